@@ -45,6 +45,26 @@ func (b *Broadcaster) Send(v interface{}) {
 	}
 }
 
+// TrySend broadcasts a message to the channel without blocking. Listeners that have
+// fallen a full buffer behind are closed and removed, so they can't stall the sender.
+func (b *Broadcaster) TrySend(v interface{}) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if b.closed {
+		log.Warning("send on closed channel")
+		return
+	}
+	for id, l := range b.listeners {
+		select {
+		case l <- v:
+		default:
+			log.Warningf("dropping slow listener %d", id)
+			close(l)
+			delete(b.listeners, id)
+		}
+	}
+}
+
 // Close closes the channel, disabling the sending of further messages.
 func (b *Broadcaster) Close() {
 	b.m.Lock()
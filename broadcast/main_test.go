@@ -0,0 +1,32 @@
+package broadcast
+
+import "testing"
+
+func TestBroadcaster_TrySend(t *testing.T) {
+	b := NewBroadcaster(1)
+	fast := b.Listen()
+	slow := b.Listen()
+
+	b.TrySend(1)
+	<-fast.Ch
+	b.TrySend(2)
+
+	if v := <-fast.Ch; v != 2 {
+		t.Fatalf("fast listener got %v", v)
+	}
+	if v := <-slow.Ch; v != 1 {
+		t.Fatalf("slow listener got %v", v)
+	}
+	if _, ok := <-slow.Ch; ok {
+		t.Fatal("slow listener should have been closed")
+	}
+
+	// sending continues for the remaining listener
+	b.TrySend(3)
+	if v := <-fast.Ch; v != 3 {
+		t.Fatalf("fast listener got %v", v)
+	}
+	slow.Close()
+	fast.Close()
+	b.Close()
+}
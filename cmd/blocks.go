@@ -88,6 +88,17 @@ func BlockIgnore(blockID string) error {
 	return nil
 }
 
+func BlockEdit(blockID string, body string) error {
+	res, err := executeJsonCmd(http.MethodPost, "blocks/"+blockID+"/edit", params{
+		args: []string{body},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
 func BlockFile(blockID string, index int, path string, content bool) error {
 	urlPath := "blocks/" + blockID + "/files"
 	if path != "" {
@@ -51,3 +51,90 @@ func CafeMessages() error {
 	output(res)
 	return nil
 }
+
+func CafeWebhook(cafeID string, url string) error {
+	var meth method = http.MethodDelete
+	var args []string
+	if url != "" {
+		meth = http.MethodPut
+		args = []string{url}
+	}
+	res, err := executeStringCmd(meth, "cafes/"+cafeID+"/webhook", params{args: args})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeHostList(token string) error {
+	res, err := executeJsonCmd(http.MethodGet, "cafe/clients", cafeHostParams(token), nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeHostInspect(token string, clientID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "cafe/clients/"+clientID, cafeHostParams(token), nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeHostEvict(token string, clientID string) error {
+	res, err := executeStringCmd(http.MethodDelete, "cafe/clients/"+clientID, cafeHostParams(token))
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeHostBan(token string, peerID string) error {
+	pars := cafeHostParams(token)
+	pars.args = []string{peerID}
+	res, err := executeJsonCmd(http.MethodPost, "cafe/bans", pars, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeHostBans(token string) error {
+	res, err := executeJsonCmd(http.MethodGet, "cafe/bans", cafeHostParams(token), nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeHostUnban(token string, peerID string) error {
+	res, err := executeStringCmd(http.MethodDelete, "cafe/bans/"+peerID, cafeHostParams(token))
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeHostGC(token string) error {
+	res, err := executeJsonCmd(http.MethodPost, "cafe/gc", cafeHostParams(token), nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+// cafeHostParams returns request params authorized with the cafe admin token
+func cafeHostParams(token string) params {
+	return params{
+		headers: map[string]string{"Authorization": "Bearer " + token},
+	}
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+func Changes(since int64, limit int) error {
+	var list pb.ChangeList
+	res, err := executeJsonPbCmd(http.MethodGet, "changes", params{
+		opts: map[string]string{
+			"since": strconv.FormatInt(since, 10),
+			"limit": strconv.Itoa(limit),
+		},
+	}, &list)
+	if err != nil {
+		return err
+	}
+	output(res)
+
+	if len(list.Items) < limit {
+		return nil
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("next page...")
+	if _, err := reader.ReadString('\n'); err != nil {
+		return err
+	}
+
+	return Changes(list.Next, limit)
+}
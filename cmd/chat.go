@@ -56,7 +56,10 @@ func Chat(threadID string) error {
 					if last {
 						println()
 					}
-					println(Cyan(payload.User.Name) + "  " + Grey(payload.Body))
+					if quote := quoteReply(payload); quote != "" {
+						println(Grey(quote))
+					}
+					println(Cyan(payload.User.Name) + "  " + Grey(core.RenderMentions(payload.Body, payload.Mentions)))
 					last = false
 				}
 			}
@@ -79,13 +82,31 @@ func Chat(threadID string) error {
 
 func handleLine(line string, threadID string) error {
 	if strings.TrimSpace(line) != "" {
-		if _, err := addMessage(threadID, line); err != nil {
+		if _, err := addMessage(threadID, "", line); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// quoteReply returns a quoted line for the message a reply targets, if any
+func quoteReply(msg *pb.Text) string {
+	if msg.ReplyTo == "" || msg.Target == nil {
+		return ""
+	}
+
+	target := new(pb.Text)
+	if err := ptypes.UnmarshalAny(msg.Target.Payload, target); err != nil {
+		return ""
+	}
+
+	var name string
+	if target.User != nil {
+		name = target.User.Name
+	}
+	return "> " + name + ": " + core.RenderMentions(target.Body, target.Mentions)
+}
+
 func getAccountContact() (*pb.Contact, error) {
 	_, c, err := getAccount()
 	if err != nil {
@@ -31,6 +31,10 @@ func CommentGet(blockID string) error {
 	return nil
 }
 
+func CommentEdit(blockID string, body string) error {
+	return BlockEdit(blockID, body)
+}
+
 func CommentIgnore(blockID string) error {
 	return BlockIgnore(blockID)
 }
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bufio"
+	"net/http"
+	"strings"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+func EventsCommand(threadID string, types []string) error {
+	events, err := Events(threadID, types)
+	if err != nil {
+		return err
+	}
+
+	for event := range events {
+		out, err := pbMarshaler.MarshalToString(event)
+		if err != nil {
+			return err
+		}
+		output(out)
+	}
+	return nil
+}
+
+func Events(threadID string, types []string) (<-chan *pb.Event, error) {
+	events := make(chan *pb.Event, 10)
+	go func() {
+		defer close(events)
+
+		res, cancel, err := request(http.MethodGet, "events", params{
+			opts: map[string]string{
+				"type":   strings.Join(types, "|"),
+				"thread": threadID,
+				"ping":   "0",
+			},
+		})
+		if err != nil {
+			output(err.Error())
+			return
+		}
+		defer res.Body.Close()
+		defer cancel()
+
+		if res.StatusCode >= 400 {
+			body, err := util.UnmarshalString(res.Body)
+			if err != nil {
+				output(err.Error())
+			} else {
+				output(body)
+			}
+			return
+		}
+
+		// server-sent events carry each json event on a data line
+		scanner := bufio.NewScanner(res.Body)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			var event pb.Event
+			if err := pbUnmarshaler.Unmarshal(strings.NewReader(strings.TrimPrefix(line, "data:")), &event); err != nil {
+				output(err.Error())
+				return
+			}
+			events <- &event
+		}
+		if err := scanner.Err(); err != nil {
+			output(err.Error())
+		}
+	}()
+
+	return events, nil
+}
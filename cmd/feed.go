@@ -6,17 +6,23 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/textileio/go-textile/pb"
 )
 
-func Feed(threadID string, offset string, limit int, mode string) error {
+func Feed(threadID string, offset string, limit int, mode string, author string, types []string, after string, before string, media string) error {
 	var list pb.FeedItemList
 	opts := map[string]string{
 		"thread": threadID,
 		"offset": offset,
 		"limit":  strconv.Itoa(limit),
 		"mode":   mode,
+		"author": author,
+		"type":   strings.Join(types, "|"),
+		"after":  after,
+		"before": before,
+		"media":  media,
 	}
 	res, err := executeJsonPbCmd(http.MethodGet, "feed", params{opts: opts}, &list)
 	if err != nil {
@@ -36,5 +42,5 @@ func Feed(threadID string, offset string, limit int, mode string) error {
 		return err
 	}
 
-	return Feed(threadID, list.Next, limit, mode)
+	return Feed(threadID, list.Next, limit, mode, author, types, after, before, media)
 }
@@ -17,6 +17,7 @@ import (
 	ipfspath "github.com/ipfs/go-path"
 	"github.com/mitchellh/go-homedir"
 	"github.com/textileio/go-textile/core"
+	m "github.com/textileio/go-textile/mill"
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/schema"
 )
@@ -289,8 +290,14 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 			return nil, err
 		}
 
-		// send each link
+		// send each link, leaving out optional links without output
+		skipped := make(map[string]bool)
 		for _, step := range steps {
+			if skipped[step.Link.Use] {
+				skipped[step.Name] = true
+				continue
+			}
+
 			var res string
 			file := &pb.FileIndex{}
 
@@ -318,6 +325,10 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 
 				res, file, err = handleStep(step.Link.Mill, reader, mopts, ctype)
 				if err != nil {
+					if step.Link.Optional && m.NoOutput(err) {
+						skipped[step.Name] = true
+						continue
+					}
 					return nil, err
 				}
 
@@ -331,6 +342,10 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 					opts: mopts.val,
 				}, file)
 				if err != nil {
+					if step.Link.Optional && m.NoOutput(err) {
+						skipped[step.Name] = true
+						continue
+					}
 					return nil, err
 				}
 			}
@@ -401,7 +416,23 @@ func handleStep(mil string, reader io.Reader, opts millOpts, ctype string) (stri
 	return res, &file, nil
 }
 
+// multipartReader returns a reader of f as multipart form data.
+// Seekable files are streamed, while others, e.g., stdin, are buffered
+// so they can be rewound.
 func multipartReader(f *os.File) (io.ReadSeeker, string, error) {
+	if _, err := f.Seek(0, io.SeekCurrent); err != nil {
+		return bufferedMultipartReader(f)
+	}
+
+	writer := multipart.NewWriter(nil)
+	r := &multipartFileReader{f: f, boundary: writer.Boundary()}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, "", err
+	}
+	return r, writer.FormDataContentType(), nil
+}
+
+func bufferedMultipartReader(f *os.File) (io.ReadSeeker, string, error) {
 	var body bytes.Buffer
 	writer := multipart.NewWriter(&body)
 	part, err := writer.CreateFormFile("file", filepath.Base(f.Name()))
@@ -415,6 +446,61 @@ func multipartReader(f *os.File) (io.ReadSeeker, string, error) {
 	return bytes.NewReader(body.Bytes()), writer.FormDataContentType(), nil
 }
 
+// multipartFileReader streams a file as multipart form data.
+// It can only seek to the start, which re-reads the file.
+type multipartFileReader struct {
+	f        *os.File
+	boundary string
+	pr       *io.PipeReader
+	done     chan struct{}
+}
+
+func (r *multipartFileReader) Read(p []byte) (int, error) {
+	return r.pr.Read(p)
+}
+
+func (r *multipartFileReader) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, fmt.Errorf("multipart reader can only seek to start")
+	}
+	_ = r.Close()
+	if _, err := r.f.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	pr, pw := io.Pipe()
+	r.pr = pr
+	r.done = make(chan struct{})
+	go func(done chan struct{}) {
+		defer close(done)
+		writer := multipart.NewWriter(pw)
+		err := writer.SetBoundary(r.boundary)
+		if err == nil {
+			var part io.Writer
+			part, err = writer.CreateFormFile("file", filepath.Base(r.f.Name()))
+			if err == nil {
+				_, err = io.Copy(part, r.f)
+			}
+		}
+		if err == nil {
+			err = writer.Close()
+		}
+		_ = pw.CloseWithError(err)
+	}(r.done)
+
+	return 0, nil
+}
+
+// Close stops the current stream, waiting for it to release the file
+func (r *multipartFileReader) Close() error {
+	if r.pr == nil {
+		return nil
+	}
+	_ = r.pr.Close()
+	<-r.done
+	return nil
+}
+
 // ------------------------------------
 // > file list
 
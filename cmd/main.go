@@ -35,6 +35,7 @@ type params struct {
 	opts    map[string]string
 	payload io.Reader
 	ctype   string
+	headers map[string]string
 }
 
 var (
@@ -109,7 +110,7 @@ var (
 	blockMetaBlockID = blockMetaCmd.Arg("block", "Block ID").Required().String()
 
 	// ignore
-	blockIgnoreCmd     = blockCmd.Command("ignore", "Remove a block by marking it to be ignored").Alias("remove").Alias("rm")
+	blockIgnoreCmd     = blockCmd.Command("ignore", "Remove a block by marking it to be ignored. Only the original author can remove a block.").Alias("remove").Alias("rm")
 	blockIgnoreBlockID = blockIgnoreCmd.Arg("block", "Block ID").Required().String()
 
 	// files
@@ -144,6 +145,50 @@ An access token is required to register, and should be obtained separately from
 	// messages
 	cafeMessagesCmd = cafeCmd.Command("messages", "Check for messages at all cafes. New messages are downloaded and processed opportunistically.")
 
+	// webhook
+	cafeWebhookCmd = cafeCmd.Command("webhook", `Sets a URL the cafe POSTs a signed notice to when it receives a message for this peer, e.g., to wake a mobile app via a push relay.
+Omit the URL to remove the webhook.`)
+	cafeWebhookCafeID = cafeWebhookCmd.Arg("cafe", "Cafe ID").Required().String()
+	cafeWebhookURL    = cafeWebhookCmd.Arg("url", "Webhook URL").String()
+
+	// host
+	cafeHostCmd   = cafeCmd.Command("host", "Commands to manage clients registered with this cafe. Requires the cafe admin token (Cafe.Host.AdminToken).")
+	cafeHostToken = cafeHostCmd.Flag("token", "The cafe admin token").Short('t').Envar("CAFE_HOST_ADMIN_TOKEN").Required().String()
+
+	// host list
+	cafeHostListCmd = cafeHostCmd.Command("list", "List the storage and inbox usage of registered clients, along with their quotas").Alias("ls")
+
+	// host inspect
+	cafeHostInspectCmd      = cafeHostCmd.Command("inspect", "Shows the usage, stored thread snapshot IDs, and waiting inbox messages of a registered client").Alias("get")
+	cafeHostInspectClientID = cafeHostInspectCmd.Arg("client", "Client peer ID").Required().String()
+
+	// host evict
+	cafeHostEvictCmd      = cafeHostCmd.Command("evict", "Forcibly deregisters a client, deleting its stored thread snapshots and inbox messages")
+	cafeHostEvictClientID = cafeHostEvictCmd.Arg("client", "Client peer ID").Required().String()
+
+	// host ban
+	cafeHostBanCmd    = cafeHostCmd.Command("ban", "Bans a peer from registering and using existing sessions. Registered clients are evicted.")
+	cafeHostBanPeerID = cafeHostBanCmd.Arg("peer", "Peer ID").Required().String()
+
+	// host bans
+	cafeHostBansCmd = cafeHostCmd.Command("bans", "List banned peers")
+
+	// host unban
+	cafeHostUnbanCmd    = cafeHostCmd.Command("unban", "Lifts a ban, allowing the peer to register again")
+	cafeHostUnbanPeerID = cafeHostUnbanCmd.Arg("peer", "Peer ID").Required().String()
+
+	// host gc
+	cafeHostGCCmd = cafeHostCmd.Command("gc", `Expires clients that have not been seen within Cafe.Host.Retention.ClientMaxAge, reclaiming their pins, thread snapshots, and inbox messages.
+Inbox messages older than Cafe.Host.Retention.MessageMaxAge are also deleted. This runs hourly on open cafes.`)
+
+	// ================================
+
+	// changes
+	changesCmd = appCmd.Command("changes", `Lists local index changes (blocks, files, notifications, and thread metadata) after a cursor, oldest first.
+Each change has a sequence number, the last of which is the cursor for the next request.`)
+	changesSince = changesCmd.Flag("since", "Cursor to list changes after, omit for all").Short('s').Default("0").Int64()
+	changesLimit = changesCmd.Flag("limit", "List page size").Short('l').Default("100").Int()
+
 	// ================================
 
 	// chat
@@ -158,7 +203,7 @@ An access token is required to register, and should be obtained separately from
 	// add
 	commentAddCmd     = commentCmd.Command("add", "Attach a comment to a block")
 	commentAddBlockID = commentAddCmd.Arg("block", "The Block ID to attach the comment to").Required().String()
-	commentAddBody    = commentAddCmd.Arg("body", "Text to use as the comment").Required().String()
+	commentAddBody    = commentAddCmd.Arg("body", "Text to use as the comment, mention users with @address").Required().String()
 
 	// list
 	commentListCmd     = commentCmd.Command("list", "Get the comments that are attached to a block").Alias("ls")
@@ -168,6 +213,11 @@ An access token is required to register, and should be obtained separately from
 	commentGetCmd     = commentCmd.Command("get", "Get a comment by its own Block ID")
 	commentGetBlockID = commentGetCmd.Arg("comment-block", "Comment Block ID").Required().String()
 
+	// edit
+	commentEditCmd     = commentCmd.Command("edit", "Edit a comment by its own Block ID, only the original author can edit a comment")
+	commentEditBlockID = commentEditCmd.Arg("comment-block", "Comment Block ID").Required().String()
+	commentEditBody    = commentEditCmd.Arg("body", "New comment text, mention users with @address").Required().String()
+
 	// ignore
 	commentIgnoreCmd     = commentCmd.Command("ignore", "Ignore a comment by its own Block ID").Alias("remove").Alias("rm")
 	commentIgnoreBlockID = commentIgnoreCmd.Arg("comment-block", "Comment Block ID").Required().String()
@@ -226,6 +276,13 @@ An access token is required to register, and should be obtained separately from
 
 	// ================================
 
+	// events
+	eventsCmd      = appCmd.Command("events", "Streams node events: thread updates, wallet updates, notifications, and node online/offline status")
+	eventsThreadID = eventsCmd.Flag("thread", "Only include events for a thread, omit for all").Short('t').String()
+	eventsType     = eventsCmd.Flag("type", "Only include specific types of events, possible values: thread_update, wallet_update, notification, node_online, node_offline. Can be used multiple times, e.g., --type thread_update --type notification").Short('k').Strings()
+
+	// ================================
+
 	// feed
 	feedCmd = appCmd.Command("feed", `Paginates post (join|leave|files|message) and annotation (comment|like) block types as a consumable feed.
 
@@ -245,6 +302,11 @@ Stacks may include:
 	feedOffset   = feedCmd.Flag("offset", "Offset ID to start listening from").Short('o').String()
 	feedLimit    = feedCmd.Flag("limit", "List page size").Short('l').Default("3").Int()
 	feedMode     = feedCmd.Flag("mode", "Feed mode, one of: chrono, annotated, stacks").Short('m').Default("chrono").String()
+	feedAuthor   = feedCmd.Flag("author", "Only list blocks by an author peer ID").Short('a').String()
+	feedType     = feedCmd.Flag("type", "Only list specific block types shown by the mode, e.g., --type files --type text").Short('k').Strings()
+	feedAfter    = feedCmd.Flag("after", "Only list blocks added at or after an RFC 3339 date, e.g., 2019-04-01T00:00:00Z").String()
+	feedBefore   = feedCmd.Flag("before", "Only list blocks added before an RFC 3339 date").String()
+	feedMedia    = feedCmd.Flag("media", "Only list files blocks with a file of this media type or top-level type, e.g., image/jpeg or image").String()
 	// ^ when kingpin v2 lands with enumerables, we could move the usage docs to the enum docs
 
 	// ================================
@@ -298,6 +360,7 @@ Stacks may include:
 	initCafeOpen          = initCmd.Flag("cafe-open", "Open the p2p cafe service for other peers").Bool()
 	initCafeURL           = initCmd.Flag("cafe-url", "Specify a custom URL of this cafe, e.g., https://mycafe.com").Envar("CAFE_HOST_URL").String()
 	initCafeNeighborURL   = initCmd.Flag("cafe-neighbor-url", "Specify the URL of a secondary cafe. Must return cafe info, e.g., via a Gateway: https://my-gateway.yolo.com/cafe, or a cafe API: https://my-cafe.yolo.com").Envar("CAFE_HOST_NEIGHBOR_URL").String()
+	initCafeAdminToken    = initCmd.Flag("cafe-admin-token", "Specify a secret token required by the cafe admin API, which is disabled without one").Envar("CAFE_HOST_ADMIN_TOKEN").String()
 
 	// ================================
 	// @todo are invites blocks?
@@ -397,7 +460,8 @@ There are two types of invites, direct account-to-account and external:
 	// add
 	messageAddCmd      = messageCmd.Command("add", "Adds a message to a thread")
 	messageAddThreadID = messageAddCmd.Flag("thread", "Thread ID").Default("default").String()
-	messageAddBody     = messageAddCmd.Arg("body", "The message to add the thread").String()
+	messageAddReplyTo  = messageAddCmd.Flag("reply-to", "Block ID of the message to reply to").Short('r').String()
+	messageAddBody     = messageAddCmd.Arg("body", "The message to add the thread, mention users with @address").String()
 
 	// list
 	messageListCmd      = messageCmd.Command("list", "Paginates thread messages").Alias("ls")
@@ -409,6 +473,15 @@ There are two types of invites, direct account-to-account and external:
 	messageGetCmd     = messageCmd.Command("get", "Gets a message by its own Block ID")
 	messageGetBlockID = messageGetCmd.Arg("message-block", "Message Block ID").String()
 
+	// replies
+	messageRepliesCmd     = messageCmd.Command("replies", "Lists the replies to a message by its own Block ID")
+	messageRepliesBlockID = messageRepliesCmd.Arg("message-block", "Message Block ID").Required().String()
+
+	// edit
+	messageEditCmd     = messageCmd.Command("edit", "Edits a message by its own Block ID, only the original author can edit a message")
+	messageEditBlockID = messageEditCmd.Arg("message-block", "Message Block ID").Required().String()
+	messageEditBody    = messageEditCmd.Arg("body", "New message text, mention users with @address").Required().String()
+
 	// ignore
 	messageIgnoreCmd     = messageCmd.Command("ignore", "Ignores a message by its own Block ID").Alias("remove").Alias("rm")
 	messageIgnoreBlockID = messageIgnoreCmd.Arg("message-block", "Message Block ID").String()
@@ -433,6 +506,21 @@ There are two types of invites, direct account-to-account and external:
 	notificationReadCmd = notificationCmd.Command("read", "Marks a notification as read")
 	notificationReadID  = notificationReadCmd.Arg("id", "Notification ID, set to [all] to mark all notifications as read").Required().String()
 
+	// settings
+	notificationSettingsCmd      = notificationCmd.Command("settings", "Lists notification settings, or gets the settings of a thread. Thread settings take precedence over account-wide settings.")
+	notificationSettingsThreadID = notificationSettingsCmd.Flag("thread", "Thread ID, omit to list all settings").Short('t').String()
+
+	// configure
+	notificationConfigureCmd          = notificationCmd.Command("configure", "Updates the notification settings of a thread, or the account-wide settings. Omitted flags are unchanged. Mentions are always allowed.").Alias("set")
+	notificationConfigureThreadID     = notificationConfigureCmd.Flag("thread", "Thread ID, omit for account-wide settings").Short('t').String()
+	notificationConfigureMuted        = notificationConfigureCmd.Flag("muted", "Whether or not to silence all notifications").Short('m').Enum("true", "false")
+	notificationConfigureMentionsOnly = notificationConfigureCmd.Flag("mentions-only", "Whether or not to only allow notifications addressed to you, e.g., mentions, invites and role changes").Enum("true", "false")
+	notificationConfigureType         = notificationConfigureCmd.Flag("type", "Only allow specific types of notifications, possible values: invite_received, account_peer_joined, peer_joined, peer_left, message_added, files_added, comment_added, like_added, block_rejected, role_changed, mentioned. Can be used multiple times, e.g., --type message_added --type comment_added. Use --type all to allow all types.").Short('k').Strings()
+
+	// reset
+	notificationResetCmd      = notificationCmd.Command("reset", "Removes the notification settings of a thread, or resets the account-wide settings")
+	notificationResetThreadID = notificationResetCmd.Flag("thread", "Thread ID, omit for account-wide settings").Short('t').String()
+
 	// delete
 	// @todo do delete notification command at some point
 
@@ -463,6 +551,41 @@ There are two types of invites, direct account-to-account and external:
 
 	// ================================
 
+	// reaction
+	reactionCmd = appCmd.Command("reaction", `Reactions are added as blocks in a thread, which target another block. Likes are listed as heart reactions.`).Alias("reactions")
+
+	// add
+	reactionAddCmd      = reactionCmd.Command("add", "Attach a reaction to a block")
+	reactionAddBlockID  = reactionAddCmd.Arg("block", "Block ID, usually a message or file's block").Required().String()
+	reactionAddReaction = reactionAddCmd.Arg("reaction", "Short reaction key without whitespace, e.g., an emoji").Required().String()
+
+	// list
+	reactionListCmd     = reactionCmd.Command("list", "Get reactions that are attached to a block").Alias("ls")
+	reactionListBlockID = reactionListCmd.Arg("block", "Block ID, usually a message or file's block").Required().String()
+
+	// get
+	reactionGetCmd        = reactionCmd.Command("get", "Get a reaction by its own Block ID")
+	reactionGetReactionID = reactionGetCmd.Arg("reaction-block", "Reaction Block ID").Required().String()
+
+	// remove
+	reactionRemoveCmd      = reactionCmd.Command("remove", "Remove your reaction from a block, use heart to remove a like").Alias("rm")
+	reactionRemoveBlockID  = reactionRemoveCmd.Arg("block", "Block ID, usually a message or file's block").Required().String()
+	reactionRemoveReaction = reactionRemoveCmd.Arg("reaction", "Reaction to remove").Required().String()
+
+	// ================================
+
+	// search
+	searchCmd = appCmd.Command("search", `Searches local thread messages, comments, and file captions, names, and metadata, newest first.
+Queries use SQLite full-text syntax, e.g., beach OR lake, sun*, or "sunny beach". Ignored blocks are not included.`).Alias("find")
+	searchQuery    = searchCmd.Arg("query", "Full-text query").Required().String()
+	searchThreadID = searchCmd.Flag("thread", "Thread ID, omit for all").Short('t').String()
+	searchType     = searchCmd.Flag("type", "Only include specific block types, e.g., --type text --type comment").Short('k').Strings()
+	searchAuthor   = searchCmd.Flag("author", "Only include blocks by an author peer ID").Short('a').String()
+	searchOffset   = searchCmd.Flag("offset", "Offset ID to start listing from").Short('o').String()
+	searchLimit    = searchCmd.Flag("limit", "List page size").Short('l').Default("10").Int()
+
+	// ================================
+
 	// subscribe
 	subscribeCmd      = appCmd.Command("subscribe", "Subscribes to updates in a thread or all threads. An update is generated when a new block is added to a thread.").Alias("sub")
 	subscribeThreadID = subscribeCmd.Flag("thread", "Thread ID, omit for all").Short('t').String()
@@ -481,9 +604,10 @@ There are two types of invites, direct account-to-account and external:
 Use this command to add, list, get, and remove threads. See below for additional commands.
 
 Control over thread access and sharing is handled by a combination of the --type and --sharing flags.
-An immutable member address "whitelist" gives the initiator fine-grained control.
+A member address "whitelist" gives the initiator fine-grained control.
 The table below outlines access patterns for the thread initiator and the whitelist members.
 An empty whitelist is taken to be "everyone", which is the default.
+Roles granted later by the initiator or admins override both (see "thread role").
 
 Thread type controls read (R), annotate (A), and write (W) access:
 
@@ -525,11 +649,85 @@ shared      --> initiator: Y, whitelist: Y`).Alias("threads")
 	threadPeerCmd      = threadCmd.Command("peer", "Lists all peers in a thread").Alias("peers")
 	threadPeerThreadID = threadPeerCmd.Flag("thread", "Thread ID").Default("default").Short('t').String()
 
+	// dag
+	threadDagCmd = threadCmd.Command("dag", `Walks thread history, streaming the blocks reachable from a starting block (or HEAD).
+Parents that aren't indexed are included with a MISSING, UNFETCHABLE, or REJECTED status.`).Alias("walk")
+	threadDagThreadID = threadDagCmd.Flag("thread", "Thread ID").Default("default").Short('t').String()
+	threadDagStart    = threadDagCmd.Flag("start", "Block ID to start from, omit for HEAD").Short('s').String()
+	threadDagOrder    = threadDagCmd.Flag("order", "Set the order to one of: reverse_chronological, topological (parents first)").Short('o').Default("reverse_chronological").String()
+	threadDagDepth    = threadDagCmd.Flag("depth", "Max parent hops from start, 0 for no limit").Short('d').Default("0").Int()
+	threadDagType     = threadDagCmd.Flag("type", "Only include specific block types, e.g., --type files --type text").Short('k').Strings()
+	threadDagLimit    = threadDagCmd.Flag("limit", "Max steps, 0 for no limit").Short('l').Default("0").Int()
+	threadDagFetch    = threadDagCmd.Flag("fetch", "Try fetching missing blocks to find unfetchable ones").Bool()
+
 	// rename
 	threadRenameCmd      = threadCmd.Command("rename", "Renames a thread. Only the initiator of a thread can rename it.").Alias("mv")
 	threadRenameThreadID = threadRenameCmd.Flag("thread", "Thread ID").Default("default").Short('t').String()
 	threadRenameName     = threadRenameCmd.Arg("name", "The name to rename the thread to").Required().String()
 
+	// role
+	threadRoleCmd = threadCmd.Command("role", `Roles override the access given by thread type and whitelist.
+Granting a role to an address adds it to the thread members, revoking removes it.
+Only the initiator and admins can change roles, and only the initiator can change admins.`).Alias("roles")
+
+	// role list
+	threadRoleListCmd      = threadRoleCmd.Command("list", "Lists granted and revoked roles in a thread").Alias("ls")
+	threadRoleListThreadID = threadRoleListCmd.Flag("thread", "Thread ID").Default("default").Short('t').String()
+
+	// role grant
+	threadRoleGrantCmd      = threadRoleCmd.Command("grant", "Grants a role to an account address")
+	threadRoleGrantThreadID = threadRoleGrantCmd.Flag("thread", "Thread ID").Default("default").Short('t').String()
+	threadRoleGrantRole     = threadRoleGrantCmd.Flag("role", "Set the role to one of: read, annotate, write, admin").Short('r').Default("read").String()
+	threadRoleGrantAddress  = threadRoleGrantCmd.Arg("address", "Account address").Required().String()
+
+	// role revoke
+	threadRoleRevokeCmd      = threadRoleCmd.Command("revoke", "Revokes all access from an account address").Alias("remove").Alias("rm")
+	threadRoleRevokeThreadID = threadRoleRevokeCmd.Flag("thread", "Thread ID").Default("default").Short('t').String()
+	threadRoleRevokeAddress  = threadRoleRevokeCmd.Arg("address", "Account address").Required().String()
+
+	// rotate
+	threadRotateCmd = threadCmd.Command("rotate", `Rotates the thread key, distributing a new key to each remaining member.
+Blocks added afterwards can't be decrypted by former members.
+Only the initiator and admins can rotate the thread key.`)
+	threadRotateThreadID = threadRotateCmd.Flag("thread", "Thread ID").Default("default").Short('t').String()
+
+	// evict
+	threadEvictCmd      = threadCmd.Command("evict", "Revokes all access from an account address and rotates the thread key")
+	threadEvictThreadID = threadEvictCmd.Flag("thread", "Thread ID").Default("default").Short('t').String()
+	threadEvictAddress  = threadEvictCmd.Arg("address", "Account address").Required().String()
+
+	// export
+	threadExportCmd      = threadCmd.Command("export", "Writes a portable, signed archive of a thread to stdout, which can only be imported by the same account")
+	threadExportThreadID = threadExportCmd.Arg("thread", "Thread ID").Required().String()
+
+	// import
+	threadImportCmd  = threadCmd.Command("import", "Imports a thread archive, re-indexing the thread without fetching blocks from the network")
+	threadImportPath = threadImportCmd.Arg("path", "Path to a thread archive").Required().String()
+
+	// verify
+	threadVerifyCmd = threadCmd.Command("verify", `Checks thread integrity by walking from HEAD, verifying that each block is present, pinned,
+decryptable, and indexed, that role and key rotation blocks are validly signed, and that file nodes are present and pinned as the thread schema dictates.
+Indexed blocks not reachable from HEAD are reported as orphans.`)
+	threadVerifyThreadID = threadVerifyCmd.Flag("thread", "Thread ID").Default("default").Short('t').String()
+	threadVerifyRepair   = threadVerifyCmd.Flag("repair", "Fetch missing blocks, pin nodes, re-index unindexed blocks, and merge orphans into HEAD").Short('r').Bool()
+
+	// advertise
+	threadAdvertiseCmd = threadCmd.Command("advertise", `Makes a shared public or open thread discoverable by peers searching the network.
+The advert carries an external invite, which is renewed when the thread key rotates.`)
+	threadAdvertiseThreadID = threadAdvertiseCmd.Flag("thread", "Thread ID").Default("default").Short('t').String()
+
+	// unadvertise
+	threadUnadvertiseCmd      = threadCmd.Command("unadvertise", "Stops advertising a thread to the network")
+	threadUnadvertiseThreadID = threadUnadvertiseCmd.Flag("thread", "Thread ID").Default("default").Short('t').String()
+
+	// discover
+	threadDiscoverCmd       = threadCmd.Command("discover", "Searches the network for advertised public and open threads")
+	threadDiscoverName      = threadDiscoverCmd.Flag("name", "Search by thread name").Short('n').String()
+	threadDiscoverSchema    = threadDiscoverCmd.Flag("schema", "Search by thread schema ID").Short('s').String()
+	threadDiscoverInitiator = threadDiscoverCmd.Flag("initiator", "Search by initiator account address").Short('i').String()
+	threadDiscoverLimit     = threadDiscoverCmd.Flag("limit", "Stops searching after [limit] results are found").Default("5").Int()
+	threadDiscoverWait      = threadDiscoverCmd.Flag("wait", "Stops searching after [wait] seconds have elapsed (max 30s)").Short('w').Default("2").Int()
+
 	// unsubscribe
 	threadUnsubscribeCmd      = threadCmd.Command("unsubscribe", "Unsubscribes from the thread, and if no one else remains subscribed, deletes it").Alias("subsub").Alias("remove").Alias("rm")
 	threadUnsubscribeThreadID = threadUnsubscribeCmd.Arg("thread", "Thread ID").Required().String()
@@ -645,6 +843,34 @@ func Run() error {
 	case cafeMessagesCmd.FullCommand():
 		return CafeMessages()
 
+	case cafeWebhookCmd.FullCommand():
+		return CafeWebhook(*cafeWebhookCafeID, *cafeWebhookURL)
+
+	case cafeHostListCmd.FullCommand():
+		return CafeHostList(*cafeHostToken)
+
+	case cafeHostInspectCmd.FullCommand():
+		return CafeHostInspect(*cafeHostToken, *cafeHostInspectClientID)
+
+	case cafeHostEvictCmd.FullCommand():
+		return CafeHostEvict(*cafeHostToken, *cafeHostEvictClientID)
+
+	case cafeHostBanCmd.FullCommand():
+		return CafeHostBan(*cafeHostToken, *cafeHostBanPeerID)
+
+	case cafeHostBansCmd.FullCommand():
+		return CafeHostBans(*cafeHostToken)
+
+	case cafeHostUnbanCmd.FullCommand():
+		return CafeHostUnban(*cafeHostToken, *cafeHostUnbanPeerID)
+
+	case cafeHostGCCmd.FullCommand():
+		return CafeHostGC(*cafeHostToken)
+
+	// changes
+	case changesCmd.FullCommand():
+		return Changes(*changesSince, *changesLimit)
+
 	// chat
 	case chatCmd.FullCommand():
 		return Chat(*chatThreadID)
@@ -659,6 +885,9 @@ func Run() error {
 	case commentGetCmd.FullCommand():
 		return CommentGet(*commentGetBlockID)
 
+	case commentEditCmd.FullCommand():
+		return CommentEdit(*commentEditBlockID, *commentEditBody)
+
 	case commentIgnoreCmd.FullCommand():
 		return CommentIgnore(*commentIgnoreBlockID)
 
@@ -694,9 +923,13 @@ func Run() error {
 	case docsCmd.FullCommand():
 		return Docs()
 
+	// events
+	case eventsCmd.FullCommand():
+		return EventsCommand(*eventsThreadID, *eventsType)
+
 	// feed
 	case feedCmd.FullCommand():
-		return Feed(*feedThreadID, *feedOffset, *feedLimit, *feedMode)
+		return Feed(*feedThreadID, *feedOffset, *feedLimit, *feedMode, *feedAuthor, *feedType, *feedAfter, *feedBefore, *feedMedia)
 
 	// file
 	case fileListCmd.FullCommand():
@@ -747,6 +980,7 @@ func Run() error {
 			CafeOpen:        *initCafeOpen,
 			CafeURL:         *initCafeURL,
 			CafeNeighborURL: *initCafeNeighborURL,
+			CafeAdminToken:  *initCafeAdminToken,
 		}
 
 		return InitCommand(config)
@@ -796,7 +1030,7 @@ func Run() error {
 
 	// message
 	case messageAddCmd.FullCommand():
-		return MessageAdd(*messageAddThreadID, *messageAddBody)
+		return MessageAdd(*messageAddThreadID, *messageAddReplyTo, *messageAddBody)
 
 	case messageListCmd.FullCommand():
 		return MessageList(*messageListThreadID, *messageListOffset, *messageListLimit)
@@ -804,6 +1038,12 @@ func Run() error {
 	case messageGetCmd.FullCommand():
 		return MessageGet(*messageGetBlockID)
 
+	case messageRepliesCmd.FullCommand():
+		return MessageReplies(*messageRepliesBlockID)
+
+	case messageEditCmd.FullCommand():
+		return MessageEdit(*messageEditBlockID, *messageEditBody)
+
 	case messageIgnoreCmd.FullCommand():
 		return MessageIgnore(*messageIgnoreBlockID)
 
@@ -822,6 +1062,15 @@ func Run() error {
 	case notificationReadCmd.FullCommand():
 		return NotificationRead(*notificationReadID)
 
+	case notificationSettingsCmd.FullCommand():
+		return NotificationSettings(*notificationSettingsThreadID)
+
+	case notificationConfigureCmd.FullCommand():
+		return NotificationConfigure(*notificationConfigureThreadID, *notificationConfigureMuted, *notificationConfigureMentionsOnly, *notificationConfigureType)
+
+	case notificationResetCmd.FullCommand():
+		return NotificationReset(*notificationResetThreadID)
+
 	// ping
 	case pingCmd.FullCommand():
 		return Ping(*pingAddress)
@@ -839,6 +1088,23 @@ func Run() error {
 	case profileSetAvatarCmd.FullCommand():
 		return ProfileSet("", *profileSetAvatarValue)
 
+	// reaction
+	case reactionAddCmd.FullCommand():
+		return ReactionAdd(*reactionAddBlockID, *reactionAddReaction)
+
+	case reactionListCmd.FullCommand():
+		return ReactionList(*reactionListBlockID)
+
+	case reactionGetCmd.FullCommand():
+		return ReactionGet(*reactionGetReactionID)
+
+	case reactionRemoveCmd.FullCommand():
+		return ReactionRemove(*reactionRemoveBlockID, *reactionRemoveReaction)
+
+	// search
+	case searchCmd.FullCommand():
+		return Search(*searchQuery, *searchThreadID, *searchType, *searchAuthor, *searchOffset, *searchLimit)
+
 	// subscribe
 	case subscribeCmd.FullCommand():
 		return SubscribeCommand(*subscribeThreadID, *subscribeType)
@@ -863,9 +1129,45 @@ func Run() error {
 	case threadPeerCmd.FullCommand():
 		return ThreadPeer(*threadPeerThreadID)
 
+	case threadDagCmd.FullCommand():
+		return ThreadDag(*threadDagThreadID, *threadDagStart, *threadDagOrder, *threadDagDepth, *threadDagType, *threadDagLimit, *threadDagFetch)
+
 	case threadRenameCmd.FullCommand():
 		return ThreadRename(*threadRenameName, *threadRenameThreadID)
 
+	case threadRoleListCmd.FullCommand():
+		return ThreadRoleList(*threadRoleListThreadID)
+
+	case threadRoleGrantCmd.FullCommand():
+		return ThreadRoleGrant(*threadRoleGrantAddress, *threadRoleGrantRole, *threadRoleGrantThreadID)
+
+	case threadRoleRevokeCmd.FullCommand():
+		return ThreadRoleRevoke(*threadRoleRevokeAddress, *threadRoleRevokeThreadID)
+
+	case threadRotateCmd.FullCommand():
+		return ThreadRotate(*threadRotateThreadID)
+
+	case threadEvictCmd.FullCommand():
+		return ThreadEvict(*threadEvictAddress, *threadEvictThreadID)
+
+	case threadExportCmd.FullCommand():
+		return ThreadExport(*threadExportThreadID)
+
+	case threadImportCmd.FullCommand():
+		return ThreadImport(*threadImportPath)
+
+	case threadVerifyCmd.FullCommand():
+		return ThreadVerify(*threadVerifyThreadID, *threadVerifyRepair)
+
+	case threadAdvertiseCmd.FullCommand():
+		return ThreadAdvertise(*threadAdvertiseThreadID)
+
+	case threadUnadvertiseCmd.FullCommand():
+		return ThreadUnadvertise(*threadUnadvertiseThreadID)
+
+	case threadDiscoverCmd.FullCommand():
+		return ThreadDiscover(*threadDiscoverName, *threadDiscoverSchema, *threadDiscoverInitiator, *threadDiscoverLimit, *threadDiscoverWait)
+
 	case threadUnsubscribeCmd.FullCommand():
 		return ThreadUnsubscribe(*threadUnsubscribeThreadID)
 
@@ -1041,6 +1343,10 @@ func request(meth method, pth string, pars params) (*http.Response, func(), erro
 		req.Header.Set("Content-Type", pars.ctype)
 	}
 
+	for k, v := range pars.headers {
+		req.Header.Set(k, v)
+	}
+
 	tr := &http.Transport{}
 	client := &http.Client{Transport: tr}
 	res, err := client.Do(req)
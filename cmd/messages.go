@@ -10,8 +10,8 @@ import (
 	"github.com/textileio/go-textile/pb"
 )
 
-func MessageAdd(threadID string, body string) error {
-	res, err := addMessage(threadID, body)
+func MessageAdd(threadID string, replyTo string, body string) error {
+	res, err := addMessage(threadID, replyTo, body)
 	if err != nil {
 		return err
 	}
@@ -19,9 +19,10 @@ func MessageAdd(threadID string, body string) error {
 	return nil
 }
 
-func addMessage(threadID string, body string) (string, error) {
+func addMessage(threadID string, replyTo string, body string) (string, error) {
 	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/messages", params{
 		args: []string{body},
+		opts: map[string]string{"reply_to": replyTo},
 	}, nil)
 
 	if err != nil {
@@ -67,6 +68,19 @@ func MessageGet(blockID string) error {
 	return nil
 }
 
+func MessageReplies(blockID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "messages/"+blockID+"/replies", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func MessageEdit(blockID string, body string) error {
+	return BlockEdit(blockID, body)
+}
+
 func MessageIgnore(blockID string) error {
 	return BlockIgnore(blockID)
 }
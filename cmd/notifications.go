@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"net/http"
+	"strings"
 )
 
 func NotificationList() error {
@@ -21,3 +22,51 @@ func NotificationRead(id string) error {
 	output(res)
 	return nil
 }
+
+func NotificationSettings(threadID string) error {
+	opts := make(map[string]string)
+	if threadID != "" {
+		opts["thread"] = threadID
+	}
+	res, err := executeJsonCmd(http.MethodGet, "notifications/settings", params{opts: opts}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func NotificationConfigure(threadID string, muted string, mentionsOnly string, types []string) error {
+	opts := map[string]string{"thread": threadID}
+	if muted != "" {
+		opts["muted"] = muted
+	}
+	if mentionsOnly != "" {
+		opts["mentions_only"] = mentionsOnly
+	}
+	if len(types) > 0 {
+		if len(types) == 1 && types[0] == "all" {
+			opts["types"] = ""
+		} else {
+			opts["types"] = strings.Join(types, "|")
+		}
+	}
+
+	res, err := executeJsonCmd(http.MethodPost, "notifications/settings", params{opts: opts}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func NotificationReset(threadID string) error {
+	res, err := executeStringCmd(http.MethodDelete, "notifications/settings", params{
+		opts: map[string]string{"thread": threadID},
+	})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+func Search(query string, threadID string, types []string, author string, offset string, limit int) error {
+	var list pb.BlockSearchResultList
+	res, err := executeJsonPbCmd(http.MethodGet, "search", params{
+		args: []string{query},
+		opts: map[string]string{
+			"thread": threadID,
+			"type":   strings.Join(types, "|"),
+			"author": author,
+			"offset": offset,
+			"limit":  strconv.Itoa(limit),
+		},
+	}, &list)
+	if err != nil {
+		return err
+	}
+	if len(list.Items) > 0 {
+		output(res)
+	}
+
+	if list.Next == "" {
+		return nil
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("next page...")
+	if _, err := reader.ReadString('\n'); err != nil {
+		return err
+	}
+
+	return Search(query, threadID, types, author, list.Next, limit)
+}
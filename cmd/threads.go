@@ -106,6 +106,19 @@ func ThreadPeer(threadID string) error {
 	return nil
 }
 
+func ThreadDag(threadID string, start string, order string, depth int, types []string, limit int, fetch bool) error {
+	return executeBlobCmd(http.MethodGet, "threads/"+threadID+"/dag", params{
+		opts: map[string]string{
+			"start": start,
+			"order": order,
+			"depth": strconv.Itoa(depth),
+			"type":  strings.Join(types, "|"),
+			"limit": strconv.Itoa(limit),
+			"fetch": strconv.FormatBool(fetch),
+		},
+	})
+}
+
 func ThreadRename(name string, threadID string) error {
 	res, err := executeStringCmd(http.MethodPost, "threads/"+threadID+"/name", params{args: []string{name}})
 	if err != nil {
@@ -115,6 +128,118 @@ func ThreadRename(name string, threadID string) error {
 	return nil
 }
 
+func ThreadRoleList(threadID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "threads/"+threadID+"/roles", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadRoleGrant(address string, role string, threadID string) error {
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/roles", params{
+		args: []string{address},
+		opts: map[string]string{"role": role},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadRoleRevoke(address string, threadID string) error {
+	res, err := executeStringCmd(http.MethodDelete, "threads/"+threadID+"/roles/"+address, params{})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadRotate(threadID string) error {
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/keys", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadEvict(address string, threadID string) error {
+	res, err := executeStringCmd(http.MethodDelete, "threads/"+threadID+"/members/"+address, params{})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadExport(threadID string) error {
+	return executeBlobCmd(http.MethodGet, "archives/"+threadID, params{})
+}
+
+func ThreadImport(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	res, err := executeJsonCmd(http.MethodPost, "archives", params{
+		payload: f,
+		ctype:   "application/octet-stream",
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadVerify(threadID string, repair bool) error {
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/verify", params{
+		opts: map[string]string{"repair": strconv.FormatBool(repair)},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadAdvertise(threadID string) error {
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/advert", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadUnadvertise(threadID string) error {
+	res, err := executeStringCmd(http.MethodDelete, "threads/"+threadID+"/advert", params{})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadDiscover(name string, schema string, initiator string, limit int, wait int) error {
+	handleSearchStream("adverts/search", params{
+		opts: map[string]string{
+			"name":      name,
+			"schema":    schema,
+			"initiator": initiator,
+			"limit":     strconv.Itoa(limit),
+			"wait":      strconv.Itoa(wait),
+		},
+	})
+	return nil
+}
+
 func ThreadUnsubscribe(threadID string) error {
 	res, err := executeStringCmd(http.MethodDelete, "threads/"+threadID, params{})
 	if err != nil {
@@ -146,12 +146,19 @@ func (a *api) Start() {
 			contacts.POST("/search", a.searchContacts)
 		}
 
+		changes := v0.Group("/changes")
+		{
+			changes.GET("", a.lsChanges)
+		}
+
 		mills := v0.Group("/mills")
 		{
 			mills.POST("/schema", a.schemaMill)
 			mills.POST("/blob", a.blobMill)
 			mills.POST("/image/resize", a.imageResizeMill)
 			mills.POST("/image/exif", a.imageExifMill)
+			mills.POST("/video/meta", a.videoMetaMill)
+			mills.POST("/video/poster", a.videoPosterMill)
 			mills.POST("/json", a.jsonMill)
 		}
 
@@ -163,17 +170,42 @@ func (a *api) Start() {
 			threads.GET("", a.lsThreads)
 			threads.GET("/:id", a.getThreads)
 			threads.GET("/:id/peers", a.peersThreads)
+			threads.GET("/:id/dag", a.dagThreads)
+			threads.POST("/:id/verify", a.verifyThreads)
+			threads.GET("/:id/roles", a.lsThreadRoles)
+			threads.POST("/:id/roles", a.addThreadRoles)
+			threads.DELETE("/:id/roles/:address", a.rmThreadRoles)
+			threads.POST("/:id/keys", a.rotateThreadKeys)
+			threads.POST("/:id/advert", a.advertiseThreads)
+			threads.DELETE("/:id/advert", a.unadvertiseThreads)
+			threads.DELETE("/:id/members/:address", a.rmThreadMembers)
 			threads.DELETE("/:id", a.rmThreads)
 			threads.POST("/:id/messages", a.addThreadMessages)
 			threads.POST("/:id/files", a.addThreadFiles)
 		}
 
+		archives := v0.Group("/archives")
+		{
+			archives.POST("", a.importArchives)
+			archives.GET("/:id", a.exportArchives)
+		}
+
 		snapshots := v0.Group("/snapshots")
 		{
 			snapshots.POST("", a.createThreadSnapshots)
 			snapshots.POST("/search", a.searchThreadSnapshots)
 		}
 
+		adverts := v0.Group("/adverts")
+		{
+			adverts.POST("/search", a.searchThreadAdverts)
+		}
+
+		search := v0.Group("/search")
+		{
+			search.GET("", a.searchBlocks)
+		}
+
 		blocks := v0.Group("/blocks")
 		{
 			blocks.GET("", a.lsBlocks)
@@ -196,6 +228,7 @@ func (a *api) Start() {
 					g.Redirect(http.StatusPermanentRedirect, "/api/v0/blocks/"+id+"/meta")
 				})
 				block.DELETE("", a.rmBlocks)
+				block.POST("/edit", a.editBlocks)
 
 				block.GET("/comment", a.getBlockComment)
 				comments := block.Group("/comments")
@@ -210,6 +243,14 @@ func (a *api) Start() {
 					likes.POST("", a.addBlockLikes)
 					likes.GET("", a.lsBlockLikes)
 				}
+
+				block.GET("/reaction", a.getBlockReaction)
+				reactions := block.Group("/reactions")
+				{
+					reactions.POST("", a.addBlockReactions)
+					reactions.GET("", a.lsBlockReactions)
+					reactions.DELETE("", a.rmBlockReactions)
+				}
 			}
 		}
 
@@ -217,6 +258,7 @@ func (a *api) Start() {
 		{
 			messages.GET("", a.lsThreadMessages)
 			messages.GET("/:block", a.getThreadMessages)
+			messages.GET("/:block/replies", a.lsMessageReplies)
 		}
 
 		files := v0.Group("/files")
@@ -239,6 +281,7 @@ func (a *api) Start() {
 				})
 				hash.GET("/meta", a.getFileMeta)
 				hash.GET("/content", a.getFileContent)
+				hash.HEAD("/content", a.getFileContent)
 			}
 		}
 
@@ -258,6 +301,12 @@ func (a *api) Start() {
 			subscribe.GET("/:id", a.getThreadsSubscribe)
 		}
 
+		events := v0.Group("/events")
+		{
+			events.GET("", a.getEvents)
+			events.GET("/ws", a.getEventsWebSocket)
+		}
+
 		invites := v0.Group("/invites")
 		{
 			invites.POST("", a.createInvites)
@@ -270,6 +319,11 @@ func (a *api) Start() {
 		{
 			notifs.GET("", a.lsNotifications)
 			notifs.POST("/:id/read", a.readNotifications)
+
+			// settings shares the :id wildcard with read, i.e., /notifications/settings
+			notifs.GET("/:id", a.getNotificationSettings)
+			notifs.POST("/:id", a.setNotificationSettings)
+			notifs.DELETE("/:id", a.rmNotificationSettings)
 		}
 
 		cafes := v0.Group("/cafes")
@@ -278,9 +332,22 @@ func (a *api) Start() {
 			cafes.GET("", a.lsCafes)
 			cafes.GET("/:id", a.getCafes)
 			cafes.DELETE("/:id", a.rmCafes)
+			cafes.PUT("/:id/webhook", a.setCafeWebhook)
+			cafes.DELETE("/:id/webhook", a.rmCafeWebhook)
 			cafes.POST("/messages", a.checkCafeMessages)
 		}
 
+		cafe := v0.Group("/cafe", a.cafeAdmin)
+		{
+			cafe.GET("/clients", a.lsCafeClients)
+			cafe.GET("/clients/:id", a.getCafeClients)
+			cafe.DELETE("/clients/:id", a.rmCafeClients)
+			cafe.POST("/bans", a.addCafeBans)
+			cafe.GET("/bans", a.lsCafeBans)
+			cafe.DELETE("/bans/:id", a.rmCafeBans)
+			cafe.POST("/gc", a.cafeGC)
+		}
+
 		tokens := v0.Group("/tokens")
 		{
 			tokens.POST("", a.createTokens)
@@ -425,12 +492,14 @@ func (a *api) getFileConfig(g *gin.Context, mill m.Mill, use string, plaintext b
 	var reader io.ReadSeeker
 	conf := &AddFileConfig{}
 
+	// streaming mills read the input directly, leaving AddFileIndex to close it
+	_, stream := mill.(m.StreamMill)
+
 	if use == "" {
 		f, fn, err := a.openFile(g)
 		if err != nil {
 			return nil, err
 		}
-		defer f.Close()
 		reader = f
 		conf.Name = fn
 
@@ -447,21 +516,38 @@ func (a *api) getFileConfig(g *gin.Context, mill m.Mill, use string, plaintext b
 
 	media, err := a.node.GetMedia(reader, mill)
 	if err != nil {
+		closeReader(reader)
 		return nil, err
 	}
 	conf.Media = media
 	reader.Seek(0, 0)
+	conf.Plaintext = plaintext
+
+	if stream {
+		conf.Reader = reader
+		return conf, nil
+	}
 
 	data, err := ioutil.ReadAll(reader)
+	closeReader(reader)
 	if err != nil {
 		return nil, err
 	}
 	conf.Input = data
-	conf.Plaintext = plaintext
 
 	return conf, nil
 }
 
+// serveContent writes file content with support for range and conditional requests,
+// using the file hash as the etag
+func serveContent(g *gin.Context, reader io.ReadSeeker, file *pb.FileIndex) {
+	g.Header("ETag", `"`+file.Hash+`"`)
+	if file.Media != "" {
+		g.Header("Content-Type", file.Media)
+	}
+	http.ServeContent(g.Writer, g.Request, file.Name, time.Time{}, reader)
+}
+
 // pbJSON responds with a JSON rendered protobuf message
 func pbJSON(g *gin.Context, status int, msg proto.Message) {
 	str, err := pbMarshaler.MarshalToString(msg)
@@ -482,6 +568,17 @@ func pbValForEnumString(vals map[string]int32, str string) int32 {
 	return 0
 }
 
+// pbEnumForString returns the value of a case-insensitive enum name, and whether
+// or not the name was found
+func pbEnumForString(vals map[string]int32, str string) (int32, bool) {
+	for v, i := range vals {
+		if strings.ToLower(v) == strings.ToLower(str) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
 // sendError sends the error to the gin context
 func sendError(g *gin.Context, err error, statusCode int) {
 	g.String(statusCode, err.Error())
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/pb"
+)
+
+// advertiseThreads godoc
+// @Summary Advertise a thread
+// @Description Makes a shared public or open thread discoverable by peers searching the
+// @Description network. The advert carries an external invite, which is renewed when the thread key rotates.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Success 201 {object} pb.ThreadAdvert "advert"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/advert [post]
+func (a *api) advertiseThreads(g *gin.Context) {
+	id := g.Param("id")
+	if id == "default" {
+		id = a.node.config.Threads.Defaults.ID
+	}
+	if a.node.Thread(id) == nil {
+		g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+		return
+	}
+
+	advert, err := a.node.AdvertiseThread(id)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, advert)
+}
+
+// unadvertiseThreads godoc
+// @Summary Stop advertising a thread
+// @Description Stops advertising a thread to the network
+// @Tags threads
+// @Param id path string true "thread id"
+// @Success 204 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/advert [delete]
+func (a *api) unadvertiseThreads(g *gin.Context) {
+	id := g.Param("id")
+	if id == "default" {
+		id = a.node.config.Threads.Defaults.ID
+	}
+
+	if err := a.node.UnadvertiseThread(id); err != nil {
+		if err == ErrThreadAdvertNotFound {
+			g.String(http.StatusNotFound, err.Error())
+			return
+		}
+		a.abort500(g, err)
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// searchThreadAdverts godoc
+// @Summary Search for public threads
+// @Description Searches the network for advertised public and open threads
+// @Tags threads
+// @Produce application/json
+// @Param X-Textile-Opts header string false "name: Thread name substring, schema: Thread schema id, initiator: Thread initiator address, limit: Stops searching after limit results are found, wait: Stops searching after 'wait' seconds have elapsed (max 30s), events: Whether to emit Server-Sent Events (SSEvent) or plain JSON" default(name=,schema=,initiator=,limit=5,wait=5,events="false")
+// @Success 200 {object} pb.QueryResult "results stream"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /adverts/search [post]
+func (a *api) searchThreadAdverts(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	limit, err := strconv.Atoi(opts["limit"])
+	if err != nil {
+		limit = 5
+	}
+	wait, err := strconv.Atoi(opts["wait"])
+	if err != nil {
+		wait = 5
+	}
+
+	query := &pb.PublicThreadQuery{
+		Name:      opts["name"],
+		Schema:    opts["schema"],
+		Initiator: opts["initiator"],
+	}
+	options := &pb.QueryOptions{
+		Limit: int32(limit),
+		Wait:  int32(wait),
+	}
+
+	resCh, errCh, cancel, err := a.node.SearchPublicThreads(query, options)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	handleSearchStream(g, resCh, errCh, cancel, opts["events"] == "true")
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportArchives godoc
+// @Summary Export a thread archive
+// @Description Exports a portable, signed archive of a thread's blocks, file dags, schema,
+// @Description and keys. The keys are encrypted for the account, so the archive can only be
+// @Description imported by the same account.
+// @Tags archives
+// @Produce application/octet-stream
+// @Param id path string true "thread id"
+// @Success 200 {string} byte "archive"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /archives/{id} [get]
+func (a *api) exportArchives(g *gin.Context) {
+	threadId := g.Param("id")
+	if threadId == "default" {
+		threadId = a.node.config.Threads.Defaults.ID
+	}
+	if a.node.Thread(threadId) == nil {
+		g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+		return
+	}
+
+	g.Header("Content-Type", "application/octet-stream")
+	g.Header("Content-Disposition", "attachment; filename="+threadId+".archive")
+	if err := a.node.ExportThread(threadId, g.Writer); err != nil {
+		if !g.Writer.Written() {
+			a.abort500(g, err)
+			return
+		}
+		log.Errorf("error exporting %s: %s", threadId, err)
+	}
+}
+
+// importArchives godoc
+// @Summary Import a thread archive
+// @Description Imports a thread archive exported by this account, re-indexing the thread
+// @Description without fetching blocks from the network
+// @Tags archives
+// @Accept application/octet-stream
+// @Produce application/json
+// @Success 201 {object} pb.Thread "thread"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /archives [post]
+func (a *api) importArchives(g *gin.Context) {
+	id, err := a.node.ImportThread(g.Request.Body)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	view, err := a.node.ThreadView(id)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, view)
+}
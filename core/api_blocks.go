@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/proto"
 	"github.com/textileio/go-textile/ipfs"
 	"github.com/textileio/go-textile/pb"
 )
@@ -216,7 +217,11 @@ func (a *api) getBlockFileMeta(g *gin.Context) {
 // @Param id path string true "block id"
 // @Param index path string true "file index"
 // @Param path path string true "file path"
+// @Param Range header string false "byte range, e.g., bytes=0-1023"
+// @Param If-None-Match header string false "file hash etag"
 // @Success 200 {string} byte
+// @Success 206 {string} byte
+// @Success 304 {string} string "Not Modified"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 404 {string} string "Not Found"
 func (a *api) getBlockFileContent(g *gin.Context) {
@@ -230,12 +235,14 @@ func (a *api) getBlockFileContent(g *gin.Context) {
 		sendError(g, err, http.StatusNotFound)
 		return
 	}
-	g.DataFromReader(http.StatusOK, file.Size, file.Media, reader, map[string]string{})
+	defer closeReader(reader)
+
+	serveContent(g, reader, file)
 }
 
 // rmBlocks godoc
 // @Summary Remove thread block
-// @Description Removes a thread block by ID
+// @Description Removes a thread block by ID. Only the original author can remove a block.
 // @Tags blocks
 // @Produce application/json
 // @Param id path string true "block id"
@@ -255,7 +262,12 @@ func (a *api) rmBlocks(g *gin.Context) {
 
 	hash, err := thread.AddIgnore(blockID)
 	if err != nil {
-		a.abort500(g, err)
+		switch err {
+		case ErrIgnoreTargetNotFound, ErrNotBlockAuthor:
+			g.String(http.StatusBadRequest, err.Error())
+		default:
+			a.abort500(g, err)
+		}
 		return
 	}
 
@@ -268,6 +280,68 @@ func (a *api) rmBlocks(g *gin.Context) {
 	pbJSON(g, http.StatusCreated, block)
 }
 
+// editBlocks godoc
+// @Summary Edit a thread block
+// @Description Edits a text or comment block by ID, replacing its body. Only the original
+// @Description author can edit a block. Views show the latest revision, marked as edited,
+// @Description along with all revisions.
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Param X-Textile-Args header string true "urlescaped new body"
+// @Success 201 {object} pb.Text "message or comment"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /blocks/{id}/edit [post]
+func (a *api) editBlocks(g *gin.Context) {
+	blockID := g.Param("id")
+
+	thread, err, code := getBlockThread(a.node, blockID)
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing body")
+		return
+	}
+
+	if _, err := thread.AddEdit(blockID, args[0]); err != nil {
+		switch err {
+		case ErrEditTargetNotFound, ErrNotEditable, ErrNotBlockAuthor:
+			g.String(http.StatusBadRequest, err.Error())
+		default:
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	block, err, code := getBlock(a.node, blockID)
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+	var view proto.Message
+	if block.Type == pb.Block_TEXT {
+		view, err = a.node.message(block, feedItemOpts{annotations: true})
+	} else {
+		view, err = a.node.comment(block, feedItemOpts{annotations: true})
+	}
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, view)
+}
+
 func (a *api) toDots(blocks *pb.BlockList) (string, error) {
 	dots := `digraph {
     rankdir="BT";`
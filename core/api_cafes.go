@@ -1,7 +1,9 @@
 package core
 
 import (
+	"crypto/subtle"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -110,6 +112,64 @@ func (a *api) rmCafes(g *gin.Context) {
 	g.Status(http.StatusNoContent)
 }
 
+// setCafeWebhook godoc
+// @Summary Sets an inbox webhook with a cafe
+// @Description Registers a url with a cafe, which the cafe POSTs a signed JSON notice to
+// @Description whenever it receives a message for this peer, e.g., to wake a mobile app via
+// @Description a push relay. The body is signed with the cafe's peer key (hex encoded signature
+// @Description in the X-Textile-Cafe-Sig header).
+// @Tags cafes
+// @Produce text/plain
+// @Param id path string true "cafe id"
+// @Param X-Textile-Args header string true "webhook url"
+// @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /cafes/{id}/webhook [put]
+func (a *api) setCafeWebhook(g *gin.Context) {
+	id := g.Param("id")
+
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 || args[0] == "" {
+		g.String(http.StatusBadRequest, "missing webhook url")
+		return
+	}
+
+	if err := a.node.SetCafeWebhook(id, args[0]); err != nil {
+		if err == ErrInvalidWebhook {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		a.abort500(g, err)
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// rmCafeWebhook godoc
+// @Summary Removes an inbox webhook from a cafe
+// @Description Removes the url the cafe POSTs to when it receives a message for this peer
+// @Tags cafes
+// @Param id path string true "cafe id"
+// @Success 204 {string} string "ok"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /cafes/{id}/webhook [delete]
+func (a *api) rmCafeWebhook(g *gin.Context) {
+	id := g.Param("id")
+
+	if err := a.node.SetCafeWebhook(id, ""); err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
 // checkCafeMessages godoc
 // @Summary Check for messages at all cafes
 // @Description Check for messages at all cafes. New messages are downloaded and processed
@@ -127,3 +187,189 @@ func (a *api) checkCafeMessages(g *gin.Context) {
 
 	g.String(http.StatusOK, "ok")
 }
+
+// lsCafeClients godoc
+// @Summary List the usage and quotas of cafe clients
+// @Description Lists the storage and inbox usage of all clients registered with this cafe,
+// @Description along with their quotas. A zero limit means unlimited.
+// @Tags cafe admin
+// @Produce application/json
+// @Param Authorization header string true "bearer admin token"
+// @Success 200 {object} pb.CafeClientUsageList "client usage"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /cafe/clients [get]
+func (a *api) lsCafeClients(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.node.CafeClientUsage())
+}
+
+// getCafeClients godoc
+// @Summary Inspect a cafe client
+// @Description Gets the usage, stored thread snapshot IDs, and waiting inbox messages of a
+// @Description client registered with this cafe
+// @Tags cafe admin
+// @Produce application/json
+// @Param Authorization header string true "bearer admin token"
+// @Param id path string true "client peer id"
+// @Success 200 {object} pb.CafeClientInfo "client"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Not Found"
+// @Router /cafe/clients/{id} [get]
+func (a *api) getCafeClients(g *gin.Context) {
+	info, err := a.node.CafeClientInfo(g.Param("id"))
+	if err != nil {
+		sendError(g, err, http.StatusNotFound)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, info)
+}
+
+// rmCafeClients godoc
+// @Summary Evict a cafe client
+// @Description Forcibly deregisters a client from this cafe, deleting its stored thread
+// @Description snapshots and inbox messages. The client is free to register again.
+// @Tags cafe admin
+// @Param Authorization header string true "bearer admin token"
+// @Param id path string true "client peer id"
+// @Success 204 {string} string "ok"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /cafe/clients/{id} [delete]
+func (a *api) rmCafeClients(g *gin.Context) {
+	if err := a.node.EvictCafeClient(g.Param("id")); err != nil {
+		if err == ErrCafeClientNotFound {
+			sendError(g, err, http.StatusNotFound)
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// addCafeBans godoc
+// @Summary Ban a peer from this cafe
+// @Description Bans a peer from registering with this cafe and from using existing sessions.
+// @Description Registered clients are evicted, and their account address is banned as well.
+// @Tags cafe admin
+// @Produce application/json
+// @Param Authorization header string true "bearer admin token"
+// @Param X-Textile-Args header string true "peer id"
+// @Success 201 {object} pb.CafeClientBan "ban"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /cafe/bans [post]
+func (a *api) addCafeBans(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing peer id")
+		return
+	}
+
+	ban, err := a.node.BanCafeClient(args[0])
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, ban)
+}
+
+// lsCafeBans godoc
+// @Summary List peers banned from this cafe
+// @Description Lists all peers banned from this cafe
+// @Tags cafe admin
+// @Produce application/json
+// @Param Authorization header string true "bearer admin token"
+// @Success 200 {object} pb.CafeClientBanList "bans"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Router /cafe/bans [get]
+func (a *api) lsCafeBans(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.node.CafeClientBans())
+}
+
+// rmCafeBans godoc
+// @Summary Lift a ban
+// @Description Lifts a ban, allowing the peer to register with this cafe again
+// @Tags cafe admin
+// @Param Authorization header string true "bearer admin token"
+// @Param id path string true "peer id"
+// @Success 204 {string} string "ok"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /cafe/bans/{id} [delete]
+func (a *api) rmCafeBans(g *gin.Context) {
+	if err := a.node.UnbanCafeClient(g.Param("id")); err != nil {
+		if err == ErrCafeClientBanNotFound {
+			sendError(g, err, http.StatusNotFound)
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// cafeGC godoc
+// @Summary Collect expired cafe client data
+// @Description Expires clients that have not been seen within Cafe.Host.Retention.ClientMaxAge,
+// @Description reclaiming their pins, thread snapshots, and inbox messages, and deletes inbox
+// @Description messages older than Cafe.Host.Retention.MessageMaxAge. This also runs hourly.
+// @Tags cafe admin
+// @Produce application/json
+// @Param Authorization header string true "bearer admin token"
+// @Success 200 {object} pb.CafeGCReport "report"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /cafe/gc [post]
+func (a *api) cafeGC(g *gin.Context) {
+	report, err := a.node.CollectCafeGarbage()
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, report)
+}
+
+// cafeAdmin aborts the request if the cafe admin API is disabled or the request
+// does not carry the admin token
+func (a *api) cafeAdmin(g *gin.Context) {
+	token := a.node.Config().Cafe.Host.AdminToken
+	if token == "" {
+		g.String(http.StatusForbidden, "cafe admin api is disabled, set Cafe.Host.AdminToken to enable")
+		g.Abort()
+		return
+	}
+	if !adminAuthorized(g.Request.Header.Get("Authorization"), token) {
+		g.String(http.StatusUnauthorized, "invalid admin token")
+		g.Abort()
+		return
+	}
+}
+
+// adminAuthorized returns whether or not an authorization header carries the admin token
+func adminAuthorized(header string, token string) bool {
+	auth := strings.SplitN(header, " ", 2)
+	if len(auth) < 2 || !strings.EqualFold(auth[0], "bearer") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(auth[1]), []byte(token)) == 1
+}
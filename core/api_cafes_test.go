@@ -0,0 +1,17 @@
+package core
+
+import "testing"
+
+func TestAdminAuthorized(t *testing.T) {
+	if !adminAuthorized("Bearer secret", "secret") {
+		t.Fatal("valid token should be authorized")
+	}
+	if !adminAuthorized("bearer secret", "secret") {
+		t.Fatal("scheme should be case insensitive")
+	}
+	for _, header := range []string{"", "secret", "Bearer", "Bearer wrong", "Basic secret"} {
+		if adminAuthorized(header, "secret") {
+			t.Fatalf("header should not be authorized: %s", header)
+		}
+	}
+}
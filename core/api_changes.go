@@ -0,0 +1,54 @@
+package core
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// lsChanges godoc
+// @Summary List index changes
+// @Description Lists local index changes (blocks added, ignored, or removed, files indexed or
+// @Description removed, notifications, and thread metadata) after a cursor, oldest first. Each
+// @Description change has a monotonically increasing sequence number. Pass the returned next
+// @Description cursor as since to receive only newer changes. The cursor can also be passed
+// @Description as a query parameter, e.g., /changes?since=42.
+// @Tags changes
+// @Produce application/json
+// @Param X-Textile-Opts header string false "since: Cursor to list changes after (omit for all), limit: List page size (default: 100)" default(since=0,limit=100)
+// @Success 200 {object} pb.ChangeList "changes"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /changes [get]
+func (a *api) lsChanges(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	since := opts["since"]
+	if since == "" {
+		since = g.Query("since")
+	}
+	var cursor int64
+	if since != "" {
+		cursor, err = strconv.ParseInt(since, 10, 64)
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	limit := 100
+	if opts["limit"] != "" {
+		limit, err = strconv.Atoi(opts["limit"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pbJSON(g, http.StatusOK, a.node.Changes(cursor, limit))
+}
@@ -8,7 +8,8 @@ import (
 
 // addBlockComments godoc
 // @Summary Add a comment
-// @Description Adds a comment to a thread block
+// @Description Adds a comment to a thread block. Account addresses referenced as @address
+// @Description in the body are sent as mentions.
 // @Tags blocks
 // @Produce application/json
 // @Param id path string true "block id"
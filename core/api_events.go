@@ -0,0 +1,230 @@
+package core
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/gorilla/websocket"
+	"github.com/textileio/go-textile/pb"
+)
+
+// defaultEventHeartbeat is the default interval between ping events
+const defaultEventHeartbeat = time.Second * 30
+
+// eventWriteWait is the time allowed to write an event to a websocket
+const eventWriteWait = time.Second * 10
+
+// getEvents godoc
+// @Summary Stream node events
+// @Description Streams thread updates, wallet updates, notifications, and node online/offline
+// @Description events as Server-Sent Events. Each event is named after its type in lower case
+// @Description (e.g., thread_update). A ping event is sent on an interval to keep the connection
+// @Description alive. Options can also be passed as query parameters, e.g.,
+// @Description /events?type=THREAD_UPDATE|NOTIFICATION&thread=<id>, for clients that can't set headers.
+// @Description Clients that fall too far behind are disconnected.
+// @Tags events
+// @Produce text/event-stream
+// @Param X-Textile-Opts header string false "type: Or'd list of event types (PING, THREAD_UPDATE, WALLET_UPDATE, NOTIFICATION, NODE_ONLINE, NODE_OFFLINE) or empty to include all types, thread: Only include events for this thread, ping: Seconds between ping events (0 disables)" default(type=,thread=,ping=30)
+// @Success 200 {object} pb.Event "stream of events"
+// @Failure 400 {string} string "Bad Request"
+// @Router /events [get]
+func (a *api) getEvents(g *gin.Context) {
+	types, thread, heartbeat, err := a.readEventOpts(g)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	listener := a.node.EventListener()
+	defer listener.Close()
+
+	pings, stop := eventHeartbeat(heartbeat)
+	defer stop()
+
+	g.Header("Cache-Control", "no-cache")
+	g.Header("X-Accel-Buffering", "no")
+	g.Stream(func(w io.Writer) bool {
+		var event *pb.Event
+		select {
+		case <-g.Request.Context().Done():
+			return false
+
+		case <-pings:
+			event = &pb.Event{Type: pb.Event_PING, Date: ptypes.TimestampNow()}
+
+		case value, ok := <-listener.Ch:
+			if !ok {
+				return false
+			}
+			event, ok = value.(*pb.Event)
+			if !ok {
+				return true
+			}
+		}
+		if !eventMatches(event, types, thread) {
+			return true
+		}
+
+		str, err := pbMarshaler.MarshalToString(event)
+		if err != nil {
+			log.Errorf("error marshaling event: %s", err)
+			return true
+		}
+		g.SSEvent(strings.ToLower(event.Type.String()), str)
+		return true
+	})
+}
+
+// getEventsWebSocket godoc
+// @Summary Stream node events over a WebSocket
+// @Description Upgrades the connection to a WebSocket and streams thread updates, wallet updates,
+// @Description notifications, and node online/offline events as JSON text messages. A ping event
+// @Description is sent on an interval to keep the connection alive. Messages from the client are
+// @Description ignored. Accepts the same options as /events, as a header or query parameters.
+// @Description Clients that fall too far behind are disconnected.
+// @Tags events
+// @Param X-Textile-Opts header string false "type: Or'd list of event types (PING, THREAD_UPDATE, WALLET_UPDATE, NOTIFICATION, NODE_ONLINE, NODE_OFFLINE) or empty to include all types, thread: Only include events for this thread, ping: Seconds between ping events (0 disables)" default(type=,thread=,ping=30)
+// @Success 101 {object} pb.Event "stream of events"
+// @Failure 400 {string} string "Bad Request"
+// @Router /events/ws [get]
+func (a *api) getEventsWebSocket(g *gin.Context) {
+	types, thread, heartbeat, err := a.readEventOpts(g)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	upgrader := websocket.Upgrader{CheckOrigin: a.checkEventOrigin}
+	conn, err := upgrader.Upgrade(g.Writer, g.Request, nil)
+	if err != nil {
+		// upgrader has already replied with an error
+		log.Debugf("error upgrading connection: %s", err)
+		return
+	}
+	defer conn.Close()
+
+	// discard client messages, noticing when the connection is closed
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	listener := a.node.EventListener()
+	defer listener.Close()
+
+	pings, stop := eventHeartbeat(heartbeat)
+	defer stop()
+
+	for {
+		var event *pb.Event
+		select {
+		case <-done:
+			return
+
+		case <-pings:
+			event = &pb.Event{Type: pb.Event_PING, Date: ptypes.TimestampNow()}
+
+		case value, ok := <-listener.Ch:
+			if !ok {
+				conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseGoingAway, ""),
+					time.Now().Add(eventWriteWait))
+				return
+			}
+			event, ok = value.(*pb.Event)
+			if !ok {
+				continue
+			}
+		}
+		if !eventMatches(event, types, thread) {
+			continue
+		}
+
+		str, err := pbMarshaler.MarshalToString(event)
+		if err != nil {
+			log.Errorf("error marshaling event: %s", err)
+			continue
+		}
+		conn.SetWriteDeadline(time.Now().Add(eventWriteWait))
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(str)); err != nil {
+			log.Debugf("error writing event: %s", err)
+			return
+		}
+	}
+}
+
+// readEventOpts returns event filters and the heartbeat interval from opts,
+// falling back to query parameters
+func (a *api) readEventOpts(g *gin.Context) ([]pb.Event_Type, string, time.Duration, error) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	opt := func(key string) string {
+		if val, ok := opts[key]; ok {
+			return val
+		}
+		return g.Query(key)
+	}
+
+	types, err := parseEventTypes(opt("type"))
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	thread := opt("thread")
+	if thread == "default" {
+		thread = a.node.config.Threads.Defaults.ID
+	}
+
+	heartbeat := defaultEventHeartbeat
+	if ping := opt("ping"); ping != "" {
+		secs, err := strconv.Atoi(ping)
+		if err != nil {
+			return nil, "", 0, err
+		}
+		heartbeat = time.Duration(secs) * time.Second
+	}
+
+	return types, thread, heartbeat, nil
+}
+
+// checkEventOrigin allows websocket connections from the same host
+// or from an origin allowed by the API's CORS headers
+func (a *api) checkEventOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	for _, allowed := range a.node.config.API.HTTPHeaders["Access-Control-Allow-Origin"] {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return u.Host == r.Host
+}
+
+// eventHeartbeat returns a channel that ticks on the given interval, which is nil
+// (never ticks) if the interval is not positive, and a func to stop it
+func eventHeartbeat(interval time.Duration) (<-chan time.Time, func()) {
+	if interval <= 0 {
+		return nil, func() {}
+	}
+	ticker := time.NewTicker(interval)
+	return ticker.C, ticker.Stop
+}
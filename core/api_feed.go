@@ -4,8 +4,11 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/textileio/go-textile/pb"
 )
 
@@ -25,9 +28,10 @@ import (
 // @Description * One or more annotations about a post. The newest annotation assumes the "top"
 // @Description position in the stack. Additional annotations are nested under the target.
 // @Description Newer annotations may have already been listed in the case as well.
+// @Description Filters narrow the listed blocks, while annotations nested under a listed post are not filtered.
 // @Tags feed
 // @Produce application/json
-// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default'), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5), mode: Feed mode (one of 'chrono', 'annotated', or 'stacks')" default(thread=,offset=,limit=5,mode="chrono")
+// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default'), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5), mode: Feed mode (one of 'chrono', 'annotated', or 'stacks'), author: Author peer ID, type: Or'd list of block types shown by the mode (e.g., FILES|TEXT), after: Only list blocks added at or after an RFC 3339 date, before: Only list blocks added before an RFC 3339 date, media: Only list files blocks with a file of this media type or top-level type (e.g., image/jpeg or image)" default(thread=,offset=,limit=5,mode="chrono",author=,type=,after=,before=,media=)
 // @Success 200 {object} pb.FeedItemList "feed"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 404 {string} string "Not Found"
@@ -47,6 +51,8 @@ func (a *api) lsThreadFeed(g *gin.Context) {
 		Thread: opts["thread"],
 		Mode:   pb.FeedRequest_Mode(pb.FeedRequest_Mode_value[mode]),
 		Limit:  5,
+		Author: opts["author"],
+		Media:  opts["media"],
 	}
 	if req.Thread == "default" {
 		req.Thread = a.node.config.Threads.Defaults.ID
@@ -59,6 +65,32 @@ func (a *api) lsThreadFeed(g *gin.Context) {
 		}
 	}
 
+	for _, t := range strings.Split(strings.TrimSpace(opts["type"]), "|") {
+		if t == "" {
+			continue
+		}
+		btype, ok := pbEnumForString(pb.Block_BlockType_value, t)
+		if !ok {
+			g.String(http.StatusBadRequest, "unknown block type: "+t)
+			return
+		}
+		req.Types = append(req.Types, pb.Block_BlockType(btype))
+	}
+	if opts["after"] != "" {
+		req.After, err = parseFeedDate(opts["after"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	if opts["before"] != "" {
+		req.Before, err = parseFeedDate(opts["before"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
 	if opts["limit"] != "" {
 		limit, err := strconv.Atoi(opts["limit"])
 		if err != nil {
@@ -76,3 +108,12 @@ func (a *api) lsThreadFeed(g *gin.Context) {
 
 	pbJSON(g, http.StatusOK, list)
 }
+
+// parseFeedDate parses an RFC 3339 date option
+func parseFeedDate(date string) (*timestamp.Timestamp, error) {
+	t, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return nil, err
+	}
+	return ptypes.TimestampProto(t)
+}
@@ -193,11 +193,16 @@ func (a *api) getFileMeta(g *gin.Context) {
 
 // getFileContent godoc
 // @Summary File content at hash
-// @Description Returns decrypted raw content for file
+// @Description Returns decrypted raw content for file. Supports range and
+// @Description conditional requests, with the file hash as the ETag.
 // @Tags files
 // @Produce application/octet-stream
 // @Param hash path string true "file hash"
+// @Param Range header string false "byte range, e.g., bytes=0-1023"
+// @Param If-None-Match header string false "file hash etag"
 // @Success 200 {string} byte
+// @Success 206 {string} byte
+// @Success 304 {string} string "Not Modified"
 // @Failure 404 {string} string "Not Found"
 // @Router /file/{hash}/content [get]
 func (a *api) getFileContent(g *gin.Context) {
@@ -206,6 +211,7 @@ func (a *api) getFileContent(g *gin.Context) {
 		g.String(http.StatusNotFound, err.Error())
 		return
 	}
+	defer closeReader(reader)
 
-	g.DataFromReader(http.StatusOK, file.Size, file.Media, reader, map[string]string{})
+	serveContent(g, reader, file)
 }
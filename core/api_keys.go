@@ -0,0 +1,80 @@
+package core
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/pb"
+)
+
+// rotateThreadKeys godoc
+// @Summary Rotate thread key
+// @Description Distributes a new thread key to each remaining member. Blocks added
+// @Description afterwards can't be decrypted by former members. Only the initiator and admins can rotate keys.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Success 201 {object} pb.ThreadKey "key (without secret key)"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/keys [post]
+func (a *api) rotateThreadKeys(g *gin.Context) {
+	threadId := g.Param("id")
+	if threadId == "default" {
+		threadId = a.node.config.Threads.Defaults.ID
+	}
+	thrd := a.node.Thread(threadId)
+	if thrd == nil {
+		g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+		return
+	}
+
+	hash, err := thrd.RotateKey()
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	key := a.node.datastore.ThreadKeys().Get(hash.B58String())
+	if key == nil {
+		g.String(http.StatusNotFound, ErrThreadKeyNotFound.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, &pb.ThreadKey{
+		Id:     key.Id,
+		Thread: key.Thread,
+		Epoch:  key.Epoch,
+		Date:   key.Date,
+	})
+}
+
+// rmThreadMembers godoc
+// @Summary Evict a member
+// @Description Revokes all access from an account address, removes its peers, and rotates
+// @Description the thread key. Only the initiator and admins can evict members.
+// @Tags threads
+// @Param id path string true "thread id"
+// @Param address path string true "account address"
+// @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/members/{address} [delete]
+func (a *api) rmThreadMembers(g *gin.Context) {
+	threadId := g.Param("id")
+	if threadId == "default" {
+		threadId = a.node.config.Threads.Defaults.ID
+	}
+	thrd := a.node.Thread(threadId)
+	if thrd == nil {
+		g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+		return
+	}
+
+	if _, err := thrd.Evict(g.Param("address")); err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
@@ -9,10 +9,13 @@ import (
 
 // addThreadMessages godoc
 // @Summary Add a message
-// @Description Adds a message to a thread
+// @Description Adds a message to a thread. Account addresses referenced as @address
+// @Description in the body are sent as mentions. Use reply_to to reply to another
+// @Description message in the thread.
 // @Tags threads
 // @Produce application/json
 // @Param X-Textile-Args header string true "urlescaped message body"
+// @Param X-Textile-Opts header string false "reply_to: Block ID of the message to reply to" default(reply_to=)
 // @Success 200 {object} pb.Text "message"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 404 {string} string "Not Found"
@@ -28,6 +31,11 @@ func (a *api) addThreadMessages(g *gin.Context) {
 		g.String(http.StatusBadRequest, "missing message body")
 		return
 	}
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
 
 	threadId := g.Param("id")
 	if threadId == "default" {
@@ -39,7 +47,7 @@ func (a *api) addThreadMessages(g *gin.Context) {
 		return
 	}
 
-	hash, err := thrd.AddMessage(args[0])
+	hash, err := thrd.AddReply(opts["reply_to"], args[0])
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -120,3 +128,22 @@ func (a *api) getThreadMessages(g *gin.Context) {
 
 	pbJSON(g, http.StatusOK, info)
 }
+
+// lsMessageReplies godoc
+// @Summary List message replies
+// @Description Lists the replies to a thread message by block ID
+// @Tags messages
+// @Produce application/json
+// @Param block path string true "block id"
+// @Success 200 {object} pb.TextList "replies"
+// @Failure 400 {string} string "Bad Request"
+// @Router /messages/{block}/replies [get]
+func (a *api) lsMessageReplies(g *gin.Context) {
+	list, err := a.node.Replies(g.Param("block"))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, list)
+}
@@ -173,6 +173,84 @@ func (a *api) imageExifMill(g *gin.Context) {
 	pbJSON(g, http.StatusCreated, added)
 }
 
+// videoMetaMill godoc
+// @Summary Extract meta data from a video
+// @Description Takes an input video, and extracts its duration, dimensions, codec, and rotation
+// @Description from the container (optionally encrypting output), before adding to IPFS, and returns a file object
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext=false,use="")
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/video/meta [post]
+func (a *api) videoMetaMill(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	mill := &m.VideoMeta{}
+
+	plaintext := opts["plaintext"] == "true"
+
+	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	conf.Media = "application/json"
+
+	added, err := a.node.AddFileIndex(mill, *conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, added)
+}
+
+// videoPosterMill godoc
+// @Summary Extract a poster frame from a video
+// @Description Takes an input video, and extracts a still JPEG from its embedded cover art or
+// @Description motion-JPEG track (optionally encrypting output), before adding to IPFS, and returns a file object
+// @Tags mills
+// @Accept multipart/form-data
+// @Produce application/json
+// @Param file formData file false "multipart/form-data file"
+// @Param X-Textile-Opts header string false "plaintext: whether to leave unencrypted, use: if empty, assumes body contains multipart form file data, otherwise, will attempt to fetch given CID from IPFS" default(plaintext=false,use="")
+// @Success 201 {object} pb.FileIndex "file"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /mills/video/poster [post]
+func (a *api) videoPosterMill(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	mill := &m.VideoPoster{}
+
+	plaintext := opts["plaintext"] == "true"
+
+	conf, err := a.getFileConfig(g, mill, opts["use"], plaintext)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	conf.Media = "image/jpeg"
+
+	added, err := a.node.AddFileIndex(mill, *conf)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, added)
+}
+
 // jsonMill godoc
 // @Summary Process input JSON data
 // @Description Takes an input JSON document, validates it according to its json-schema.org definition,
@@ -220,6 +298,7 @@ func (a *api) jsonMill(g *gin.Context) {
 			g.String(http.StatusBadRequest, err.Error())
 			return
 		}
+		defer closeReader(reader)
 		conf.Use = file.Checksum
 
 		conf.Input, err = ioutil.ReadAll(reader)
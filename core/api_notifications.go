@@ -2,8 +2,10 @@ package core
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/pb"
 )
 
 // lsNotifications godoc
@@ -42,3 +44,155 @@ func (a *api) readNotifications(g *gin.Context) {
 
 	g.JSON(http.StatusOK, "ok")
 }
+
+// getNotificationSettings godoc
+// @Summary Get notification settings
+// @Description Gets the notification settings of a thread, or lists account-wide settings
+// @Description followed by all thread settings. Thread settings take precedence over
+// @Description account-wide settings.
+// @Tags notifications
+// @Produce application/json
+// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default'), omit to list all settings" default(thread=)
+// @Success 200 {object} pb.NotificationSettingsList "settings"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /notifications/settings [get]
+func (a *api) getNotificationSettings(g *gin.Context) {
+	if g.Param("id") != "settings" {
+		g.String(http.StatusNotFound, "not found")
+		return
+	}
+
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	thread, ok := opts["thread"]
+	if !ok {
+		list := a.node.NotificationSettingsList()
+		if len(list.Items) == 0 || list.Items[0].Thread != "" {
+			account, err := a.node.NotificationSettings("")
+			if err != nil {
+				a.abort500(g, err)
+				return
+			}
+			list.Items = append([]*pb.NotificationSettings{account}, list.Items...)
+		}
+		pbJSON(g, http.StatusOK, list)
+		return
+	}
+
+	if thread == "default" {
+		thread = a.node.config.Threads.Defaults.ID
+	}
+	settings, err := a.node.NotificationSettings(thread)
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, settings)
+}
+
+// setNotificationSettings godoc
+// @Summary Update notification settings
+// @Description Updates the notification settings of a thread, or the account-wide settings
+// @Description if no thread is given. Muted silences all notifications, mentions only silences
+// @Description notifications not addressed to the account (e.g., invites, role changes), and
+// @Description types enables only the listed notification types. Omitted options are unchanged.
+// @Description Mentions are always allowed.
+// @Tags notifications
+// @Produce application/json
+// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default'), omit for account-wide settings, muted: Whether or not to silence all notifications, mentions_only: Whether or not to only allow notifications addressed to the account, types: Or'd list of enabled notification types (e.g., MESSAGE_ADDED|COMMENT_ADDED), empty enables all" default(thread=,muted="false",mentions_only="false",types=)
+// @Success 200 {object} pb.NotificationSettings "settings"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /notifications/settings [post]
+func (a *api) setNotificationSettings(g *gin.Context) {
+	if g.Param("id") != "settings" {
+		g.String(http.StatusNotFound, "not found")
+		return
+	}
+
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	thread := opts["thread"]
+	if thread == "default" {
+		thread = a.node.config.Threads.Defaults.ID
+	}
+	settings, err := a.node.NotificationSettings(thread)
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	if val, ok := opts["muted"]; ok {
+		settings.Muted, err = strconv.ParseBool(val)
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	if val, ok := opts["mentions_only"]; ok {
+		settings.MentionsOnly, err = strconv.ParseBool(val)
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	if val, ok := opts["types"]; ok {
+		settings.Types, err = parseNotificationTypes(val)
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	if err := a.node.SetNotificationSettings(settings); err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, settings)
+}
+
+// rmNotificationSettings godoc
+// @Summary Reset notification settings
+// @Description Removes the notification settings of a thread, which then falls back to the
+// @Description account-wide settings, or resets the account-wide settings if no thread is given.
+// @Tags notifications
+// @Produce application/json
+// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default'), omit for account-wide settings" default(thread=)
+// @Success 200 {string} string "ok"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /notifications/settings [delete]
+func (a *api) rmNotificationSettings(g *gin.Context) {
+	if g.Param("id") != "settings" {
+		g.String(http.StatusNotFound, "not found")
+		return
+	}
+
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	thread := opts["thread"]
+	if thread == "default" {
+		thread = a.node.config.Threads.Defaults.ID
+	}
+	if err := a.node.ResetNotificationSettings(thread); err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	g.JSON(http.StatusOK, "ok")
+}
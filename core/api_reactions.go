@@ -0,0 +1,153 @@
+package core
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// addBlockReactions godoc
+// @Summary Add a reaction
+// @Description Adds a reaction to a thread block. Reactions are short keys without
+// @Description whitespace, e.g., an emoji.
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Param X-Textile-Args header string true "urlescaped reaction"
+// @Success 201 {object} pb.Reaction "reaction"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /blocks/{id}/reactions [post]
+func (a *api) addBlockReactions(g *gin.Context) {
+	id := g.Param("id")
+
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing reaction")
+		return
+	}
+
+	thread, err, code := getBlockThread(a.node, id)
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	hash, err := thread.AddReaction(id, args[0])
+	if err != nil {
+		switch err {
+		case ErrInvalidReaction, ErrReactionTargetNotFound:
+			g.String(http.StatusBadRequest, err.Error())
+		default:
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	reaction, err := a.node.Reaction(hash.B58String())
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, reaction)
+}
+
+// lsBlockReactions godoc
+// @Summary List reactions
+// @Description Lists reactions on a thread block, including likes as heart reactions
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Success 200 {object} pb.ReactionList "reactions"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /blocks/{id}/reactions [get]
+func (a *api) lsBlockReactions(g *gin.Context) {
+	id := g.Param("id")
+
+	reactions, err := a.node.Reactions(id)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, reactions)
+}
+
+// rmBlockReactions godoc
+// @Summary Remove a reaction
+// @Description Removes the local account's reaction on a thread block by ignoring it.
+// @Description Likes are removed with the heart reaction.
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Param X-Textile-Args header string true "urlescaped reaction"
+// @Success 201 {object} pb.Block "ignore block"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /blocks/{id}/reactions [delete]
+func (a *api) rmBlockReactions(g *gin.Context) {
+	id := g.Param("id")
+
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing reaction")
+		return
+	}
+
+	thread, err, code := getBlockThread(a.node, id)
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	hash, err := thread.RemoveReaction(id, args[0])
+	if err != nil {
+		switch err {
+		case ErrInvalidReaction:
+			g.String(http.StatusBadRequest, err.Error())
+		case ErrReactionNotFound:
+			g.String(http.StatusNotFound, err.Error())
+		default:
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	block, err, code := getBlock(a.node, hash.B58String())
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, block)
+}
+
+// getBlockReaction godoc
+// @Summary Get thread reaction
+// @Description Gets a thread reaction or like by block ID
+// @Tags blocks
+// @Produce application/json
+// @Param id path string true "block id"
+// @Success 200 {object} pb.Reaction "reaction"
+// @Failure 400 {string} string "Bad Request"
+// @Router /blocks/{id}/reaction [get]
+func (a *api) getBlockReaction(g *gin.Context) {
+	info, err := a.node.Reaction(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, info)
+}
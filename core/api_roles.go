@@ -0,0 +1,118 @@
+package core
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/pb"
+)
+
+// addThreadRoles godoc
+// @Summary Grant a role
+// @Description Grants a role to an account address, overriding thread type and whitelist.
+// @Description Only the initiator and admins can grant roles, and only the initiator can grant admin.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param X-Textile-Args header string true "account address"
+// @Param X-Textile-Opts header string false "role: Set the role to one of 'read', 'annotate', 'write', or 'admin'" default(role=read)
+// @Success 201 {object} pb.ThreadRole "role"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/roles [post]
+func (a *api) addThreadRoles(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing account address")
+		return
+	}
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	threadId := g.Param("id")
+	if threadId == "default" {
+		threadId = a.node.config.Threads.Defaults.ID
+	}
+	thrd := a.node.Thread(threadId)
+	if thrd == nil {
+		g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+		return
+	}
+
+	role := pb.Thread_READ
+	if opts["role"] != "" {
+		role = pb.Thread_Role(pbValForEnumString(pb.Thread_Role_value, opts["role"]))
+	}
+
+	if _, err := thrd.AddRoleGrant(args[0], role); err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, &pb.ThreadRole{
+		Address: args[0],
+		Role:    role,
+	})
+}
+
+// lsThreadRoles godoc
+// @Summary List thread roles
+// @Description Lists the granted and revoked roles in a thread. Addresses not listed
+// @Description have the access given by thread type and whitelist.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Success 200 {object} pb.ThreadRoleList "roles"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/roles [get]
+func (a *api) lsThreadRoles(g *gin.Context) {
+	threadId := g.Param("id")
+	if threadId == "default" {
+		threadId = a.node.config.Threads.Defaults.ID
+	}
+	roles, err := a.node.ThreadRoles(threadId)
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, roles)
+}
+
+// rmThreadRoles godoc
+// @Summary Revoke a role
+// @Description Revokes all access from an account address, removing it from the thread members.
+// @Description Only the initiator and admins can revoke roles, and only the initiator can revoke admins.
+// @Tags threads
+// @Param id path string true "thread id"
+// @Param address path string true "account address"
+// @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Router /threads/{id}/roles/{address} [delete]
+func (a *api) rmThreadRoles(g *gin.Context) {
+	threadId := g.Param("id")
+	if threadId == "default" {
+		threadId = a.node.config.Threads.Defaults.ID
+	}
+	thrd := a.node.Thread(threadId)
+	if thrd == nil {
+		g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+		return
+	}
+
+	if _, err := thrd.AddRoleRevoke(g.Param("address")); err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
@@ -3,6 +3,8 @@ package core
 import (
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/textileio/go-textile/broadcast"
@@ -45,3 +47,71 @@ func handleSearchStream(g *gin.Context, resultCh <-chan *pb.QueryResult, errCh <
 		return true
 	})
 }
+
+// searchBlocks godoc
+// @Summary Search thread content
+// @Description Searches local thread messages, comments, and file captions, names, and metadata
+// @Description using SQLite full-text query syntax, newest first. Ignored blocks are not included.
+// @Tags search
+// @Produce application/json
+// @Param X-Textile-Args header string true "urlescaped full-text query"
+// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default', omit for all), type: Or'd list of block types (e.g., TEXT|COMMENT), author: Author peer ID, offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 10)" default(thread=,type=,author=,offset=,limit=10)
+// @Success 200 {object} pb.BlockSearchResultList "results"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /search [get]
+func (a *api) searchBlocks(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 || strings.TrimSpace(args[0]) == "" {
+		g.String(http.StatusBadRequest, "missing search query")
+		return
+	}
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	filters := &pb.BlockSearchFilters{
+		Author: opts["author"],
+		Offset: opts["offset"],
+		Limit:  10,
+	}
+	if opts["thread"] != "" {
+		filters.Thread = opts["thread"]
+		if filters.Thread == "default" {
+			filters.Thread = a.node.config.Threads.Defaults.ID
+		}
+		if a.node.Thread(filters.Thread) == nil {
+			g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+			return
+		}
+	}
+	for _, t := range strings.Split(strings.TrimSpace(opts["type"]), "|") {
+		if t != "" {
+			filters.Types = append(filters.Types,
+				pb.Block_BlockType(pbValForEnumString(pb.Block_BlockType_value, t)))
+		}
+	}
+	if opts["limit"] != "" {
+		limit, err := strconv.Atoi(opts["limit"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		filters.Limit = int32(limit)
+	}
+
+	results, err := a.node.SearchBlocks(args[0], filters)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, results)
+}
@@ -13,7 +13,7 @@ import (
 // @Summary Subscribe to thread updates
 // @Description Subscribes to updates in a thread or all threads. An update is generated
 // @Description when a new block is added to a thread. There are several update types:
-// @Description MERGE, IGNORE, FLAG, JOIN, ANNOUNCE, LEAVE, TEXT, FILES, COMMENT, LIKE
+// @Description MERGE, IGNORE, FLAG, JOIN, ANNOUNCE, LEAVE, TEXT, FILES, COMMENT, LIKE, REACTION
 // @Tags subscribe
 // @Produce application/json
 // @Param id path string false "thread id, omit to stream all events"
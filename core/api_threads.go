@@ -3,6 +3,8 @@ package core
 import (
 	"crypto/rand"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	libp2pc "github.com/libp2p/go-libp2p-crypto"
@@ -215,6 +217,145 @@ func (a *api) peersThreads(g *gin.Context) {
 	pbJSON(g, http.StatusOK, peers)
 }
 
+// dagThreads godoc
+// @Summary Walk thread history
+// @Description Streams the blocks reachable from a starting block (or HEAD) by following parents.
+// @Description Parents that aren't indexed are included with a MISSING, UNFETCHABLE, or REJECTED status.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param X-Textile-Opts header string false "start: Block ID to start from (omit for HEAD), order: Either 'reverse_chronological' or 'topological' (parents first), depth: Max parent hops from start (0 for no limit), type: Or'd list of block types (e.g., FILES|TEXT) or empty to include all types, limit: Max steps (0 for no limit), fetch: Whether to try fetching missing blocks, events: Whether to emit Server-Sent Events (SSEvent) or plain JSON" default(start=,order=reverse_chronological,depth=0,type=,limit=0,fetch="false",events="false")
+// @Success 200 {object} pb.WalkStep "stream of steps"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/dag [get]
+func (a *api) dagThreads(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	id := g.Param("id")
+	if id == "default" {
+		id = a.node.config.Threads.Defaults.ID
+	}
+	if a.node.Thread(id) == nil {
+		g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+		return
+	}
+
+	wopts := &pb.WalkOptions{
+		Start: opts["start"],
+		Order: pb.WalkOptions_Order(pbValForEnumString(pb.WalkOptions_Order_value, opts["order"])),
+	}
+	for _, t := range strings.Split(strings.TrimSpace(opts["type"]), "|") {
+		if t != "" {
+			wopts.Types = append(wopts.Types,
+				pb.Block_BlockType(pbValForEnumString(pb.Block_BlockType_value, t)))
+		}
+	}
+	if opts["depth"] != "" {
+		depth, err := strconv.Atoi(opts["depth"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		wopts.Depth = int32(depth)
+	}
+	if opts["limit"] != "" {
+		limit, err := strconv.Atoi(opts["limit"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		wopts.Limit = int32(limit)
+	}
+	wopts.Fetch = opts["fetch"] == "true"
+	events := opts["events"] == "true"
+
+	err = a.node.WalkThread(id, wopts, func(step *pb.WalkStep) error {
+		if err := g.Request.Context().Err(); err != nil {
+			return err
+		}
+		if step.Block != nil {
+			step.Block.User = a.node.PeerUser(step.Block.Author)
+		}
+
+		str, err := pbMarshaler.MarshalToString(step)
+		if err != nil {
+			return err
+		}
+		if events {
+			g.SSEvent("step", str)
+		} else {
+			g.Data(http.StatusOK, "application/json", []byte(str))
+			g.Writer.Write([]byte("\n"))
+		}
+		g.Writer.Flush()
+		return nil
+	})
+	if err != nil {
+		if g.Writer.Written() {
+			if events {
+				g.SSEvent("error", err.Error())
+			}
+			log.Warningf("error walking %s: %s", id, err)
+			return
+		}
+		if err == ErrBlockNotFound {
+			g.String(http.StatusNotFound, err.Error())
+			return
+		}
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	if !g.Writer.Written() {
+		g.Status(http.StatusOK)
+	}
+}
+
+// verifyThreads godoc
+// @Summary Verify thread integrity
+// @Description Walks a thread from HEAD, checking that each block is present, pinned,
+// @Description decryptable, and indexed, that role and key rotation blocks are validly signed,
+// @Description and that file nodes are present and pinned as the thread schema dictates. Indexed blocks not reachable from HEAD are
+// @Description reported as orphans. With repair, missing blocks are fetched, nodes are pinned,
+// @Description unindexed blocks are re-indexed, and orphans are merged into HEAD.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param X-Textile-Opts header string false "repair: Whether to repair found issues" default(repair="false")
+// @Success 200 {object} pb.ThreadVerifyReport "report"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/verify [post]
+func (a *api) verifyThreads(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	id := g.Param("id")
+	if id == "default" {
+		id = a.node.config.Threads.Defaults.ID
+	}
+	if a.node.Thread(id) == nil {
+		g.String(http.StatusNotFound, ErrThreadNotFound.Error())
+		return
+	}
+
+	report, err := a.node.VerifyThread(id, opts["repair"] == "true")
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, report)
+}
+
 // rmThreads godoc
 // @Summary Leave and remove a thread
 // @Description Leaves and removes a thread
@@ -0,0 +1,310 @@
+package core
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	cid "github.com/ipfs/go-cid"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/db"
+)
+
+// ErrInvalidThreadArchive indicates a thread archive is malformed or incomplete
+var ErrInvalidThreadArchive = fmt.Errorf("invalid thread archive")
+
+// ErrInvalidThreadArchiveSig indicates a thread archive was not signed by its account
+var ErrInvalidThreadArchiveSig = fmt.Errorf("invalid thread archive signature")
+
+// ErrThreadArchiveAccount indicates a thread archive was exported by another account
+var ErrThreadArchiveAccount = fmt.Errorf("thread archive belongs to another account")
+
+// ErrThreadArchiveTooLarge indicates a thread has too many blocks to fit in an archive
+var ErrThreadArchiveTooLarge = fmt.Errorf("thread is too large to archive")
+
+// threadArchiveVersion is the current thread archive format version
+const threadArchiveVersion = 1
+
+// maxArchiveSection is the largest allowed thread archive section
+const maxArchiveSection = 32 << 20
+
+// ExportThread writes a thread archive containing all thread blocks, file dags,
+// and the schema node. The thread keys are encrypted for the account, so that
+// the archive can only be imported by the same account.
+//
+// An archive is a sequence of length-prefixed sections, starting with a signed
+// pb.ThreadArchive header, which is followed by a cid and data section for each
+// ipfs block listed in the header.
+func (t *Textile) ExportThread(id string, w io.Writer) error {
+	thrd := t.Thread(id)
+	if thrd == nil {
+		return ErrThreadNotFound
+	}
+	mod := t.datastore.Threads().Get(thrd.Id)
+	if mod == nil {
+		return ErrThreadNotFound
+	}
+
+	ids, err := thrd.archiveCids()
+	if err != nil {
+		return err
+	}
+
+	keys, err := proto.Marshal(&pb.ThreadArchiveKeys{
+		Sk:   mod.Sk,
+		Keys: t.datastore.ThreadKeys().ListByThread(thrd.Id).Items,
+	})
+	if err != nil {
+		return err
+	}
+	ciphertext, err := t.account.Encrypt(keys)
+	if err != nil {
+		return err
+	}
+
+	mod.Sk = nil
+	archive := &pb.ThreadArchive{
+		Version: threadArchiveVersion,
+		Thread:  mod,
+		Address: t.account.Address(),
+		Keys:    ciphertext,
+		Date:    ptypes.TimestampNow(),
+	}
+	for _, id := range ids {
+		archive.Blocks = append(archive.Blocks, id.String())
+	}
+
+	data, err := proto.Marshal(archive)
+	if err != nil {
+		return err
+	}
+	archive.Sig, err = t.account.Sign(data)
+	if err != nil {
+		return err
+	}
+	header, err := proto.Marshal(archive)
+	if err != nil {
+		return err
+	}
+	// the header lists every cid, and would not be importable if too large
+	if len(header) > maxArchiveSection {
+		return ErrThreadArchiveTooLarge
+	}
+
+	bw := bufio.NewWriter(w)
+	if err := writeArchiveSection(bw, header); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		data, err := ipfs.BlockAtCid(t.node, id)
+		if err != nil {
+			return err
+		}
+		if len(data) > maxArchiveSection {
+			return ErrThreadArchiveTooLarge
+		}
+		if err := writeArchiveSection(bw, id.Bytes()); err != nil {
+			return err
+		}
+		if err := writeArchiveSection(bw, data); err != nil {
+			return err
+		}
+	}
+
+	log.Debugf("exported %d blocks from %s", len(ids), thrd.Id)
+
+	return bw.Flush()
+}
+
+// ImportThread reads a thread archive, adding its blocks to the local blockstore
+// before re-indexing the thread from its head. Blocks are not fetched from the
+// network, so an archive can be imported offline.
+func (t *Textile) ImportThread(r io.Reader) (string, error) {
+	br := bufio.NewReader(r)
+
+	header, err := readArchiveSection(br)
+	if err != nil {
+		return "", err
+	}
+	archive := new(pb.ThreadArchive)
+	if err := proto.Unmarshal(header, archive); err != nil {
+		return "", err
+	}
+	if archive.Version != threadArchiveVersion || archive.Thread == nil || archive.Thread.Head == "" {
+		return "", ErrInvalidThreadArchive
+	}
+	if archive.Address != t.account.Address() {
+		return "", ErrThreadArchiveAccount
+	}
+
+	sig := archive.Sig
+	archive.Sig = nil
+	data, err := proto.Marshal(archive)
+	if err != nil {
+		return "", err
+	}
+	if err := t.account.Verify(data, sig); err != nil {
+		return "", ErrInvalidThreadArchiveSig
+	}
+
+	plaintext, err := t.account.Decrypt(archive.Keys)
+	if err != nil {
+		return "", err
+	}
+	keys := new(pb.ThreadArchiveKeys)
+	if err := proto.Unmarshal(plaintext, keys); err != nil {
+		return "", err
+	}
+
+	// only blocks listed in the signed header are accepted
+	pending := make(map[string]struct{})
+	for _, id := range archive.Blocks {
+		pending[id] = struct{}{}
+	}
+	for len(pending) > 0 {
+		idb, err := readArchiveSection(br)
+		if err != nil {
+			if err == io.EOF {
+				return "", ErrInvalidThreadArchive
+			}
+			return "", err
+		}
+		data, err := readArchiveSection(br)
+		if err != nil {
+			if err == io.EOF {
+				return "", ErrInvalidThreadArchive
+			}
+			return "", err
+		}
+
+		id, err := cid.Cast(idb)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := pending[id.String()]; !ok {
+			return "", ErrInvalidThreadArchive
+		}
+		if err := ipfs.PutBlock(t.node, id, data); err != nil {
+			return "", err
+		}
+		delete(pending, id.String())
+	}
+
+	thrd := archive.Thread
+	thrd.Sk = keys.Sk
+	for _, key := range keys.Keys {
+		if err := t.datastore.ThreadKeys().Add(key); err != nil {
+			if !db.ConflictError(err) {
+				return "", err
+			}
+		}
+	}
+
+	if err := t.AddOrUpdateThread(thrd); err != nil {
+		return "", err
+	}
+	if err := t.pinArchiveRoots(thrd.Id, archive.Blocks); err != nil {
+		return "", err
+	}
+
+	log.Debugf("imported %d blocks into %s", len(archive.Blocks), thrd.Id)
+
+	return thrd.Id, nil
+}
+
+// pinArchiveRoots pins imported thread blocks and file roots, so that they survive
+// repo gc. File links are pinned by schema when files blocks are handled.
+func (t *Textile) pinArchiveRoots(threadId string, blocks []string) error {
+	imported := make(map[string]struct{})
+	for _, id := range blocks {
+		imported[id] = struct{}{}
+	}
+
+	query := fmt.Sprintf("threadId='%s'", threadId)
+	for _, block := range t.datastore.Blocks().List("", -1, query).Items {
+		roots := []string{block.Id}
+		if block.Type == pb.Block_FILES {
+			roots = append(roots, block.Target)
+		}
+		for _, root := range roots {
+			id, err := cid.Decode(root)
+			if err != nil {
+				return err
+			}
+			if _, ok := imported[id.String()]; !ok {
+				continue
+			}
+			if err := ipfs.PinCid(t.node, id, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// archiveCids returns the cids of each ipfs block needed to rebuild the thread,
+// including the schema node, thread blocks, and file dags
+func (t *Thread) archiveCids() ([]cid.Cid, error) {
+	var roots []string
+	if t.schemaId != "" {
+		roots = append(roots, t.schemaId)
+	}
+	query := fmt.Sprintf("threadId='%s'", t.Id)
+	for _, block := range t.datastore.Blocks().List("", -1, query).Items {
+		roots = append(roots, block.Id)
+		if block.Type == pb.Block_FILES {
+			roots = append(roots, block.Target)
+		}
+	}
+
+	var list []cid.Cid
+	seen := make(map[string]struct{})
+	for _, root := range roots {
+		id, err := cid.Decode(root)
+		if err != nil {
+			return nil, err
+		}
+		ids, err := ipfs.DagCids(t.node(), id, seen)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, ids...)
+	}
+	return list, nil
+}
+
+// writeArchiveSection writes a length-prefixed archive section
+func writeArchiveSection(w io.Writer, data []byte) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, uint64(len(data)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// readArchiveSection reads a length-prefixed archive section, returning io.EOF
+// if there are no more sections
+func readArchiveSection(r *bufio.Reader) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxArchiveSection {
+		return nil, ErrInvalidThreadArchive
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return data, nil
+}
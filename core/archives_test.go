@@ -0,0 +1,198 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	libp2pc "github.com/libp2p/go-libp2p-crypto"
+	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/keypair"
+	"github.com/textileio/go-textile/mill"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/schema/textile"
+)
+
+func TestArchiveSections(t *testing.T) {
+	sections := [][]byte{[]byte("header"), {}, bytes.Repeat([]byte("x"), 1024)}
+
+	var buf bytes.Buffer
+	for _, s := range sections {
+		if err := writeArchiveSection(&buf, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := bufio.NewReader(&buf)
+	for i, s := range sections {
+		data, err := readArchiveSection(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, s) {
+			t.Errorf("section %d does not match", i)
+		}
+	}
+	if _, err := readArchiveSection(r); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+}
+
+func TestArchiveSectionsInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeArchiveSection(&buf, []byte("truncated")); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()[:buf.Len()-1]
+	if _, err := readArchiveSection(bufio.NewReader(bytes.NewReader(data))); err != io.ErrUnexpectedEOF {
+		t.Errorf("truncated: expected %s, got %v", io.ErrUnexpectedEOF, err)
+	}
+
+	size := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(size, maxArchiveSection+1)
+	if _, err := readArchiveSection(bufio.NewReader(bytes.NewReader(size[:n]))); err != ErrInvalidThreadArchive {
+		t.Errorf("oversized: expected %s, got %v", ErrInvalidThreadArchive, err)
+	}
+}
+
+func TestTextile_ExportImportThread(t *testing.T) {
+	account := keypair.Random()
+
+	// export from one node
+	exporter := newArchiveTestNode(t, "testdata/.textile_export", account)
+	defer os.RemoveAll("testdata/.textile_export")
+	defer exporter.Stop()
+
+	schema, err := exporter.AddSchema(textile.Blob, "blob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thrd, err := exporter.AddThread(pb.AddThreadConfig{
+		Key:     ksuid.New().String(),
+		Name:    "archive",
+		Schema:  &pb.AddThreadConfig_Schema{Id: schema.Hash},
+		Type:    pb.Thread_PRIVATE,
+		Sharing: pb.Thread_NOT_SHARED,
+	}, sk, account.Address(), true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := thrd.AddMessage("hello"); err != nil {
+		t.Fatal(err)
+	}
+	content := []byte("archived file content")
+	file, err := exporter.AddFileIndex(&mill.Blob{}, AddFileConfig{
+		Input: content,
+		Name:  "file.txt",
+		Media: "text/plain",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dag, keys, err := exporter.AddNodeFromFiles([]*pb.FileIndex{file})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := thrd.AddFiles(dag, "caption", keys.Files); err != nil {
+		t.Fatal(err)
+	}
+	blocks := exporter.datastore.Blocks().List("", -1, fmt.Sprintf("threadId='%s'", thrd.Id)).Items
+
+	var archive bytes.Buffer
+	if err := exporter.ExportThread(thrd.Id, &archive); err != nil {
+		t.Fatalf("export thread failed: %s", err)
+	}
+	exporter.Stop()
+
+	// import into a fresh node for the same account
+	importer := newArchiveTestNode(t, "testdata/.textile_import", account)
+	defer os.RemoveAll("testdata/.textile_import")
+	defer importer.Stop()
+
+	id, err := importer.ImportThread(bytes.NewReader(archive.Bytes()))
+	if err != nil {
+		t.Fatalf("import thread failed: %s", err)
+	}
+	if id != thrd.Id || importer.Thread(id) == nil {
+		t.Fatal("thread was not imported")
+	}
+
+	for _, block := range blocks {
+		if importer.datastore.Blocks().Get(block.Id) == nil {
+			t.Errorf("block %s was not restored", block.Id)
+		}
+	}
+
+	msgs, err := importer.Messages("", -1, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs.Items) != 1 || msgs.Items[0].Body != "hello" {
+		t.Fatalf("message was not restored: %v", msgs.Items)
+	}
+
+	files, err := importer.Files("", -1, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files.Items) != 1 || len(files.Items[0].Files) != 1 {
+		t.Fatalf("files were not restored: %v", files.Items)
+	}
+	reader, err := importer.FileIndexContent(files.Items[0].Files[0].File)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored, err := ioutil.ReadAll(reader)
+	closeReader(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(restored, content) {
+		t.Fatal("file content was not restored")
+	}
+	importer.Stop()
+
+	// another account can't import it
+	other := newArchiveTestNode(t, "testdata/.textile_import_other", keypair.Random())
+	defer os.RemoveAll("testdata/.textile_import_other")
+	defer other.Stop()
+
+	if _, err := other.ImportThread(bytes.NewReader(archive.Bytes())); err != ErrThreadArchiveAccount {
+		t.Fatalf("expected %s, got %v", ErrThreadArchiveAccount, err)
+	}
+	if other.Thread(id) != nil {
+		t.Fatal("thread should not have been imported by another account")
+	}
+}
+
+// newArchiveTestNode starts a fresh node for account
+func newArchiveTestNode(t *testing.T, repoPath string, account *keypair.Full) *Textile {
+	_ = os.RemoveAll(repoPath)
+	if err := InitRepo(InitConfig{
+		Account:  account,
+		RepoPath: repoPath,
+		ApiAddr:  fmt.Sprintf("127.0.0.1:%s", GetRandomPort()),
+	}); err != nil {
+		t.Fatalf("init node failed: %s", err)
+	}
+	node, err := NewTextile(RunConfig{RepoPath: repoPath})
+	if err != nil {
+		t.Fatalf("create node failed: %s", err)
+	}
+	if err := node.Start(); err != nil {
+		t.Fatalf("start node failed: %s", err)
+	}
+	<-node.OnlineCh()
+	return node
+}
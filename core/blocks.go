@@ -2,6 +2,8 @@ package core
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/textileio/go-textile/pb"
 )
@@ -9,6 +11,13 @@ import (
 // ErrBlockNotFound indicates a block was not found in the index
 var ErrBlockNotFound = fmt.Errorf("block not found")
 
+// searchableBlockTypes are the block types with a full-text indexed body
+var searchableBlockTypes = map[pb.Block_BlockType]struct{}{
+	pb.Block_TEXT:    {},
+	pb.Block_FILES:   {},
+	pb.Block_COMMENT: {},
+}
+
 // GetBlocks paginates blocks
 func (t *Textile) Blocks(offset string, limit int, query string) *pb.BlockList {
 	filtered := &pb.BlockList{Items: make([]*pb.Block, 0)}
@@ -47,3 +56,50 @@ func (t *Textile) BlockView(id string) (*pb.Block, error) {
 	block.User = t.PeerUser(block.Author)
 	return block, nil
 }
+
+// SearchBlocks returns blocks matching a full-text query, newest first. Message,
+// comment, and file caption bodies are matched, as are the names and metadata of
+// files targeted by files blocks. Ignored blocks are not included.
+func (t *Textile) SearchBlocks(query string, filters *pb.BlockSearchFilters) (*pb.BlockSearchResultList, error) {
+	if filters == nil {
+		filters = &pb.BlockSearchFilters{}
+	}
+
+	conds := []string{"('ignore-'||id) not in (select target from blocks where type=" +
+		strconv.Itoa(int(pb.Block_IGNORE)) + ")"}
+	var args []interface{}
+	if filters.Thread != "" {
+		conds = append(conds, "threadId=?")
+		args = append(args, filters.Thread)
+	}
+	if filters.Author != "" {
+		conds = append(conds, "authorId=?")
+		args = append(args, filters.Author)
+	}
+	if len(filters.Types) > 0 {
+		var types []string
+		for _, btype := range filters.Types {
+			types = append(types, strconv.Itoa(int(btype)))
+		}
+		conds = append(conds, "type in ("+strings.Join(types, ",")+")")
+	}
+
+	limit := int(filters.Limit)
+	if limit <= 0 {
+		limit = -1
+	}
+
+	items, err := t.datastore.SearchIndex().SearchBlocks(query, filters.Offset, limit, strings.Join(conds, " and "), args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		item.Block.User = t.PeerUser(item.Block.Author)
+	}
+
+	list := &pb.BlockSearchResultList{Items: items}
+	if limit > 0 && len(items) == limit {
+		list.Next = items[len(items)-1].Block.Id
+	}
+	return list, nil
+}
@@ -0,0 +1,138 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+)
+
+// ErrCafeClientNotFound indicates a cafe client is not registered with this cafe
+var ErrCafeClientNotFound = fmt.Errorf("cafe client not found")
+
+// ErrCafeClientBanNotFound indicates a peer is not banned from this cafe
+var ErrCafeClientBanNotFound = fmt.Errorf("cafe client ban not found")
+
+// CafeClientInfo returns the usage, stored thread snapshots, and waiting inbox messages
+// of a registered cafe client
+func (t *Textile) CafeClientInfo(id string) (*pb.CafeClientInfo, error) {
+	client := t.datastore.CafeClients().Get(id)
+	if client == nil {
+		return nil, ErrCafeClientNotFound
+	}
+
+	info := &pb.CafeClientInfo{
+		Usage:    clientUsage(t.datastore, t.config.Cafe.Host, client),
+		Threads:  make([]string, 0),
+		Messages: make([]*pb.CafeClientMessage, 0),
+	}
+	for _, thrd := range t.datastore.CafeClientThreads().ListByClient(id) {
+		info.Threads = append(info.Threads, thrd.Id)
+	}
+	for _, msg := range t.datastore.CafeClientMessages().ListByClient(id, -1) {
+		msg := msg
+		info.Messages = append(info.Messages, &msg)
+	}
+	return info, nil
+}
+
+// EvictCafeClient forcibly deregisters a cafe client, deleting its stored thread snapshots
+// and inbox messages, and unpinning objects no longer stored for other clients. The client
+// is free to register again.
+func (t *Textile) EvictCafeClient(id string) error {
+	if t.datastore.CafeClients().Get(id) == nil {
+		return ErrCafeClientNotFound
+	}
+	return t.cafe.removeClient(id)
+}
+
+// BanCafeClient bans a peer from registering with this cafe, and from using existing
+// sessions. Registered clients are evicted, and their account address is banned as well.
+func (t *Textile) BanCafeClient(id string) (*pb.CafeClientBan, error) {
+	ban := &pb.CafeClientBan{
+		Id:   id,
+		Date: ptypes.TimestampNow(),
+	}
+	client := t.datastore.CafeClients().Get(id)
+	if client != nil {
+		ban.Address = client.Address
+	}
+	if err := t.datastore.CafeClientBans().Add(ban); err != nil {
+		return nil, err
+	}
+
+	if client != nil {
+		if err := t.cafe.removeClient(id); err != nil {
+			return nil, err
+		}
+	}
+	return ban, nil
+}
+
+// CafeClientBans lists all peers banned from this cafe
+func (t *Textile) CafeClientBans() *pb.CafeClientBanList {
+	list := &pb.CafeClientBanList{Items: make([]*pb.CafeClientBan, 0)}
+	for _, ban := range t.datastore.CafeClientBans().List() {
+		ban := ban
+		list.Items = append(list.Items, &ban)
+	}
+	return list
+}
+
+// UnbanCafeClient lifts a ban, allowing the peer to register again
+func (t *Textile) UnbanCafeClient(id string) error {
+	if t.datastore.CafeClientBans().Get(id) == nil {
+		return ErrCafeClientBanNotFound
+	}
+	return t.datastore.CafeClientBans().Delete(id)
+}
+
+// removeClient deletes a client along with all of its data, and replicates its removal
+func (h *CafeService) removeClient(id string) error {
+	if err := h.deleteClient(id); err != nil {
+		return err
+	}
+	ts, err := h.bury(id, id, pb.CafeClientTombstone_CLIENT, ptypes.TimestampNow())
+	if err != nil {
+		return err
+	}
+	h.replicate(&pb.CafeReplicate{
+		Client:     &pb.CafeClient{Id: id},
+		Tombstones: []*pb.CafeClientTombstone{ts},
+	})
+	return nil
+}
+
+// deleteClient deletes a client along with its stored thread snapshots, inbox messages,
+// pin records, and tombstones, unpinning objects no longer stored for other clients
+func (h *CafeService) deleteClient(id string) error {
+	for _, pin := range h.datastore.CafeClientPins().ListByClient(id) {
+		if _, err := h.releasePin(id, pin.Cid); err != nil {
+			log.Warningf("error releasing pin %s: %s", pin.Cid, err)
+		}
+	}
+	if err := h.datastore.CafeClientThreads().DeleteByClient(id); err != nil {
+		return fmt.Errorf("delete client threads failed: %s", err)
+	}
+	if err := h.datastore.CafeClientMessages().DeleteByClient(id, -1); err != nil {
+		return fmt.Errorf("delete client messages failed: %s", err)
+	}
+	if err := h.datastore.CafeClientPins().DeleteByClient(id); err != nil {
+		return fmt.Errorf("delete client pins failed: %s", err)
+	}
+	if err := h.datastore.CafeClientTombstones().DeleteByClient(id); err != nil {
+		return fmt.Errorf("delete client tombstones failed: %s", err)
+	}
+	if err := h.datastore.CafeClients().Delete(id); err != nil {
+		return fmt.Errorf("delete client failed: %s", err)
+	}
+	return nil
+}
+
+// banned returns whether or not a peer or account address is banned from this cafe
+func (h *CafeService) banned(id string, address string) bool {
+	if h.datastore.CafeClientBans().Get(id) != nil {
+		return true
+	}
+	return address != "" && len(h.datastore.CafeClientBans().ListByAddress(address)) > 0
+}
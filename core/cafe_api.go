@@ -162,7 +162,23 @@ func (c *cafeApi) validateToken(g *gin.Context) {
 		case jwt.ErrInvalid:
 			c.abort(g, http.StatusForbidden, nil)
 		}
+		return
+	}
+
+	// the token subject is the client peer id, used for bans and quota accounting
+	parsed, _ := njwt.Parse(token, c.verifyKeyFunc)
+	if parsed == nil {
+		return
+	}
+	claims, err := jwt.ParseClaims(parsed.Claims)
+	if err != nil {
+		return
+	}
+	if c.node.cafe.banned(claims.Subject, "") {
+		c.abort(g, http.StatusForbidden, nil)
+		return
 	}
+	g.Set("client", claims.Subject)
 }
 
 // verifyKeyFunc returns the correct key for token verification
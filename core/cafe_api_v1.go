@@ -22,13 +22,22 @@ func (c *cafeApi) store(g *gin.Context) {
 		return
 	}
 	hash := id.Hash().B58String()
+	client := g.GetString("client")
+
+	if g.Request.ContentLength > 0 {
+		if err := c.node.cafe.checkStorage(client, g.Request.ContentLength); err != nil {
+			c.abort(g, http.StatusInsufficientStorage, err)
+			return
+		}
+	}
+	body := &countingReader{r: g.Request.Body}
 
 	var aid *cid.Cid
 	switch g.Request.Header.Get("X-Textile-Store-Type") {
 	case "data":
-		aid, err = ipfs.AddData(c.node.Ipfs(), g.Request.Body, true)
+		aid, err = ipfs.AddData(c.node.Ipfs(), body, true)
 	case "object":
-		aid, err = ipfs.AddObject(c.node.Ipfs(), g.Request.Body, true)
+		aid, err = ipfs.AddObject(c.node.Ipfs(), body, true)
 	default:
 		c.abort(g, http.StatusBadRequest, fmt.Errorf("missing store type header"))
 		return
@@ -46,6 +55,24 @@ func (c *cafeApi) store(g *gin.Context) {
 		return
 	}
 
+	// content length may be missing or wrong, check the actual size
+	if err := c.node.cafe.checkStorage(client, body.n); err != nil {
+		// leave data stored for other clients pinned
+		if c.node.datastore.CafeClientPins().CountByCid(rhash) == 0 {
+			if err := ipfs.UnpinCid(c.node.Ipfs(), *aid, true); err != nil {
+				log.Warningf("error unpinning %s: %s", rhash, err)
+			}
+		}
+		c.abort(g, http.StatusInsufficientStorage, err)
+		return
+	}
+	if client != "" {
+		if err := c.node.cafe.recordPin(client, rhash, body.n); err != nil {
+			c.abort(g, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
 	g.Status(http.StatusNoContent)
 }
 
@@ -62,14 +89,17 @@ func (c *cafeApi) unstore(g *gin.Context) {
 		return
 	}
 
+	// data stored for other clients stays pinned
+	var ids []string
 	for _, p := range pinned {
 		if p.Mode != pin.NotPinned {
-			if err := ipfs.UnpinCid(c.node.Ipfs(), p.Key, true); err != nil {
-				c.abort(g, http.StatusBadRequest, err)
-				return
-			}
+			ids = append(ids, p.Key.Hash().B58String())
 		}
 	}
+	if err := c.node.cafe.unstorePins(g.GetString("client"), ids); err != nil {
+		c.abort(g, http.StatusBadRequest, err)
+		return
+	}
 
 	g.Status(http.StatusNoContent)
 }
@@ -97,15 +127,22 @@ func (c *cafeApi) storeThread(g *gin.Context) {
 		return
 	}
 
+	if err := c.node.cafe.checkThreadStorage(client.Id, id, buf.Bytes()); err != nil {
+		c.abort(g, http.StatusInsufficientStorage, err)
+		return
+	}
+
 	thrd := &pb.CafeClientThread{
 		Id:         id,
 		Client:     client.Id,
 		Ciphertext: buf.Bytes(),
+		Date:       ptypes.TimestampNow(),
 	}
 	if err := c.node.datastore.CafeClientThreads().AddOrUpdate(thrd); err != nil {
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
+	c.node.cafe.replicateClient(client.Id, &pb.CafeReplicate{Threads: []*pb.CafeClientThread{thrd}})
 
 	g.Status(http.StatusNoContent)
 }
@@ -120,7 +157,7 @@ func (c *cafeApi) unstoreThread(g *gin.Context) {
 		return
 	}
 
-	if err := c.node.datastore.CafeClientThreads().Delete(id, client.Id); err != nil {
+	if err := c.node.cafe.unstoreClientThread(client.Id, id); err != nil {
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
@@ -139,6 +176,12 @@ func (c *cafeApi) deliverMessage(g *gin.Context) {
 		return
 	}
 
+	if err := c.node.cafe.checkInbox(client); err != nil {
+		log.Warningf("dropping message from %s for client %s: %s", pid, client.Id, err)
+		c.abort(g, http.StatusInsufficientStorage, err)
+		return
+	}
+
 	// message id is the request body
 	buf := bodyPool.Get().(*bytes.Buffer)
 	defer func() {
@@ -169,6 +212,8 @@ func (c *cafeApi) deliverMessage(g *gin.Context) {
 		c.abort(g, http.StatusInternalServerError, err)
 		return
 	}
+	c.node.cafe.replicateClient(client.Id, &pb.CafeReplicate{Messages: []*pb.CafeClientMessage{message}})
+	c.node.cafe.pushWebhook(client, message)
 
 	go func() {
 		cpid, err := peer.IDB58Decode(client.Id)
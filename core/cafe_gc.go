@@ -0,0 +1,160 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/util"
+)
+
+// kCafeGCFreq how often to collect expired cafe client data and sync replica cafes
+const kCafeGCFreq = time.Hour
+
+// CollectCafeGarbage expires clients that have not been seen within the configured
+// client max age, reclaiming their pins, thread snapshots, and inbox messages, and
+// deletes inbox messages older than the configured message max age
+func (t *Textile) CollectCafeGarbage() (*pb.CafeGCReport, error) {
+	return t.cafe.collectGarbage(time.Now())
+}
+
+// runCafeJobs periodically collects expired cafe client data and syncs replica cafes
+func (t *Textile) runCafeJobs() {
+	tick := time.NewTicker(kCafeGCFreq)
+	defer tick.Stop()
+
+	t.maybeCollectCafeGarbage()
+	t.cafe.syncReplicas()
+
+	for {
+		select {
+		case <-tick.C:
+			t.maybeCollectCafeGarbage()
+			t.cafe.syncReplicas()
+
+		case <-t.done:
+			return
+		}
+	}
+}
+
+// maybeCollectCafeGarbage collects expired cafe client data, logging what was reclaimed
+func (t *Textile) maybeCollectCafeGarbage() {
+	report, err := t.CollectCafeGarbage()
+	if err != nil {
+		log.Errorf("error collecting cafe garbage: %s", err)
+		return
+	}
+	if len(report.Clients) > 0 || report.Messages > 0 {
+		log.Infof("cafe gc: expired %d clients, dropped %d threads and %d messages, unpinned %d objects, reclaimed %d bytes",
+			len(report.Clients), report.Threads, report.Messages, report.Unpinned, report.Reclaimed)
+	}
+}
+
+// collectGarbage expires clients and deletes inbox messages based on the host's
+// retention settings
+func (h *CafeService) collectGarbage(now time.Time) (*pb.CafeGCReport, error) {
+	h.gcMux.Lock()
+	defer h.gcMux.Unlock()
+
+	clientMaxAge, messageMaxAge, err := retentionAges(h.host.Retention)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &pb.CafeGCReport{
+		Clients: make([]string, 0),
+		Date:    ptypes.TimestampNow(),
+	}
+
+	if clientMaxAge > 0 {
+		for _, client := range expiredClients(h.datastore.CafeClients().List(), clientMaxAge, now) {
+			if err := h.expireClient(client.Id, report); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if messageMaxAge > 0 {
+		before := now.Add(-messageMaxAge)
+		count, err := h.datastore.CafeClientMessages().DeleteBefore(before)
+		if err != nil {
+			return nil, err
+		}
+		report.Messages += int32(count)
+
+		// so replicas drop them too
+		newest := util.ProtoTs(before.UnixNano() - 1)
+		for _, client := range h.datastore.CafeClients().List() {
+			if _, err := h.bury(client.Id, client.Id, pb.CafeClientTombstone_MESSAGES, newest); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if _, err := h.datastore.CafeClientTombstones().DeleteBefore(now.Add(-kCafeTombstoneMaxAge)); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// expireClient unpins objects no longer stored for other clients, and deletes a client
+// along with its thread snapshots and inbox messages, adding what was reclaimed to report
+func (h *CafeService) expireClient(id string, report *pb.CafeGCReport) error {
+	for _, pin := range h.datastore.CafeClientPins().ListByClient(id) {
+		unpinned, err := h.releasePin(id, pin.Cid)
+		if err != nil {
+			log.Warningf("error releasing pin %s: %s", pin.Cid, err)
+			continue
+		}
+		if unpinned {
+			report.Unpinned++
+			report.Reclaimed += pin.Size
+		}
+	}
+
+	report.Threads += int32(len(h.datastore.CafeClientThreads().ListByClient(id)))
+	report.Reclaimed += h.datastore.CafeClientThreads().SizeByClient(id)
+	report.Messages += int32(h.datastore.CafeClientMessages().CountByClient(id))
+
+	if err := h.removeClient(id); err != nil {
+		return err
+	}
+	report.Clients = append(report.Clients, id)
+	return nil
+}
+
+// retentionAges parses the client and message max ages of retention settings,
+// returning zero durations for empty values
+func retentionAges(retention config.CafeRetention) (time.Duration, time.Duration, error) {
+	var clientMaxAge, messageMaxAge time.Duration
+	var err error
+	if retention.ClientMaxAge != "" {
+		clientMaxAge, err = time.ParseDuration(retention.ClientMaxAge)
+		if err != nil || clientMaxAge <= 0 {
+			return 0, 0, fmt.Errorf("invalid client max age: %s", retention.ClientMaxAge)
+		}
+	}
+	if retention.MessageMaxAge != "" {
+		messageMaxAge, err = time.ParseDuration(retention.MessageMaxAge)
+		if err != nil || messageMaxAge <= 0 {
+			return 0, 0, fmt.Errorf("invalid message max age: %s", retention.MessageMaxAge)
+		}
+	}
+	return clientMaxAge, messageMaxAge, nil
+}
+
+// expiredClients returns the clients that have not been seen within maxAge of now
+func expiredClients(clients []pb.CafeClient, maxAge time.Duration, now time.Time) []pb.CafeClient {
+	cutoff := now.Add(-maxAge).UnixNano()
+	var expired []pb.CafeClient
+	for _, client := range clients {
+		if util.ProtoNanos(client.Seen) < cutoff {
+			expired = append(expired, client)
+		}
+	}
+	return expired
+}
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
+	"github.com/textileio/go-textile/util"
+)
+
+func TestRetentionAges(t *testing.T) {
+	clientMaxAge, messageMaxAge, err := retentionAges(config.CafeRetention{
+		ClientMaxAge:  "720h",
+		MessageMaxAge: "",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clientMaxAge != time.Hour*720 {
+		t.Fatalf("wrong client max age: %s", clientMaxAge)
+	}
+	if messageMaxAge != 0 {
+		t.Fatalf("empty message max age should be zero: %s", messageMaxAge)
+	}
+
+	for _, retention := range []config.CafeRetention{
+		{ClientMaxAge: "30 days"},
+		{MessageMaxAge: "-1h"},
+	} {
+		if _, _, err := retentionAges(retention); err == nil {
+			t.Fatalf("retention should be invalid: %+v", retention)
+		}
+	}
+}
+
+func TestExpiredClients(t *testing.T) {
+	now := time.Now()
+	clients := []pb.CafeClient{
+		{Id: "active", Seen: util.ProtoTs(now.Add(-time.Hour).UnixNano())},
+		{Id: "idle", Seen: util.ProtoTs(now.Add(-time.Hour * 48).UnixNano())},
+		{Id: "new", Seen: util.ProtoTs(now.UnixNano())},
+	}
+
+	expired := expiredClients(clients, time.Hour*24, now)
+	if len(expired) != 1 || expired[0].Id != "idle" {
+		t.Fatalf("wrong expired clients: %v", expired)
+	}
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/golang/protobuf/ptypes"
+	cid "github.com/ipfs/go-cid"
+	"github.com/mr-tron/base58/base58"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/repo/config"
+)
+
+// ErrQuotaExceeded indicates a cafe client has reached its storage or inbox quota
+var ErrQuotaExceeded = fmt.Errorf("quota exceeded")
+
+// CafeClientUsage lists the storage and inbox usage of all registered cafe clients,
+// along with their quotas
+func (t *Textile) CafeClientUsage() *pb.CafeClientUsageList {
+	list := &pb.CafeClientUsageList{Items: make([]*pb.CafeClientUsage, 0)}
+	for _, client := range t.datastore.CafeClients().List() {
+		client := client
+		list.Items = append(list.Items, clientUsage(t.datastore, t.config.Cafe.Host, &client))
+	}
+	return list
+}
+
+// checkStorage returns ErrQuotaExceeded if storing size more bytes for a client
+// would exceed its storage quota
+func (h *CafeService) checkStorage(clientId string, size int64) error {
+	client := h.datastore.CafeClients().Get(clientId)
+	if client == nil {
+		return nil
+	}
+	if !storageAllowed(clientUsage(h.datastore, h.host, client), size) {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+// checkThreadStorage returns ErrQuotaExceeded if replacing a client's thread snapshot
+// with ciphertext would exceed its storage quota
+func (h *CafeService) checkThreadStorage(clientId string, threadId string, ciphertext []byte) error {
+	size := int64(len(ciphertext))
+	for _, thrd := range h.datastore.CafeClientThreads().ListByClient(clientId) {
+		if thrd.Id == threadId {
+			size -= int64(len(thrd.Ciphertext))
+			break
+		}
+	}
+	if size <= 0 {
+		return nil
+	}
+	return h.checkStorage(clientId, size)
+}
+
+// checkInbox returns ErrQuotaExceeded if a client's inbox is full
+func (h *CafeService) checkInbox(client *pb.CafeClient) error {
+	if !inboxAllowed(clientUsage(h.datastore, h.host, client)) {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+// recordPin accounts size bytes pinned for a client, and replicates the pin
+func (h *CafeService) recordPin(clientId string, id string, size int64) error {
+	pin := &pb.CafeClientPin{
+		Cid:    id,
+		Client: clientId,
+		Size:   size,
+		Date:   ptypes.TimestampNow(),
+	}
+	if err := h.datastore.CafeClientPins().AddOrUpdate(pin); err != nil {
+		return err
+	}
+	h.replicateClient(clientId, &pb.CafeReplicate{Pins: []*pb.CafeClientPin{pin}})
+	return nil
+}
+
+// releasePin deletes a client's pin record, unpinning the cid if it's not stored for
+// any other client. Returns whether or not the cid was unpinned.
+func (h *CafeService) releasePin(clientId string, id string) (bool, error) {
+	if err := h.datastore.CafeClientPins().Delete(id, clientId); err != nil {
+		return false, err
+	}
+	if h.datastore.CafeClientPins().CountByCid(id) > 0 {
+		return false, nil
+	}
+	dec, err := cid.Decode(id)
+	if err != nil {
+		return false, err
+	}
+	if err := ipfs.UnpinCid(h.service.Node(), dec, true); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// clientUsage returns the storage and inbox usage of a client along with its quota
+func clientUsage(datastore repo.Datastore, host config.CafeHost, client *pb.CafeClient) *pb.CafeClientUsage {
+	quota := clientQuota(host, client.Token)
+	return &pb.CafeClientUsage{
+		Client:       client,
+		Pinned:       datastore.CafeClientPins().SizeByClient(client.Id),
+		Threads:      datastore.CafeClientThreads().SizeByClient(client.Id),
+		Messages:     int32(datastore.CafeClientMessages().CountByClient(client.Id)),
+		StorageLimit: quota.StorageLimit,
+		InboxLimit:   int32(quota.InboxLimit),
+	}
+}
+
+// clientQuota returns the quota of clients registered with a token ID
+func clientQuota(host config.CafeHost, tokenId string) config.CafeQuota {
+	for key, quota := range host.TokenQuotas {
+		if cafeTokenId(key) == tokenId {
+			return quota
+		}
+	}
+	return host.Quota
+}
+
+// cafeTokenId returns the ID of a base58 encoded cafe token, or the given
+// string if it's not a token, i.e., it's already an ID
+func cafeTokenId(token string) string {
+	plain, err := base58.FastBase58Decoding(token)
+	if err != nil || len(plain) != 44 {
+		return token
+	}
+	return hex.EncodeToString(plain[:12])
+}
+
+// storageAllowed returns whether or not size more bytes fit in a client's storage limit
+func storageAllowed(usage *pb.CafeClientUsage, size int64) bool {
+	if usage.StorageLimit <= 0 {
+		return true
+	}
+	return usage.Pinned+usage.Threads+size <= usage.StorageLimit
+}
+
+// inboxAllowed returns whether or not another message fits in a client's inbox limit
+func inboxAllowed(usage *pb.CafeClientUsage) bool {
+	return usage.InboxLimit <= 0 || usage.Messages < usage.InboxLimit
+}
+
+// countingReader counts the bytes read from a reader
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
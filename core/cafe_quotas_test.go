@@ -0,0 +1,80 @@
+package core
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/mr-tron/base58/base58"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
+)
+
+func TestCafeTokenId(t *testing.T) {
+	key := make([]byte, 44)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	token := base58.FastBase58Encoding(key)
+	if id := cafeTokenId(token); id != hex.EncodeToString(key[:12]) {
+		t.Fatalf("wrong token id: %s", id)
+	}
+	if id := cafeTokenId("000102030405060708090a0b"); id != "000102030405060708090a0b" {
+		t.Fatalf("token id should be returned as is: %s", id)
+	}
+}
+
+func TestClientQuota(t *testing.T) {
+	key := make([]byte, 44)
+	key[0] = 1
+	token := base58.FastBase58Encoding(key)
+	tokenId := hex.EncodeToString(key[:12])
+
+	host := config.CafeHost{
+		Quota: config.CafeQuota{StorageLimit: 100, InboxLimit: 10},
+		TokenQuotas: map[string]config.CafeQuota{
+			token:   {StorageLimit: 1000},
+			"other": {InboxLimit: 1},
+		},
+	}
+
+	if quota := clientQuota(host, tokenId); quota.StorageLimit != 1000 || quota.InboxLimit != 0 {
+		t.Fatalf("wrong token quota: %+v", quota)
+	}
+	if quota := clientQuota(host, "other"); quota.InboxLimit != 1 {
+		t.Fatalf("wrong token id quota: %+v", quota)
+	}
+	if quota := clientQuota(host, "unknown"); quota.StorageLimit != 100 || quota.InboxLimit != 10 {
+		t.Fatalf("wrong default quota: %+v", quota)
+	}
+}
+
+func TestStorageAllowed(t *testing.T) {
+	usage := &pb.CafeClientUsage{Pinned: 60, Threads: 30}
+	if !storageAllowed(usage, 1000) {
+		t.Fatal("zero limit should be unlimited")
+	}
+
+	usage.StorageLimit = 100
+	if !storageAllowed(usage, 10) {
+		t.Fatal("size within limit should be allowed")
+	}
+	if storageAllowed(usage, 11) {
+		t.Fatal("size over limit should not be allowed")
+	}
+}
+
+func TestInboxAllowed(t *testing.T) {
+	usage := &pb.CafeClientUsage{Messages: 5}
+	if !inboxAllowed(usage) {
+		t.Fatal("zero limit should be unlimited")
+	}
+
+	usage.InboxLimit = 6
+	if !inboxAllowed(usage) {
+		t.Fatal("message within limit should be allowed")
+	}
+	usage.Messages = 6
+	if inboxAllowed(usage) {
+		t.Fatal("full inbox should not be allowed")
+	}
+}
@@ -0,0 +1,348 @@
+package core
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	cid "github.com/ipfs/go-cid"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// kCafeTombstoneMaxAge is how long removals of client data are remembered for replica
+// cafes. A replica that's unreachable for longer may restore removed data.
+const kCafeTombstoneMaxAge = time.Hour * 24 * 30
+
+// replicate sends changes to a client's data to all replica cafes in the background
+func (h *CafeService) replicate(rep *pb.CafeReplicate) {
+	for _, pid := range h.replicas() {
+		go func(pid peer.ID) {
+			if err := h.sendReplicate(pid, rep); err != nil {
+				log.Warningf("error replicating client %s to %s: %s", rep.Client.Id, pid.Pretty(), err)
+			}
+		}(pid)
+	}
+}
+
+// replicateClient fills in a registered client and replicates changes to its data
+func (h *CafeService) replicateClient(clientId string, rep *pb.CafeReplicate) {
+	if len(h.host.Replicas) == 0 {
+		return
+	}
+	client := h.datastore.CafeClients().Get(clientId)
+	if client == nil {
+		return
+	}
+	rep.Client = client
+	h.replicate(rep)
+}
+
+// syncReplicas sends all data of every client to replica cafes, repairing changes
+// that failed to replicate, e.g., while a replica was offline
+func (h *CafeService) syncReplicas() {
+	replicas := h.replicas()
+	if len(replicas) == 0 {
+		return
+	}
+
+	var reps []*pb.CafeReplicate
+	for _, client := range h.datastore.CafeClients().List() {
+		reps = append(reps, h.clientReplication(client))
+	}
+	for _, ts := range h.datastore.CafeClientTombstones().ListByType(pb.CafeClientTombstone_CLIENT) {
+		ts := ts
+		reps = append(reps, &pb.CafeReplicate{
+			Client:     &pb.CafeClient{Id: ts.Client},
+			Tombstones: []*pb.CafeClientTombstone{&ts},
+		})
+	}
+
+	for _, rep := range reps {
+		for _, pid := range replicas {
+			if err := h.sendReplicate(pid, rep); err != nil {
+				log.Warningf("error syncing client %s to %s: %s", rep.Client.Id, pid.Pretty(), err)
+			}
+		}
+	}
+}
+
+// clientReplication returns all stored data of a client, along with removals
+func (h *CafeService) clientReplication(client pb.CafeClient) *pb.CafeReplicate {
+	rep := &pb.CafeReplicate{Client: &client}
+	for _, pin := range h.datastore.CafeClientPins().ListByClient(client.Id) {
+		pin := pin
+		rep.Pins = append(rep.Pins, &pin)
+	}
+	for _, thrd := range h.datastore.CafeClientThreads().ListByClient(client.Id) {
+		thrd := thrd
+		rep.Threads = append(rep.Threads, &thrd)
+	}
+	for _, msg := range h.datastore.CafeClientMessages().ListByClient(client.Id, -1) {
+		msg := msg
+		rep.Messages = append(rep.Messages, &msg)
+	}
+	for _, ts := range h.datastore.CafeClientTombstones().ListByClient(client.Id) {
+		ts := ts
+		rep.Tombstones = append(rep.Tombstones, &ts)
+	}
+	return rep
+}
+
+// sendReplicate sends a replication request to a replica cafe
+func (h *CafeService) sendReplicate(pid peer.ID, rep *pb.CafeReplicate) error {
+	env, err := h.service.NewEnvelope(pb.Message_CAFE_REPLICATE, rep, nil, false)
+	if err != nil {
+		return err
+	}
+	renv, err := h.service.SendRequest(pid, env)
+	if err != nil {
+		return err
+	}
+	return ptypes.UnmarshalAny(renv.Message.Payload, new(pb.CafeReplicateAck))
+}
+
+// handleReplicate receives a client's data from a trusted replica cafe
+func (h *CafeService) handleReplicate(pid peer.ID, env *pb.Envelope) (*pb.Envelope, error) {
+	rep := new(pb.CafeReplicate)
+	if err := ptypes.UnmarshalAny(env.Message.Payload, rep); err != nil {
+		return nil, err
+	}
+
+	if !h.open || !isReplica(h.host.Replicas, pid.Pretty()) {
+		return h.service.NewError(403, errForbidden, env.Message.RequestId)
+	}
+	if rep.Client == nil || rep.Client.Id == "" {
+		return h.service.NewError(400, errBadRequest, env.Message.RequestId)
+	}
+
+	if err := h.applyReplication(rep); err != nil {
+		return h.service.NewError(500, err.Error(), env.Message.RequestId)
+	}
+
+	res := &pb.CafeReplicateAck{Client: rep.Client.Id}
+	return h.service.NewEnvelope(pb.Message_CAFE_REPLICATE_ACK, res, &env.Message.RequestId, true)
+}
+
+// applyReplication stores replicated client data, so the client can register here
+// and find its data intact if its cafe goes down. Removals are applied first, and
+// data is only stored if it's newer than what's here and hasn't been removed since.
+func (h *CafeService) applyReplication(rep *pb.CafeReplicate) error {
+	client := rep.Client
+	for _, ts := range rep.Tombstones {
+		ts.Client = client.Id
+		if err := h.applyTombstone(ts); err != nil {
+			return err
+		}
+	}
+	if client.Created == nil {
+		// removals only
+		return nil
+	}
+	if h.banned(client.Id, client.Address) {
+		return nil
+	}
+	if h.removed(client.Id, client.Id, pb.CafeClientTombstone_CLIENT, client.Created) {
+		return nil
+	}
+
+	existing := h.datastore.CafeClients().Get(client.Id)
+	if existing == nil {
+		if err := h.datastore.CafeClients().Add(client); err != nil {
+			return err
+		}
+	} else if util.ProtoTsIsNewer(client.Seen, existing.Seen) {
+		seen, err := ptypes.Timestamp(client.Seen)
+		if err != nil {
+			return err
+		}
+		if err := h.datastore.CafeClients().UpdateLastSeen(client.Id, seen); err != nil {
+			return err
+		}
+	}
+	if existing != nil && existing.Webhook != client.Webhook {
+		if err := h.datastore.CafeClients().SetWebhook(client.Id, client.Webhook); err != nil {
+			return err
+		}
+	}
+
+	var cids []string
+	for _, pin := range rep.Pins {
+		pin.Client = client.Id
+		if h.removed(client.Id, pin.Cid, pb.CafeClientTombstone_PIN, pin.Date) {
+			continue
+		}
+		if err := h.datastore.CafeClientPins().AddOrUpdate(pin); err != nil {
+			return err
+		}
+		cids = append(cids, pin.Cid)
+	}
+	if len(cids) > 0 {
+		go h.pinReplicated(cids)
+	}
+
+	for _, thrd := range rep.Threads {
+		thrd.Client = client.Id
+		if h.removed(client.Id, thrd.Id, pb.CafeClientTombstone_THREAD, thrd.Date) {
+			continue
+		}
+		local := h.datastore.CafeClientThreads().Get(thrd.Id, client.Id)
+		if local != nil && !util.ProtoTsIsNewer(thrd.Date, local.Date) {
+			continue
+		}
+		if err := h.datastore.CafeClientThreads().AddOrUpdate(thrd); err != nil {
+			return err
+		}
+	}
+
+	for _, msg := range rep.Messages {
+		msg.Client = client.Id
+		if h.removed(client.Id, client.Id, pb.CafeClientTombstone_MESSAGES, msg.Date) {
+			continue
+		}
+		if err := h.datastore.CafeClientMessages().AddOrUpdate(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyTombstone removes replicated client data, unless it was stored again since
+func (h *CafeService) applyTombstone(ts *pb.CafeClientTombstone) error {
+	if h.removed(ts.Client, ts.Id, ts.Type, ts.Date) {
+		return nil
+	}
+
+	switch ts.Type {
+	case pb.CafeClientTombstone_PIN:
+		pin := h.datastore.CafeClientPins().Get(ts.Id, ts.Client)
+		if pin != nil && !util.ProtoTsIsNewer(pin.Date, ts.Date) {
+			if _, err := h.releasePin(ts.Client, ts.Id); err != nil {
+				return err
+			}
+		}
+	case pb.CafeClientTombstone_THREAD:
+		thrd := h.datastore.CafeClientThreads().Get(ts.Id, ts.Client)
+		if thrd != nil && !util.ProtoTsIsNewer(thrd.Date, ts.Date) {
+			if err := h.datastore.CafeClientThreads().Delete(ts.Id, ts.Client); err != nil {
+				return err
+			}
+		}
+	case pb.CafeClientTombstone_MESSAGES:
+		before := time.Unix(0, util.ProtoNanos(ts.Date)+1)
+		if err := h.datastore.CafeClientMessages().DeleteByClientBefore(ts.Client, before); err != nil {
+			return err
+		}
+	case pb.CafeClientTombstone_CLIENT:
+		client := h.datastore.CafeClients().Get(ts.Client)
+		if client == nil || !util.ProtoTsIsNewer(client.Created, ts.Date) {
+			if err := h.deleteClient(ts.Client); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := h.bury(ts.Client, ts.Id, ts.Type, ts.Date)
+	return err
+}
+
+// unstorePins releases a client's pins, and replicates their removal
+func (h *CafeService) unstorePins(clientId string, ids []string) error {
+	var tombstones []*pb.CafeClientTombstone
+	now := ptypes.TimestampNow()
+	for _, id := range ids {
+		if _, err := h.releasePin(clientId, id); err != nil {
+			return err
+		}
+		if clientId == "" {
+			continue
+		}
+		ts, err := h.bury(clientId, id, pb.CafeClientTombstone_PIN, now)
+		if err != nil {
+			return err
+		}
+		tombstones = append(tombstones, ts)
+	}
+	if len(tombstones) > 0 {
+		h.replicateClient(clientId, &pb.CafeReplicate{Tombstones: tombstones})
+	}
+	return nil
+}
+
+// unstoreClientThread deletes a client's thread snapshot, and replicates its removal
+func (h *CafeService) unstoreClientThread(clientId string, id string) error {
+	if err := h.datastore.CafeClientThreads().Delete(id, clientId); err != nil {
+		return err
+	}
+	ts, err := h.bury(clientId, id, pb.CafeClientTombstone_THREAD, ptypes.TimestampNow())
+	if err != nil {
+		return err
+	}
+	h.replicateClient(clientId, &pb.CafeReplicate{Tombstones: []*pb.CafeClientTombstone{ts}})
+	return nil
+}
+
+// bury records that client data was removed, so that replicas don't restore it,
+// returning the newest tombstone
+func (h *CafeService) bury(clientId string, id string, ttype pb.CafeClientTombstone_Type, date *timestamp.Timestamp) (*pb.CafeClientTombstone, error) {
+	existing := h.datastore.CafeClientTombstones().Get(id, clientId, ttype)
+	if existing != nil && !util.ProtoTsIsNewer(date, existing.Date) {
+		return existing, nil
+	}
+	ts := &pb.CafeClientTombstone{
+		Id:     id,
+		Client: clientId,
+		Type:   ttype,
+		Date:   date,
+	}
+	if err := h.datastore.CafeClientTombstones().AddOrUpdate(ts); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// removed returns whether or not client data dated date has been removed since
+func (h *CafeService) removed(clientId string, id string, ttype pb.CafeClientTombstone_Type, date *timestamp.Timestamp) bool {
+	ts := h.datastore.CafeClientTombstones().Get(id, clientId, ttype)
+	return ts != nil && !util.ProtoTsIsNewer(date, ts.Date)
+}
+
+// pinReplicated pins replicated cids, fetching content from the network
+func (h *CafeService) pinReplicated(cids []string) {
+	for _, id := range cids {
+		dec, err := cid.Decode(id)
+		if err != nil {
+			log.Warningf("error decoding replicated cid %s: %s", id, err)
+			continue
+		}
+		if err := ipfs.PinCid(h.service.Node(), dec, true); err != nil {
+			log.Warningf("error pinning replicated cid %s: %s", id, err)
+		}
+	}
+}
+
+// replicas returns the peer IDs of the host's replica cafes
+func (h *CafeService) replicas() []peer.ID {
+	var list []peer.ID
+	for _, id := range h.host.Replicas {
+		pid, err := peer.IDB58Decode(id)
+		if err != nil {
+			log.Warningf("invalid replica cafe %s: %s", id, err)
+			continue
+		}
+		list = append(list, pid)
+	}
+	return list
+}
+
+// isReplica returns whether or not a peer is one of the given replica cafes
+func isReplica(replicas []string, id string) bool {
+	for _, r := range replicas {
+		if r == id {
+			return true
+		}
+	}
+	return false
+}
@@ -59,6 +59,7 @@ const (
 	errUnauthorized   = "unauthorized"
 	errForbidden      = "forbidden"
 	errBadRequest     = "bad request"
+	errQuotaExceeded  = "quota exceeded"
 )
 
 // cafeServiceProtocol is the current protocol tag
@@ -72,6 +73,8 @@ type CafeService struct {
 	info            *pb.Cafe
 	online          bool
 	open            bool
+	host            config.CafeHost
+	gcMux           sync.Mutex
 	queryResults    *broadcast.Broadcaster
 	inFlightQueries map[string]struct{}
 }
@@ -143,6 +146,10 @@ func (h *CafeService) Handle(pid peer.ID, env *pb.Envelope) (*pb.Envelope, error
 		return h.handlePubSubQuery(pid, env)
 	case pb.Message_CAFE_PUBSUB_QUERY_RES:
 		return h.handlePubSubQueryResults(pid, env)
+	case pb.Message_CAFE_REPLICATE:
+		return h.handleReplicate(pid, env)
+	case pb.Message_CAFE_SET_WEBHOOK:
+		return h.handleSetWebhook(pid, env)
 	default:
 		return nil, nil
 	}
@@ -669,6 +676,38 @@ func (h *CafeService) searchLocal(qtype pb.Query_Type, options *pb.QueryOptions,
 				},
 			})
 		}
+
+	case pb.Query_PUBLIC_THREADS:
+		q := new(pb.PublicThreadQuery)
+		if err := ptypes.UnmarshalAny(payload, q); err != nil {
+			return nil, err
+		}
+
+		address := h.service.Account.Address()
+		for _, a := range h.datastore.ThreadAdverts().List().Items {
+			thrd := h.datastore.Threads().Get(a.Id)
+			if thrd == nil || !advertisable(thrd, address) {
+				continue
+			}
+			advert := newThreadAdvert(a, thrd, address)
+			if !matchesPublicThreadQuery(advert, q) {
+				continue
+			}
+
+			value, err := proto.Marshal(advert)
+			if err != nil {
+				return nil, err
+			}
+			results.Add(&pb.QueryResult{
+				Id:    advert.Id,
+				Date:  advert.Date,
+				Local: local,
+				Value: &any.Any{
+					TypeUrl: "/ThreadAdvert",
+					Value:   value,
+				},
+			})
+		}
 	}
 
 	return results, nil
@@ -798,6 +837,11 @@ func (h *CafeService) handleRegistration(pid peer.ID, env *pb.Envelope) (*pb.Env
 		return h.service.NewError(403, errForbidden, env.Message.RequestId)
 	}
 
+	// is the client banned?
+	if h.banned(pid.Pretty(), reg.Address) {
+		return h.service.NewError(403, errForbidden, env.Message.RequestId)
+	}
+
 	// does the provided token match?
 	// dev tokens are actually base58(id+token)
 	plainBytes, err := base58.FastBase58Decoding(reg.Token)
@@ -853,6 +897,7 @@ func (h *CafeService) handleRegistration(pid peer.ID, env *pb.Envelope) (*pb.Env
 			return h.service.NewError(500, "get or create client failed", env.Message.RequestId)
 		}
 	}
+	h.replicate(&pb.CafeReplicate{Client: client})
 
 	session, err := jwt.NewSession(
 		h.service.Node().PrivateKey,
@@ -880,14 +925,8 @@ func (h *CafeService) handleDeregistration(pid peer.ID, env *pb.Envelope) (*pb.E
 	}
 
 	// cleanup
-	if err := h.datastore.CafeClientThreads().DeleteByClient(pid.Pretty()); err != nil {
-		return h.service.NewError(500, "delete client threads failed", env.Message.RequestId)
-	}
-	if err := h.datastore.CafeClientMessages().DeleteByClient(pid.Pretty(), -1); err != nil {
-		return h.service.NewError(500, "delete client messages failed", env.Message.RequestId)
-	}
-	if err := h.datastore.CafeClients().Delete(pid.Pretty()); err != nil {
-		return h.service.NewError(500, "delete client failed", env.Message.RequestId)
+	if err := h.removeClient(pid.Pretty()); err != nil {
+		return h.service.NewError(500, err.Error(), env.Message.RequestId)
 	}
 
 	res := &pb.CafeDeregistrationAck{
@@ -974,6 +1013,11 @@ func (h *CafeService) handleStore(pid peer.ID, env *pb.Envelope) (*pb.Envelope,
 		return rerr, nil
 	}
 
+	// reject clients with no storage left
+	if err := h.checkStorage(pid.Pretty(), 1); err != nil {
+		return h.service.NewError(507, errQuotaExceeded, env.Message.RequestId)
+	}
+
 	// ignore cids for data already pinned
 	var decoded []cid.Cid
 	for _, id := range store.Cids {
@@ -989,10 +1033,38 @@ func (h *CafeService) handleStore(pid peer.ID, env *pb.Envelope) (*pb.Envelope,
 		return nil, err
 	}
 
+	owned := make(map[string]struct{})
+	for _, p := range h.datastore.CafeClientPins().ListByClient(pid.Pretty()) {
+		owned[p.Cid] = struct{}{}
+	}
+
 	var need []string
 	for _, p := range pinned {
+		id := p.Key.Hash().B58String()
 		if p.Mode == pin.NotPinned {
-			need = append(need, p.Key.Hash().B58String())
+			need = append(need, id)
+			continue
+		}
+		if _, ok := owned[id]; ok {
+			continue
+		}
+
+		// pinned for other clients, which may release it first
+		stat, err := ipfs.StatObjectAtPath(h.service.Node(), id)
+		if err != nil {
+			return nil, err
+		}
+		size := int64(stat.CumulativeSize)
+		if err := h.checkStorage(pid.Pretty(), size); err != nil {
+			return h.service.NewError(507, errQuotaExceeded, env.Message.RequestId)
+		}
+		if p.Mode == pin.Indirect {
+			if err := ipfs.PinCid(h.service.Node(), p.Key, true); err != nil {
+				return nil, err
+			}
+		}
+		if err := h.recordPin(pid.Pretty(), id, size); err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.RequestId)
 		}
 	}
 
@@ -1030,15 +1102,16 @@ func (h *CafeService) handleUnstore(pid peer.ID, env *pb.Envelope) (*pb.Envelope
 		return nil, err
 	}
 
+	// data stored for other clients stays pinned
 	var unstored []string
 	for _, p := range pinned {
 		if p.Mode != pin.NotPinned {
-			if err := ipfs.UnpinCid(h.service.Node(), p.Key, true); err != nil {
-				return nil, err
-			}
 			unstored = append(unstored, p.Key.Hash().B58String())
 		}
 	}
+	if err := h.unstorePins(pid.Pretty(), unstored); err != nil {
+		return nil, err
+	}
 
 	res := &pb.CafeUnstoreAck{Cids: unstored}
 	return h.service.NewEnvelope(pb.Message_CAFE_UNSTORE_ACK, res, &env.Message.RequestId, true)
@@ -1059,6 +1132,11 @@ func (h *CafeService) handleObject(pid peer.ID, env *pb.Envelope) (*pb.Envelope,
 		return rerr, nil
 	}
 
+	size := int64(len(obj.Data) + len(obj.Node))
+	if err := h.checkStorage(pid.Pretty(), size); err != nil {
+		return h.service.NewError(507, errQuotaExceeded, env.Message.RequestId)
+	}
+
 	var aid *cid.Cid
 	if obj.Data != nil {
 		aid, err = ipfs.AddData(h.service.Node(), bytes.NewReader(obj.Data), true)
@@ -1074,6 +1152,10 @@ func (h *CafeService) handleObject(pid peer.ID, env *pb.Envelope) (*pb.Envelope,
 
 	log.Debugf("stored %s", rhash)
 
+	if err := h.recordPin(pid.Pretty(), rhash, size); err != nil {
+		return h.service.NewError(500, err.Error(), env.Message.RequestId)
+	}
+
 	if rhash != obj.Cid {
 		log.Warningf("cids do not match (received %s, resolved %s)", obj.Cid, rhash)
 	}
@@ -1102,14 +1184,20 @@ func (h *CafeService) handleStoreThread(pid peer.ID, env *pb.Envelope) (*pb.Enve
 		return h.service.NewError(403, errForbidden, env.Message.RequestId)
 	}
 
+	if err := h.checkThreadStorage(client.Id, store.Id, store.Ciphertext); err != nil {
+		return h.service.NewError(507, errQuotaExceeded, env.Message.RequestId)
+	}
+
 	thrd := &pb.CafeClientThread{
 		Id:         store.Id,
 		Client:     client.Id,
 		Ciphertext: store.Ciphertext,
+		Date:       ptypes.TimestampNow(),
 	}
 	if err := h.datastore.CafeClientThreads().AddOrUpdate(thrd); err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.RequestId)
 	}
+	h.replicateClient(client.Id, &pb.CafeReplicate{Threads: []*pb.CafeClientThread{thrd}})
 
 	res := &pb.CafeStoreThreadAck{Id: store.Id}
 	return h.service.NewEnvelope(pb.Message_CAFE_STORE_THREAD_ACK, res, &env.Message.RequestId, true)
@@ -1135,7 +1223,7 @@ func (h *CafeService) handleUnstoreThread(pid peer.ID, env *pb.Envelope) (*pb.En
 		return h.service.NewError(403, errForbidden, env.Message.RequestId)
 	}
 
-	if err := h.datastore.CafeClientThreads().Delete(unstore.Id, client.Id); err != nil {
+	if err := h.unstoreClientThread(client.Id, unstore.Id); err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.RequestId)
 	}
 
@@ -1156,6 +1244,11 @@ func (h *CafeService) handleDeliverMessage(pid peer.ID, env *pb.Envelope) (*pb.E
 		return nil, nil
 	}
 
+	if err := h.checkInbox(client); err != nil {
+		log.Warningf("dropping message from %s for client %s: %s", pid.Pretty(), client.Id, err)
+		return h.service.NewError(507, errQuotaExceeded, env.Message.RequestId)
+	}
+
 	message := &pb.CafeClientMessage{
 		Id:     msg.Id,
 		Peer:   pid.Pretty(),
@@ -1166,6 +1259,8 @@ func (h *CafeService) handleDeliverMessage(pid peer.ID, env *pb.Envelope) (*pb.E
 		log.Errorf("error adding message: %s", err)
 		return nil, nil
 	}
+	h.replicateClient(client.Id, &pb.CafeReplicate{Messages: []*pb.CafeClientMessage{message}})
+	h.pushWebhook(client, message)
 
 	go func() {
 		pid, err := peer.IDB58Decode(client.Id)
@@ -1240,9 +1335,17 @@ func (h *CafeService) handleDeleteMessages(pid peer.ID, env *pb.Envelope) (*pb.E
 	}
 
 	// delete the most recent page
+	page := h.datastore.CafeClientMessages().ListByClient(client.Id, inboxMessagePageSize)
 	if err := h.datastore.CafeClientMessages().DeleteByClient(client.Id, inboxMessagePageSize); err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.RequestId)
 	}
+	if len(page) > 0 {
+		ts, err := h.bury(client.Id, client.Id, pb.CafeClientTombstone_MESSAGES, page[len(page)-1].Date)
+		if err != nil {
+			return h.service.NewError(500, err.Error(), env.Message.RequestId)
+		}
+		h.replicateClient(client.Id, &pb.CafeReplicate{Tombstones: []*pb.CafeClientTombstone{ts}})
+	}
 
 	// check for more
 	remaining := h.datastore.CafeClientMessages().CountByClient(client.Id)
@@ -1426,6 +1529,9 @@ func (h *CafeService) authToken(pid peer.ID, token string, refreshing bool, requ
 			return h.service.NewError(403, errForbidden, requestId)
 		}
 	}
+	if h.banned(subject, "") {
+		return h.service.NewError(403, errForbidden, requestId)
+	}
 	return nil, nil
 }
 
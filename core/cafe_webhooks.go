@@ -0,0 +1,316 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/textileio/go-textile/pb"
+)
+
+// kWebhookAttempts is the max number of times a webhook is posted
+const kWebhookAttempts = 5
+
+// kWebhookBackoff is the initial wait between webhook attempts, doubled after each failure
+const kWebhookBackoff = time.Second
+
+// kWebhookTimeout is the max time to wait for a webhook response
+const kWebhookTimeout = time.Second * 10
+
+// ErrInvalidWebhook indicates a webhook is not an absolute http(s) url
+var ErrInvalidWebhook = fmt.Errorf("webhook must be an absolute http or https url")
+
+// ErrWebhookHostNotAllowed indicates a webhook host resolves to a loopback, private,
+// or link-local address, which a cafe must not be made to post to
+var ErrWebhookHostNotAllowed = fmt.Errorf("webhook host must be a public address")
+
+// webhookBlockedNets are address ranges webhooks may not be posted to
+var webhookBlockedNets = parseCIDRs(
+	"0.0.0.0/8",      // "this" network
+	"10.0.0.0/8",     // private
+	"100.64.0.0/10",  // carrier-grade nat
+	"127.0.0.0/8",    // loopback
+	"169.254.0.0/16", // link-local
+	"172.16.0.0/12",  // private
+	"192.168.0.0/16", // private
+	"224.0.0.0/4",    // multicast
+	"::/128",         // unspecified
+	"::1/128",        // loopback
+	"fc00::/7",       // unique local
+	"fe80::/10",      // link-local
+	"ff00::/8",       // multicast
+)
+
+// webhookPolicy decides which hosts a cafe may post webhooks to. Non-public addresses
+// are refused unless the operator allows them, e.g., for a push relay on the same network.
+type webhookPolicy struct {
+	hosts map[string]bool
+	nets  []*net.IPNet
+}
+
+// newWebhookPolicy returns a policy exempting the given hosts, ips, and cidr blocks
+func newWebhookPolicy(allowlist []string) *webhookPolicy {
+	p := &webhookPolicy{hosts: make(map[string]bool)}
+	for _, entry := range allowlist {
+		entry = strings.TrimSpace(entry)
+		if _, n, err := net.ParseCIDR(entry); err == nil {
+			p.nets = append(p.nets, n)
+		} else if ip := net.ParseIP(entry); ip != nil {
+			p.nets = append(p.nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+		} else if entry != "" {
+			p.hosts[strings.ToLower(entry)] = true
+		}
+	}
+	return p
+}
+
+// client returns an http client that re-checks addresses at dial time, so hosts that
+// resolve differently after registration are still refused, and doesn't follow
+// redirects, which could point anywhere
+func (p *webhookPolicy) client() *http.Client {
+	checked := &net.Dialer{
+		Timeout: kWebhookTimeout,
+		Control: p.control,
+	}
+	exempt := &net.Dialer{Timeout: kWebhookTimeout}
+
+	return &http.Client{
+		Timeout: kWebhookTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network string, address string) (net.Conn, error) {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return nil, err
+				}
+				if p.hosts[strings.ToLower(host)] {
+					return exempt.DialContext(ctx, network, address)
+				}
+				return checked.DialContext(ctx, network, address)
+			},
+			TLSHandshakeTimeout: kWebhookTimeout,
+		},
+		CheckRedirect: webhookCheckRedirect,
+	}
+}
+
+// validate returns ErrInvalidWebhook if raw is not an absolute http(s) url,
+// or ErrWebhookHostNotAllowed if its host resolves to a refused address
+func (p *webhookPolicy) validate(raw string) error {
+	u, err := parseWebhook(raw)
+	if err != nil {
+		return err
+	}
+	if p.hosts[strings.ToLower(u.Hostname())] {
+		return nil
+	}
+
+	ips, err := net.LookupIP(u.Hostname())
+	if err != nil {
+		return fmt.Errorf("webhook host could not be resolved: %s", err)
+	}
+	for _, ip := range ips {
+		if !p.allowedIP(ip) {
+			return ErrWebhookHostNotAllowed
+		}
+	}
+	return nil
+}
+
+// control refuses connections to addresses that aren't allowed
+func (p *webhookPolicy) control(network string, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !p.allowedIP(ip) {
+		return ErrWebhookHostNotAllowed
+	}
+	return nil
+}
+
+// allowedIP returns whether or not webhooks may be posted to ip
+func (p *webhookPolicy) allowedIP(ip net.IP) bool {
+	for _, n := range p.nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	for _, n := range webhookBlockedNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// webhookCheckRedirect stops webhook clients from following redirects
+func webhookCheckRedirect(req *http.Request, via []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+// SetWebhook registers a url with a cafe, which is posted to when the cafe
+// receives a message for this peer. An empty url removes the webhook.
+func (h *CafeService) SetWebhook(cafe peer.ID, url string) error {
+	renv, err := h.sendCafeHTTPRequest(cafe, func(session *pb.CafeSession) (*pb.Envelope, error) {
+		return h.service.NewEnvelope(pb.Message_CAFE_SET_WEBHOOK, &pb.CafeSetWebhook{
+			Token: session.Access,
+			Url:   url,
+		}, nil, false)
+	})
+	if err != nil {
+		return err
+	}
+	return ptypes.UnmarshalAny(renv.Message.Payload, new(pb.CafeSetWebhookAck))
+}
+
+// handleSetWebhook receives a client's webhook url
+func (h *CafeService) handleSetWebhook(pid peer.ID, env *pb.Envelope) (*pb.Envelope, error) {
+	hook := new(pb.CafeSetWebhook)
+	if err := ptypes.UnmarshalAny(env.Message.Payload, hook); err != nil {
+		return nil, err
+	}
+
+	rerr, err := h.authToken(pid, hook.Token, false, env.Message.RequestId)
+	if err != nil {
+		return nil, err
+	}
+	if rerr != nil {
+		return rerr, nil
+	}
+
+	client := h.datastore.CafeClients().Get(pid.Pretty())
+	if client == nil {
+		return h.service.NewError(403, errForbidden, env.Message.RequestId)
+	}
+
+	if hook.Url != "" {
+		if err := newWebhookPolicy(h.host.WebhookAllowlist).validate(hook.Url); err != nil {
+			return h.service.NewError(400, err.Error(), env.Message.RequestId)
+		}
+	}
+	if err := h.datastore.CafeClients().SetWebhook(client.Id, hook.Url); err != nil {
+		return h.service.NewError(500, err.Error(), env.Message.RequestId)
+	}
+	h.replicateClient(client.Id, &pb.CafeReplicate{})
+
+	res := &pb.CafeSetWebhookAck{Url: hook.Url}
+	return h.service.NewEnvelope(pb.Message_CAFE_SET_WEBHOOK_ACK, res, &env.Message.RequestId, true)
+}
+
+// pushWebhook posts a signed notice of a new inbox message to a client's webhook
+// in the background, if it has one
+func (h *CafeService) pushWebhook(client *pb.CafeClient, msg *pb.CafeClientMessage) {
+	if client.Webhook == "" {
+		return
+	}
+
+	go func() {
+		cafe := h.service.Node().Identity.Pretty()
+		body, err := pbMarshaler.MarshalToString(&pb.CafeWebhookMail{
+			Cafe:   cafe,
+			Client: client.Id,
+			Message: &pb.CafeMessage{
+				Id:   msg.Id,
+				Peer: msg.Peer,
+				Date: msg.Date,
+			},
+		})
+		if err != nil {
+			log.Errorf("error marshaling webhook for client %s: %s", client.Id, err)
+			return
+		}
+		sig, err := h.service.Node().PrivateKey.Sign([]byte(body))
+		if err != nil {
+			log.Errorf("error signing webhook for client %s: %s", client.Id, err)
+			return
+		}
+
+		headers := map[string]string{
+			"Content-Type":       "application/json",
+			"X-Textile-Cafe":     cafe,
+			"X-Textile-Cafe-Sig": hex.EncodeToString(sig),
+		}
+		policy := newWebhookPolicy(h.host.WebhookAllowlist)
+		err = postWebhook(policy.client(), client.Webhook, []byte(body), headers, kWebhookAttempts, kWebhookBackoff)
+		if err != nil {
+			log.Warningf("error posting webhook for client %s: %s", client.Id, err)
+		}
+	}()
+}
+
+// postWebhook posts body to a url, retrying with exponential backoff on network errors,
+// server errors, and rate limiting
+func postWebhook(client *http.Client, url string, body []byte, headers map[string]string, attempts int, backoff time.Duration) error {
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+
+		var retry bool
+		retry, err = sendWebhook(client, url, body, headers)
+		if err == nil || !retry {
+			return err
+		}
+	}
+	return err
+}
+
+// sendWebhook posts body to a url once, returning whether or not a failure is worth retrying
+func sendWebhook(client *http.Client, url string, body []byte, headers map[string]string) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode < 300:
+		return false, nil
+	case res.StatusCode >= 500 || res.StatusCode == http.StatusTooManyRequests:
+		return true, fmt.Errorf("webhook responded with %s", res.Status)
+	default:
+		return false, fmt.Errorf("webhook responded with %s", res.Status)
+	}
+}
+
+// parseWebhook returns ErrInvalidWebhook if raw is not an absolute http(s) url
+func parseWebhook(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, ErrInvalidWebhook
+	}
+	return u, nil
+}
+
+// parseCIDRs parses a list of known-good cidr blocks
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	var nets []*net.IPNet
+	for _, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}
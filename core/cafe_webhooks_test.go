@@ -0,0 +1,254 @@
+package core
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/keypair"
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestPostWebhook(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("X-Textile-Cafe-Sig") != "sig" {
+			t.Errorf("missing signature header")
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "mail" {
+			t.Errorf("wrong body: %s", body)
+		}
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	headers := map[string]string{"X-Textile-Cafe-Sig": "sig"}
+	err := postWebhook(server.Client(), server.URL, []byte("mail"), headers, 5, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestPostWebhook_GiveUp(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	if err := postWebhook(server.Client(), server.URL, nil, nil, 3, time.Millisecond); err == nil {
+		t.Fatal("expected error after all attempts failed")
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestPostWebhook_NoRetry(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer server.Close()
+
+	if err := postWebhook(server.Client(), server.URL, nil, nil, 5, time.Millisecond); err == nil {
+		t.Fatal("expected error for client error response")
+	}
+	if calls != 1 {
+		t.Fatalf("client errors should not be retried, got %d attempts", calls)
+	}
+}
+
+func TestPostWebhook_NoRedirect(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Redirect(w, r, "/elsewhere", http.StatusFound)
+	}))
+	defer server.Close()
+
+	client := server.Client()
+	client.CheckRedirect = webhookCheckRedirect
+	if err := postWebhook(client, server.URL, nil, nil, 5, time.Millisecond); err == nil {
+		t.Fatal("expected error for redirect response")
+	}
+	if calls != 1 {
+		t.Fatalf("redirects should not be followed, got %d requests", calls)
+	}
+}
+
+func TestPostWebhook_Loopback(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	_, err := sendWebhook(newWebhookPolicy(nil).client(), server.URL, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), ErrWebhookHostNotAllowed.Error()) {
+		t.Fatalf("expected loopback dial to be refused, got %v", err)
+	}
+	if calls != 0 {
+		t.Fatal("loopback server should not have been reached")
+	}
+
+	for _, allow := range []string{"127.0.0.1", "127.0.0.0/8"} {
+		if _, err := sendWebhook(newWebhookPolicy([]string{allow}).client(), server.URL, nil, nil); err != nil {
+			t.Fatalf("expected %s to be allowed: %s", allow, err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 requests to allowed server, got %d", calls)
+	}
+}
+
+func TestValidateWebhook(t *testing.T) {
+	policy := newWebhookPolicy(nil)
+	for _, u := range []string{"https://93.184.216.34/hook", "http://[2606:2800:220:1:248:1893:25c8:1946]:8080/hook"} {
+		if err := policy.validate(u); err != nil {
+			t.Fatalf("%s should be valid: %s", u, err)
+		}
+	}
+	for _, u := range []string{"", "push.example.com/hook", "/hook", "ftp://example.com", "https://"} {
+		if err := policy.validate(u); err != ErrInvalidWebhook {
+			t.Fatalf("%s should be invalid", u)
+		}
+	}
+	for _, u := range []string{
+		"http://localhost/hook",
+		"http://127.0.0.1:8080/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.0.0.1/hook",
+		"https://172.16.5.4/hook",
+		"https://192.168.1.1/hook",
+		"http://0.0.0.0/hook",
+		"http://[::1]/hook",
+		"http://[fe80::1]/hook",
+		"http://[fd00::1]/hook",
+	} {
+		if err := policy.validate(u); err != ErrWebhookHostNotAllowed {
+			t.Fatalf("%s should not be allowed, got %v", u, err)
+		}
+	}
+}
+
+func TestValidateWebhook_Allowlist(t *testing.T) {
+	policy := newWebhookPolicy([]string{"127.0.0.1", "10.0.0.0/8", "Relay.LAN"})
+	for _, u := range []string{"http://127.0.0.1:8080/hook", "http://10.1.2.3/hook", "http://relay.lan/hook"} {
+		if err := policy.validate(u); err != nil {
+			t.Fatalf("%s should be allowed: %s", u, err)
+		}
+	}
+	for _, u := range []string{"http://127.0.0.2/hook", "https://192.168.1.1/hook", "http://169.254.169.254/"} {
+		if err := policy.validate(u); err != ErrWebhookHostNotAllowed {
+			t.Fatalf("%s should not be allowed, got %v", u, err)
+		}
+	}
+}
+
+func TestCafeService_DeliverMessageWebhook(t *testing.T) {
+	repoPath := "testdata/.textile_webhook"
+	_ = os.RemoveAll(repoPath)
+	defer os.RemoveAll(repoPath)
+
+	if err := InitRepo(InitConfig{
+		Account:  keypair.Random(),
+		RepoPath: repoPath,
+		ApiAddr:  fmt.Sprintf("127.0.0.1:%s", GetRandomPort()),
+	}); err != nil {
+		t.Fatalf("init node failed: %s", err)
+	}
+	node, err := NewTextile(RunConfig{RepoPath: repoPath})
+	if err != nil {
+		t.Fatalf("create node failed: %s", err)
+	}
+	if err := node.Start(); err != nil {
+		t.Fatalf("start node failed: %s", err)
+	}
+	defer node.Stop()
+	<-node.OnlineCh()
+
+	// a local stand-in for a push relay
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer server.Close()
+	node.cafe.host.WebhookAllowlist = []string{"127.0.0.1"}
+
+	clientId, err := keypair.Random().Id()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.datastore.CafeClients().Add(&pb.CafeClient{
+		Id:      clientId.Pretty(),
+		Address: "address",
+		Created: ptypes.TimestampNow(),
+		Seen:    ptypes.TimestampNow(),
+		Webhook: server.URL,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	env, err := node.cafe.service.NewEnvelope(pb.Message_CAFE_DELIVER_MESSAGE, &pb.CafeDeliverMessage{
+		Id:     "msg",
+		Client: clientId.Pretty(),
+	}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := node.cafe.handleDeliverMessage(node.node.Identity, env); err != nil {
+		t.Fatal(err)
+	}
+
+	var req *http.Request
+	var body []byte
+	select {
+	case req = <-received:
+		body = <-bodies
+	case <-time.After(kWebhookTimeout):
+		t.Fatal("webhook was not posted")
+	}
+
+	if req.Header.Get("X-Textile-Cafe") != node.node.Identity.Pretty() {
+		t.Fatalf("wrong cafe header: %s", req.Header.Get("X-Textile-Cafe"))
+	}
+	sig, err := hex.DecodeString(req.Header.Get("X-Textile-Cafe-Sig"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := node.node.PrivateKey.GetPublic().Verify(body, sig)
+	if err != nil || !ok {
+		t.Fatal("bad webhook signature")
+	}
+
+	mail := new(pb.CafeWebhookMail)
+	if err := pbUnmarshaler.Unmarshal(bytes.NewReader(body), mail); err != nil {
+		t.Fatal(err)
+	}
+	if mail.Client != clientId.Pretty() || mail.Message.Id != "msg" {
+		t.Fatalf("wrong webhook mail: %v", mail)
+	}
+}
@@ -82,6 +82,22 @@ func (t *Textile) DeregisterCafe(id string) error {
 	return t.publishPeer()
 }
 
+// SetCafeWebhook registers a url with the given cafe, which is posted to when
+// the cafe receives a message for this peer. An empty url removes the webhook.
+func (t *Textile) SetCafeWebhook(id string, url string) error {
+	if url != "" {
+		// the cafe decides which hosts it will post to
+		if _, err := parseWebhook(url); err != nil {
+			return err
+		}
+	}
+	cafe, err := peer.IDB58Decode(id)
+	if err != nil {
+		return err
+	}
+	return t.cafe.SetWebhook(cafe, url)
+}
+
 // CheckCafeMessages fetches new messages from registered cafes
 func (t *Textile) CheckCafeMessages() error {
 	return t.cafeInbox.CheckMessages()
@@ -0,0 +1,28 @@
+package core
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+// Changes returns local index changes after the given cursor, oldest first.
+// Clients can keep a local cache consistent by following the list's next cursor.
+func (t *Textile) Changes(since int64, limit int) *pb.ChangeList {
+	list := t.datastore.Changes().List(since, limit)
+	list.Next = since
+	if len(list.Items) > 0 {
+		list.Next = list.Items[len(list.Items)-1].Seq
+	}
+	return list
+}
+
+// addChange appends a change to the local change log
+func addChange(datastore repo.Datastore, ctype pb.Change_Type, id string, thread string) error {
+	return datastore.Changes().Add(&pb.Change{
+		Type:   ctype,
+		Id:     id,
+		Thread: thread,
+		Date:   ptypes.TimestampNow(),
+	})
+}
@@ -69,6 +69,7 @@ func applyTextileConfigOptions(init InitConfig) error {
 	conf.Cafe.Host.Open = init.CafeOpen
 	conf.Cafe.Host.URL = init.CafeURL
 	conf.Cafe.Host.NeighborURL = init.CafeNeighborURL
+	conf.Cafe.Host.AdminToken = init.CafeAdminToken
 
 	// write to disk
 	return config.Write(init.RepoPath, conf)
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+)
+
+// eventBuffer is how many events a listener can fall behind by before it's dropped
+const eventBuffer = 100
+
+// sendEvent stamps an event and sends it to event listeners. Listeners that fall
+// behind are dropped, so that a slow client can't stall the node.
+func (t *Textile) sendEvent(event *pb.Event) {
+	if event.Date == nil {
+		event.Date = ptypes.TimestampNow()
+	}
+	t.events.TrySend(event)
+}
+
+// walletUpdateThread returns the thread a wallet update refers to, if any
+func walletUpdateThread(update *pb.WalletUpdate) string {
+	switch update.Type {
+	case pb.WalletUpdate_THREAD_ADDED, pb.WalletUpdate_THREAD_REMOVED:
+		return update.Id
+	default:
+		return ""
+	}
+}
+
+// parseEventTypes parses an or'd list of event types (e.g., THREAD_UPDATE|NOTIFICATION).
+// An empty list includes all types.
+func parseEventTypes(list string) ([]pb.Event_Type, error) {
+	var types []pb.Event_Type
+	for _, name := range strings.Split(strings.ToUpper(list), "|") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		val, ok := pb.Event_Type_value[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown event type: %s", name)
+		}
+		types = append(types, pb.Event_Type(val))
+	}
+	return types, nil
+}
+
+// eventMatches returns whether or not an event passes type and thread filters.
+// Pings are always included. Node status events are not scoped to a thread,
+// so they are only subject to the type filter.
+func eventMatches(event *pb.Event, types []pb.Event_Type, thread string) bool {
+	if event.Type == pb.Event_PING {
+		return true
+	}
+	if len(types) > 0 {
+		var match bool
+		for _, t := range types {
+			if t == event.Type {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	switch event.Type {
+	case pb.Event_NODE_ONLINE, pb.Event_NODE_OFFLINE:
+		return true
+	default:
+		return thread == "" || event.Thread == thread
+	}
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestParseEventTypes(t *testing.T) {
+	types, err := parseEventTypes("thread_update|NOTIFICATION")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(types) != 2 || types[0] != pb.Event_THREAD_UPDATE || types[1] != pb.Event_NOTIFICATION {
+		t.Errorf("unexpected types: %v", types)
+	}
+
+	types, err = parseEventTypes("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(types) != 0 {
+		t.Error("empty list should include all types")
+	}
+
+	if _, err := parseEventTypes("THREAD_UPDATE|BOGUS"); err == nil {
+		t.Error("unknown type should fail")
+	}
+}
+
+func TestEventMatches(t *testing.T) {
+	update := &pb.Event{Type: pb.Event_THREAD_UPDATE, Thread: "a"}
+	wallet := &pb.Event{Type: pb.Event_WALLET_UPDATE}
+	online := &pb.Event{Type: pb.Event_NODE_ONLINE}
+	ping := &pb.Event{Type: pb.Event_PING}
+
+	if !eventMatches(update, nil, "") || !eventMatches(wallet, nil, "") {
+		t.Error("unfiltered events should match")
+	}
+	if !eventMatches(update, nil, "a") || eventMatches(update, nil, "b") {
+		t.Error("thread filter failed")
+	}
+	if eventMatches(wallet, nil, "a") {
+		t.Error("events without a thread should not match a thread filter")
+	}
+	if !eventMatches(online, nil, "a") {
+		t.Error("node status events should ignore the thread filter")
+	}
+
+	types := []pb.Event_Type{pb.Event_NOTIFICATION}
+	if eventMatches(update, types, "") || eventMatches(online, types, "") {
+		t.Error("type filter failed")
+	}
+	if !eventMatches(ping, types, "b") {
+		t.Error("pings should always match")
+	}
+}
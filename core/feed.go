@@ -2,15 +2,28 @@ package core
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
 )
 
+// ErrInvalidFeedAuthor indicates a feed author filter is not a peer id
+var ErrInvalidFeedAuthor = fmt.Errorf("feed author must be a peer id")
+
+// ErrInvalidFeedMedia indicates a feed media filter is not a media type or top-level type
+var ErrInvalidFeedMedia = fmt.Errorf("feed media must be a media type or top-level type")
+
+// mediaRx matches a media type (e.g., image/jpeg) or top-level type (e.g., image)
+var mediaRx = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9!#$&^_.+-]*(/[a-zA-Z0-9][a-zA-Z0-9!#$&^_.+-]*)?$`)
+
 var flatFeedTypes = []pb.Block_BlockType{
 	pb.Block_JOIN,
 	pb.Block_LEAVE,
@@ -18,6 +31,7 @@ var flatFeedTypes = []pb.Block_BlockType{
 	pb.Block_TEXT,
 	pb.Block_COMMENT,
 	pb.Block_LIKE,
+	pb.Block_REACTION,
 }
 
 var annotatedFeedTypes = []pb.Block_BlockType{
@@ -37,34 +51,24 @@ type feedItemOpts struct {
 	annotations bool
 	comments    []*pb.Comment
 	likes       []*pb.Like
+	replies     []*pb.Text
 	target      *pb.FeedItem
 }
 
+// Feed returns a page of feed items. Filters are applied by the block query, so pages
+// are filled even when most blocks don't match.
 func (t *Textile) Feed(req *pb.FeedRequest) (*pb.FeedItemList, error) {
-	var types []pb.Block_BlockType
-	switch req.Mode {
-	case pb.FeedRequest_CHRONO, pb.FeedRequest_STACKS:
-		types = flatFeedTypes
-	case pb.FeedRequest_ANNOTATED:
-		types = annotatedFeedTypes
-	}
-
-	var query string
-	for i, t := range types {
-		query += fmt.Sprintf("type=%d", t)
-		if i != len(types)-1 {
-			query += " or "
-		}
-	}
-	query = "(" + query + ")"
 	if req.Thread != "" {
 		if t.Thread(req.Thread) == nil {
 			return nil, ErrThreadNotFound
 		}
-		query = fmt.Sprintf("(threadId='%s') and %s", req.Thread, query)
+	}
+	query, err := feedQuery(req)
+	if err != nil {
+		return nil, err
 	}
 
-	blocks := t.Blocks(req.Offset, int(req.Limit), query)
+	blocks := t.datastore.Blocks().List(req.Offset, int(req.Limit), query)
 	list := make([]*pb.FeedItem, 0)
 	var count int
 
@@ -129,6 +133,85 @@ func (t *Textile) Feed(req *pb.FeedRequest) (*pb.FeedItemList, error) {
 	}, nil
 }
 
+// feedQuery returns a block query for the feed mode and filters, excluding ignored blocks.
+// Filter values are validated before they're added to the query.
+func feedQuery(req *pb.FeedRequest) (string, error) {
+	if req.Thread != "" {
+		if _, err := peer.IDB58Decode(req.Thread); err != nil {
+			return "", ErrThreadNotFound
+		}
+	}
+	if req.Author != "" {
+		if _, err := peer.IDB58Decode(req.Author); err != nil {
+			return "", ErrInvalidFeedAuthor
+		}
+	}
+	if req.Media != "" && !mediaRx.MatchString(req.Media) {
+		return "", ErrInvalidFeedMedia
+	}
+
+	var modeTypes []pb.Block_BlockType
+	switch req.Mode {
+	case pb.FeedRequest_CHRONO, pb.FeedRequest_STACKS:
+		modeTypes = flatFeedTypes
+	case pb.FeedRequest_ANNOTATED:
+		modeTypes = annotatedFeedTypes
+	}
+
+	// filtered types must be shown by the mode
+	types := modeTypes
+	if len(req.Types) > 0 {
+		types = nil
+		for _, mt := range modeTypes {
+			for _, rt := range req.Types {
+				if mt == rt {
+					types = append(types, mt)
+					break
+				}
+			}
+		}
+	}
+	if req.Media != "" {
+		var filesOnly []pb.Block_BlockType
+		for _, bt := range types {
+			if bt == pb.Block_FILES {
+				filesOnly = append(filesOnly, bt)
+			}
+		}
+		types = filesOnly
+	}
+
+	// an empty type list matches nothing
+	var list []string
+	for _, bt := range types {
+		list = append(list, strconv.Itoa(int(bt)))
+	}
+	conds := []string{
+		"type in (" + strings.Join(list, ",") + ")",
+		"('ignore-'||id) not in (select target from blocks where type=" + strconv.Itoa(int(pb.Block_IGNORE)) + ")",
+	}
+
+	if req.Thread != "" {
+		conds = append(conds, fmt.Sprintf("threadId='%s'", req.Thread))
+	}
+	if req.Author != "" {
+		conds = append(conds, fmt.Sprintf("authorId='%s'", req.Author))
+	}
+	if req.After != nil {
+		conds = append(conds, fmt.Sprintf("date>=%d", util.ProtoNanos(req.After)))
+	}
+	if req.Before != nil {
+		conds = append(conds, fmt.Sprintf("date<%d", util.ProtoNanos(req.Before)))
+	}
+	if req.Media != "" {
+		conds = append(conds, fmt.Sprintf("exists (select 1 from files where "+
+			"instr(','||files.targets||',', ','||blocks.target||',')>0 and "+
+			"(files.media='%s' or files.media like '%s/%%'))", req.Media, req.Media))
+	}
+
+	return "(" + strings.Join(conds, ") and (") + ")", nil
+}
+
 func (t *Textile) feedItem(block *pb.Block, opts feedItemOpts) (*pb.FeedItem, error) {
 	if block == nil {
 		return nil, nil
@@ -165,6 +248,8 @@ func (t *Textile) feedItem(block *pb.Block, opts feedItemOpts) (*pb.FeedItem, er
 		payload, err = t.comment(block, opts)
 	case pb.Block_LIKE:
 		payload, err = t.like(block, opts)
+	case pb.Block_REACTION:
+		payload, err = t.reaction(block, opts)
 	default:
 		return nil, nil
 	}
@@ -178,12 +263,17 @@ func (t *Textile) feedItem(block *pb.Block, opts feedItemOpts) (*pb.FeedItem, er
 	}
 	item.Payload.Value = value
 
+	if block.Type != pb.Block_LIKE && block.Type != pb.Block_REACTION {
+		item.Reactions = t.reactionCounts(block.Id)
+	}
+
 	return item, nil
 }
 
 func (t *Textile) feedStackItem(stack feedStack) (*pb.FeedItem, error) {
 	var comments []*pb.Comment
 	var likes []*pb.Like
+	var replies []*pb.Text
 
 	// Does the stack contain the initial target,
 	// or is it a continuation stack of just annotations?
@@ -203,6 +293,18 @@ func (t *Textile) feedStackItem(stack feedStack) (*pb.FeedItem, error) {
 				return err
 			}
 			likes = append(likes, like)
+		case pb.Block_REACTION:
+			// reactions are aggregated on the target item
+		case pb.Block_TEXT:
+			if !isAnnotation(child) {
+				target = child
+				break
+			}
+			reply, err := t.message(child, feedItemOpts{annotations: true})
+			if err != nil {
+				return err
+			}
+			replies = append(replies, reply)
 		default:
 			target = child
 		}
@@ -236,6 +338,7 @@ func (t *Textile) feedStackItem(stack feedStack) (*pb.FeedItem, error) {
 	targetItem, err := t.feedItem(target, feedItemOpts{
 		comments: comments,
 		likes:    likes,
+		replies:  replies,
 	})
 	if err != nil {
 		return nil, err
@@ -300,6 +403,8 @@ func GetFeedItemPayload(item *pb.FeedItem) (FeedItemPayload, error) {
 		payload = new(pb.Comment)
 	case pb.Block_LIKE:
 		payload = new(pb.Like)
+	case pb.Block_REACTION:
+		payload = new(pb.Reaction)
 	default:
 		return nil, fmt.Errorf("unable to parse payload")
 	}
@@ -311,18 +416,20 @@ func GetFeedItemPayload(item *pb.FeedItem) (FeedItemPayload, error) {
 }
 
 func getTargetId(block *pb.Block) string {
-	switch block.Type {
-	case pb.Block_COMMENT, pb.Block_LIKE:
+	if isAnnotation(block) {
 		return block.Target
-	default:
-		return block.Id
 	}
+	return block.Id
 }
 
+// isAnnotation returns whether or not a block annotates a target, i.e.,
+// a comment, like, reaction, or message reply
 func isAnnotation(block *pb.Block) bool {
 	switch block.Type {
-	case pb.Block_COMMENT, pb.Block_LIKE:
+	case pb.Block_COMMENT, pb.Block_LIKE, pb.Block_REACTION:
 		return true
+	case pb.Block_TEXT:
+		return block.Target != ""
 	default:
 		return false
 	}
@@ -35,11 +35,15 @@ func (t *Textile) comment(block *pb.Block, opts feedItemOpts) (*pb.Comment, erro
 		return nil, ErrBlockWrongType
 	}
 
+	body, revs := t.blockRevisions(block)
 	item := &pb.Comment{
-		Id:   block.Id,
-		Date: block.Date,
-		User: t.PeerUser(block.Author),
-		Body: block.Body,
+		Id:        block.Id,
+		Date:      block.Date,
+		User:      t.PeerUser(block.Author),
+		Body:      body,
+		Mentions:  t.mentionUsers(body),
+		Edited:    len(revs) > 0,
+		Revisions: revs,
 	}
 
 	if opts.target != nil {
@@ -40,16 +40,60 @@ func (t *Textile) Message(blockId string) (*pb.Text, error) {
 	return t.message(block, feedItemOpts{annotations: true})
 }
 
+// Replies returns the replies to a message
+func (t *Textile) Replies(blockId string) (*pb.TextList, error) {
+	block, err := t.Block(blockId)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type != pb.Block_TEXT {
+		return nil, ErrBlockWrongType
+	}
+
+	list := make([]*pb.Text, 0)
+
+	query := fmt.Sprintf("type=%d and target='%s'", pb.Block_TEXT, block.Id)
+	for _, reply := range t.Blocks("", -1, query).Items {
+		msg, err := t.message(reply, feedItemOpts{annotations: true})
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, msg)
+	}
+
+	return &pb.TextList{Items: list}, nil
+}
+
 func (t *Textile) message(block *pb.Block, opts feedItemOpts) (*pb.Text, error) {
 	if block.Type != pb.Block_TEXT {
 		return nil, ErrBlockWrongType
 	}
 
+	body, revs := t.blockRevisions(block)
 	item := &pb.Text{
-		Block: block.Id,
-		Date:  block.Date,
-		User:  t.PeerUser(block.Author),
-		Body:  block.Body,
+		Block:     block.Id,
+		Date:      block.Date,
+		User:      t.PeerUser(block.Author),
+		Body:      body,
+		Mentions:  t.mentionUsers(body),
+		Edited:    len(revs) > 0,
+		Revisions: revs,
+		ReplyTo:   block.Target,
+	}
+
+	if block.Target != "" {
+		if opts.target != nil {
+			item.Target = opts.target
+		} else if !opts.annotations {
+			// the replied to message is loaded without its own target
+			target, err := t.feedItem(t.datastore.Blocks().Get(block.Target), feedItemOpts{
+				annotations: true,
+			})
+			if err != nil {
+				return nil, err
+			}
+			item.Target = target
+		}
 	}
 
 	if opts.annotations {
@@ -67,6 +111,7 @@ func (t *Textile) message(block *pb.Block, opts feedItemOpts) (*pb.Text, error)
 	} else {
 		item.Comments = opts.comments
 		item.Likes = opts.likes
+		item.Replies = opts.replies
 	}
 
 	return item, nil
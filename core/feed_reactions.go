@@ -0,0 +1,121 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// Reactions lists the reactions on a target block, including likes as hearts
+func (t *Textile) Reactions(target string) (*pb.ReactionList, error) {
+	reactions := make([]*pb.Reaction, 0)
+
+	for _, block := range t.Blocks("", -1, reactionsQuery(target)).Items {
+		info, err := t.reaction(block, feedItemOpts{annotations: true})
+		if err != nil {
+			continue
+		}
+		reactions = append(reactions, info)
+	}
+
+	return &pb.ReactionList{Items: reactions}, nil
+}
+
+func (t *Textile) Reaction(blockId string) (*pb.Reaction, error) {
+	block, err := t.Block(blockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.reaction(block, feedItemOpts{annotations: true})
+}
+
+func (t *Textile) reaction(block *pb.Block, opts feedItemOpts) (*pb.Reaction, error) {
+	if block.Type != pb.Block_REACTION && block.Type != pb.Block_LIKE {
+		return nil, ErrBlockWrongType
+	}
+
+	item := &pb.Reaction{
+		Id:       block.Id,
+		Date:     block.Date,
+		User:     t.PeerUser(block.Author),
+		Reaction: blockReaction(block),
+	}
+
+	if opts.target != nil {
+		item.Target = opts.target
+	} else if !opts.annotations {
+		target, err := t.feedItem(t.datastore.Blocks().Get(block.Target), feedItemOpts{})
+		if err != nil {
+			return nil, err
+		}
+		item.Target = target
+	}
+
+	return item, nil
+}
+
+// reactionCounts returns the aggregated reactions on a target block
+func (t *Textile) reactionCounts(target string) []*pb.ReactionCount {
+	blocks := t.Blocks("", -1, reactionsQuery(target)).Items
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	authors := make(map[string]string)
+	if thrd := t.Thread(blocks[0].Thread); thrd != nil {
+		for _, block := range blocks {
+			if _, ok := authors[block.Author]; !ok {
+				authors[block.Author] = thrd.blockAddress(block)
+			}
+		}
+	}
+	return countReactions(blocks, authors, t.account.Address())
+}
+
+// reactionsQuery returns a block query for the likes and reactions on a target block
+func reactionsQuery(target string) string {
+	return fmt.Sprintf("type in (%d,%d) and target='%s'", pb.Block_LIKE, pb.Block_REACTION, target)
+}
+
+// countReactions aggregates like and reaction blocks by reaction key, most popular first.
+// Accounts are counted once per key, with authors mapping block authors to account
+// addresses. Unknown authors are counted by peer.
+func countReactions(blocks []*pb.Block, authors map[string]string, address string) []*pb.ReactionCount {
+	counts := make(map[string]*pb.ReactionCount)
+	seen := make(map[string]struct{})
+	for _, block := range blocks {
+		key := blockReaction(block)
+		account := authors[block.Author]
+		if account == "" {
+			account = block.Author
+		}
+		if _, ok := seen[key+"/"+account]; ok {
+			continue
+		}
+		seen[key+"/"+account] = struct{}{}
+
+		count, ok := counts[key]
+		if !ok {
+			count = &pb.ReactionCount{Reaction: key}
+			counts[key] = count
+		}
+		count.Count++
+		if account == address {
+			count.Reacted = true
+		}
+	}
+
+	list := make([]*pb.ReactionCount, 0, len(counts))
+	for _, count := range counts {
+		list = append(list, count)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Count != list[j].Count {
+			return list[i].Count > list[j].Count
+		}
+		return list[i].Reaction < list[j].Reaction
+	})
+	return list
+}
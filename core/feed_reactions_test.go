@@ -0,0 +1,67 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestCountReactions(t *testing.T) {
+	blocks := []*pb.Block{
+		{Author: "p1", Type: pb.Block_REACTION, Body: "🎉"},
+		{Author: "p2", Type: pb.Block_LIKE},
+		{Author: "p3", Type: pb.Block_REACTION, Body: "heart"},
+		{Author: "p4", Type: pb.Block_REACTION, Body: "heart"},
+		{Author: "p5", Type: pb.Block_REACTION, Body: "🎉"},
+		{Author: "p6", Type: pb.Block_REACTION, Body: "👀"},
+	}
+	authors := map[string]string{
+		"p1": "alice",
+		"p2": "bob",
+		"p3": "bob", // bob's second peer, counted once
+		"p4": "carol",
+	}
+
+	counts := countReactions(blocks, authors, "alice")
+	if len(counts) != 3 {
+		t.Fatalf("wrong number of counts: %d", len(counts))
+	}
+	expected := []struct {
+		reaction string
+		count    int32
+		reacted  bool
+	}{
+		{"heart", 2, false},
+		{"🎉", 2, true},
+		{"👀", 1, false},
+	}
+	for i, e := range expected {
+		c := counts[i]
+		if c.Reaction != e.reaction || c.Count != e.count || c.Reacted != e.reacted {
+			t.Errorf("count %d should be %s/%d/%t, got %s/%d/%t",
+				i, e.reaction, e.count, e.reacted, c.Reaction, c.Count, c.Reacted)
+		}
+	}
+}
+
+func TestNormalizeReaction(t *testing.T) {
+	if r, err := normalizeReaction(" 🎉 "); err != nil || r != "🎉" {
+		t.Errorf("reaction should be trimmed: %s", r)
+	}
+	for _, r := range []string{"", "  ", "thumbs up", "abcdefghijklmnopqrstuvwxyz1234567"} {
+		if _, err := normalizeReaction(r); err != ErrInvalidReaction {
+			t.Errorf("reaction %q should be invalid", r)
+		}
+	}
+}
+
+func TestCheckReaction(t *testing.T) {
+	if err := checkReaction("🎉"); err != nil {
+		t.Errorf("reaction should be valid: %s", err)
+	}
+	for _, r := range []string{"", " 🎉", "thumbs up", "abcdefghijklmnopqrstuvwxyz1234567"} {
+		if err := checkReaction(r); err != ErrInvalidReaction {
+			t.Errorf("reaction %q should be invalid", r)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/textileio/go-textile/pb"
+)
+
+const testFeedThread = "12D3KooWJq2e9xWrccbyfY3MnXBZyDDKiKV8vZ1Rt4HtofzUAMe6"
+const testFeedAuthor = "QmQA7swSsZKoayPHaTPgzZ1u3SCQjLvLyKcN6RRMmTbLau"
+
+func TestFeedQuery(t *testing.T) {
+	query, err := feedQuery(&pb.FeedRequest{
+		Mode: pb.FeedRequest_ANNOTATED,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(query, "(type in (3,5,7,6))") {
+		t.Errorf("bad mode types: %s", query)
+	}
+
+	query, err = feedQuery(&pb.FeedRequest{
+		Mode:  pb.FeedRequest_ANNOTATED,
+		Types: []pb.Block_BlockType{pb.Block_TEXT, pb.Block_COMMENT},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(query, "(type in (6))") {
+		t.Errorf("types should be narrowed by the mode: %s", query)
+	}
+
+	query, err = feedQuery(&pb.FeedRequest{
+		Mode:   pb.FeedRequest_CHRONO,
+		Thread: testFeedThread,
+		Author: testFeedAuthor,
+		After:  &timestamp.Timestamp{Seconds: 1},
+		Before: &timestamp.Timestamp{Seconds: 2},
+		Media:  "image",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cond := range []string{
+		"(type in (7))",
+		"(threadId='" + testFeedThread + "')",
+		"(authorId='" + testFeedAuthor + "')",
+		"(date>=1000000000)",
+		"(date<2000000000)",
+		"files.media like 'image/%'",
+	} {
+		if !strings.Contains(query, cond) {
+			t.Errorf("expected %s in %s", cond, query)
+		}
+	}
+}
+
+func TestFeedQuery_Invalid(t *testing.T) {
+	for _, req := range []*pb.FeedRequest{
+		{Thread: "x' or '1'='1"},
+		{Author: "x' or '1'='1"},
+		{Media: "image' or '1'='1"},
+		{Media: "image/"},
+	} {
+		if _, err := feedQuery(req); err == nil {
+			t.Errorf("expected an error for %s", req.String())
+		}
+	}
+
+	if _, err := feedQuery(&pb.FeedRequest{Media: "image/svg+xml"}); err != nil {
+		t.Errorf("valid media should pass: %s", err)
+	}
+}
+
+func TestGetTargetId(t *testing.T) {
+	for _, test := range []struct {
+		block      *pb.Block
+		target     string
+		annotation bool
+	}{
+		{&pb.Block{Id: "a", Type: pb.Block_TEXT}, "a", false},
+		{&pb.Block{Id: "b", Type: pb.Block_TEXT, Target: "a"}, "a", true},
+		{&pb.Block{Id: "c", Type: pb.Block_COMMENT, Target: "a"}, "a", true},
+		{&pb.Block{Id: "d", Type: pb.Block_FILES, Target: "x"}, "d", false},
+	} {
+		if id := getTargetId(test.block); id != test.target {
+			t.Errorf("block %s should stack under %s, got %s", test.block.Id, test.target, id)
+		}
+		if isAnnotation(test.block) != test.annotation {
+			t.Errorf("block %s annotation should be %t", test.block.Id, test.annotation)
+		}
+	}
+}
+
+func TestCheckReply(t *testing.T) {
+	if err := checkReply(&pb.Block{Thread: "t1", Type: pb.Block_TEXT}, "t1"); err != nil {
+		t.Errorf("reply to message should pass: %s", err)
+	}
+	if err := checkReply(&pb.Block{Thread: "t1", Type: pb.Block_COMMENT}, "t1"); err != ErrReplyTargetNotFound {
+		t.Error("reply to comment should fail")
+	}
+	if err := checkReply(&pb.Block{Thread: "t2", Type: pb.Block_TEXT}, "t1"); err != ErrReplyTargetNotFound {
+		t.Error("reply to message in another thread should fail")
+	}
+	if err := checkReply(nil, "t1"); err != ErrReplyTargetNotFound {
+		t.Error("reply to missing message should fail")
+	}
+}
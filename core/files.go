@@ -4,13 +4,19 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"hash"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/ptypes"
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	ipld "github.com/ipfs/go-ipld-format"
 	uio "github.com/ipfs/go-unixfs/io"
 	"github.com/mr-tron/base58/base58"
@@ -18,6 +24,7 @@ import (
 	"github.com/textileio/go-textile/ipfs"
 	m "github.com/textileio/go-textile/mill"
 	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
 	"github.com/textileio/go-textile/repo/db"
 	"github.com/textileio/go-textile/schema"
 )
@@ -38,9 +45,26 @@ type AddFileConfig struct {
 	Media     string `json:"media"`
 	Name      string `json:"name"`
 	Plaintext bool   `json:"plaintext"`
+
+	// Reader, if set, is used instead of Input.
+	// It's closed after use if it's an io.Closer.
+	Reader io.Reader `json:"-"`
 }
 
 func (t *Textile) AddFileIndex(mill m.Mill, conf AddFileConfig) (*pb.FileIndex, error) {
+	if conf.Reader != nil {
+		if smill, ok := mill.(m.StreamMill); ok {
+			return t.addFileIndexStream(smill, conf)
+		}
+
+		input, err := ioutil.ReadAll(conf.Reader)
+		closeReader(conf.Reader)
+		if err != nil {
+			return nil, err
+		}
+		conf.Input = input
+	}
+
 	var source string
 	if conf.Use != "" {
 		source = conf.Use
@@ -81,20 +105,14 @@ func (t *Textile) AddFileIndex(mill m.Mill, conf AddFileConfig) (*pb.FileIndex,
 		Meta:     pb.ToStruct(res.Meta),
 	}
 
-	var reader *bytes.Reader
+	var reader io.Reader = bytes.NewReader(res.File)
 	if mill.Encrypt() && !conf.Plaintext {
-		key, err := crypto.GenerateAESKey()
-		if err != nil {
-			return nil, err
-		}
-		ciphertext, err := crypto.EncryptAES(res.File, key)
+		var key []byte
+		reader, key, err = t.encryptFile(reader)
 		if err != nil {
 			return nil, err
 		}
 		model.Key = base58.FastBase58Encoding(key)
-		reader = bytes.NewReader(ciphertext)
-	} else {
-		reader = bytes.NewReader(res.File)
 	}
 
 	hash, err := ipfs.AddData(t.node, reader, mill.Pin())
@@ -110,6 +128,9 @@ func (t *Textile) AddFileIndex(mill m.Mill, conf AddFileConfig) (*pb.FileIndex,
 		}
 		return nil, err
 	}
+	if err := fileIndexed(t.datastore, model); err != nil {
+		return nil, err
+	}
 
 	// Return the model fetched from the datastore to ensure
 	// consistent date formatting and therefore consistent
@@ -117,6 +138,170 @@ func (t *Textile) AddFileIndex(mill m.Mill, conf AddFileConfig) (*pb.FileIndex,
 	return t.datastore.Files().Get(model.Hash), nil
 }
 
+// addFileIndexStream adds a file by streaming its input through a mill.
+// The input is hashed first, so existing files from the same source are found
+// without milling or adding anything.
+func (t *Textile) addFileIndexStream(mill m.StreamMill, conf AddFileConfig) (*pb.FileIndex, error) {
+	defer closeReader(conf.Reader)
+
+	opts, err := mill.Options(map[string]interface{}{
+		"plaintext": conf.Plaintext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// find the source before milling so that duplicates are not added again
+	input := conf.Reader
+	source := conf.Use
+	if source == "" {
+		shash := sha256.New()
+		rinput, cleanup, err := t.rewindable(conf.Reader, shash)
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
+		input = rinput
+		source = checksumSum(shash, conf.Plaintext)
+	}
+	if efile := t.datastore.Files().GetBySource(mill.ID(), source, opts); efile != nil {
+		return efile, nil
+	}
+
+	res, err := mill.MillStream(input, conf.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	chash := sha256.New()
+	counter := &countWriter{}
+	var reader io.Reader = io.TeeReader(res.File, io.MultiWriter(chash, counter))
+
+	var key []byte
+	if mill.Encrypt() && !conf.Plaintext {
+		reader, key, err = t.encryptFile(reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hash, err := ipfs.AddDataStream(t.node, reader, mill.Pin())
+	if err != nil {
+		return nil, err
+	}
+	check := checksumSum(chash, conf.Plaintext)
+
+	// a different source may mill to the same output
+	if efile := t.datastore.Files().GetByPrimary(mill.ID(), check); efile != nil {
+		if mill.Pin() && efile.Hash != hash.Hash().B58String() {
+			if err := ipfs.UnpinCid(t.node, *hash, false); err != nil {
+				log.Warningf("error unpinning duplicate %s: %s", hash.String(), err)
+			}
+		}
+		return efile, nil
+	}
+
+	model := &pb.FileIndex{
+		Mill:     mill.ID(),
+		Checksum: check,
+		Source:   source,
+		Opts:     opts,
+		Hash:     hash.Hash().B58String(),
+		Media:    conf.Media,
+		Name:     conf.Name,
+		Size:     counter.n,
+		Added:    ptypes.TimestampNow(),
+		Meta:     pb.ToStruct(res.Meta),
+	}
+	if key != nil {
+		model.Key = base58.FastBase58Encoding(key)
+	}
+
+	if err := t.datastore.Files().Add(model); err != nil {
+		if db.ConflictError(err) {
+			// we may have lost the race
+			return t.datastore.Files().Get(model.Hash), nil
+		}
+		return nil, err
+	}
+	if err := fileIndexed(t.datastore, model); err != nil {
+		return nil, err
+	}
+
+	return t.datastore.Files().Get(model.Hash), nil
+}
+
+// encryptFile returns a reader of reader's contents encrypted with a new key.
+// The AES stream format bounds memory, but peers that predate it can't decrypt it,
+// so it's only used when enabled. Otherwise, the whole file is encrypted in memory.
+func (t *Textile) encryptFile(reader io.Reader) (io.Reader, []byte, error) {
+	key, err := crypto.GenerateAESKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if t.config.Threads.StreamEncryption {
+		ciphertext, err := crypto.EncryptAESStream(reader, key)
+		if err != nil {
+			return nil, nil, err
+		}
+		return ciphertext, key, nil
+	}
+
+	plaintext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	ciphertext, err := crypto.EncryptAES(plaintext, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bytes.NewReader(ciphertext), key, nil
+}
+
+// rewindable reads all of reader into h, returning a reader of the same data from
+// the start. Readers that can't seek are spooled to a temp file in the repo, which
+// is removed by cleanup.
+func (t *Textile) rewindable(reader io.Reader, h hash.Hash) (io.Reader, func(), error) {
+	if seeker, ok := reader.(io.ReadSeeker); ok {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := io.Copy(h, seeker); err != nil {
+			return nil, nil, err
+		}
+		if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+			return nil, nil, err
+		}
+		return seeker, func() {}, nil
+	}
+
+	dir := filepath.Join(t.repoPath, "tmp")
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, nil, err
+	}
+	file, err := ioutil.TempFile(dir, "add")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		file.Close()
+		if err := os.Remove(file.Name()); err != nil {
+			log.Warningf("error removing %s: %s", file.Name(), err)
+		}
+	}
+	if _, err := io.Copy(io.MultiWriter(file, h), reader); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return file, cleanup, nil
+}
+
 func (t *Textile) GetMedia(reader io.Reader, mill m.Mill) (string, error) {
 	buffer := make([]byte, 512)
 	n, err := reader.Read(buffer)
@@ -125,6 +310,11 @@ func (t *Textile) GetMedia(reader io.Reader, mill m.Mill) (string, error) {
 	}
 	media := http.DetectContentType(buffer[:n])
 
+	// the standard sniffer only knows quicktime files by mp4 compatible brands
+	if media == "application/octet-stream" && n >= 12 && string(buffer[4:12]) == "ftypqt  " {
+		media = "video/quicktime"
+	}
+
 	return media, mill.AcceptMedia(media)
 }
 
@@ -235,27 +425,35 @@ func (t *Textile) FileContent(hash string) (io.ReadSeeker, *pb.FileIndex, error)
 	return reader, file, err
 }
 
+// FileIndexContent returns a seekable reader of a file's plaintext content.
+// Content in AES stream format is decrypted lazily, as it's read.
+// The reader should be closed if it's an io.Closer.
 func (t *Textile) FileIndexContent(file *pb.FileIndex) (io.ReadSeeker, error) {
-	fd, err := ipfs.DataAtPath(t.node, file.Hash)
+	fd, err := ipfs.FileAtPath(t.node, file.Hash)
 	if err != nil {
 		return nil, err
 	}
+	if file.Key == "" {
+		return fd, nil
+	}
 
-	var plaintext []byte
-	if file.Key != "" {
-		key, err := base58.Decode(file.Key)
-		if err != nil {
-			return nil, err
-		}
-		plaintext, err = crypto.DecryptAES(fd, key)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		plaintext = fd
+	key, err := base58.Decode(file.Key)
+	if err != nil {
+		closeReader(fd)
+		return nil, err
+	}
+	reader, err := crypto.DecryptAESReader(fd, key)
+	if err != nil {
+		closeReader(fd)
+		return nil, err
 	}
+	return &readSeekCloser{ReadSeeker: reader, Closer: fd}, nil
+}
 
-	return bytes.NewReader(plaintext), nil
+// readSeekCloser pairs a reader with the closer of its source
+type readSeekCloser struct {
+	io.ReadSeeker
+	io.Closer
 }
 
 func (t *Textile) TargetNodeKeys(node ipld.Node) (*pb.Keys, error) {
@@ -345,6 +543,35 @@ func (t *Textile) checksum(plaintext []byte, willEncrypt bool) string {
 	return base58.FastBase58Encoding(sum[:])
 }
 
+// checksumSum finishes a streamed checksum, matching Textile.checksum
+func checksumSum(h hash.Hash, willEncrypt bool) string {
+	var add int
+	if willEncrypt {
+		add = 1
+	}
+	h.Write([]byte{byte(add)})
+	return base58.FastBase58Encoding(h.Sum(nil))
+}
+
+// countWriter counts the bytes written to it
+type countWriter struct {
+	n int64
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// closeReader closes reader if it's an io.Closer
+func closeReader(reader io.Reader) {
+	if closer, ok := reader.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			log.Warningf("error closing reader: %s", err)
+		}
+	}
+}
+
 func (t *Textile) fileNodeKeys(node ipld.Node, index int, keys *map[string]string) error {
 	vkeys := *keys
 
@@ -401,3 +628,50 @@ func looksLikeFileNode(node ipld.Node) bool {
 	}
 	return true
 }
+
+// fileIndexed indexes the searchable text of a newly added file and records the change
+func fileIndexed(datastore repo.Datastore, file *pb.FileIndex) error {
+	if err := datastore.SearchIndex().Index(file.Hash, "", fileSearchText(file)); err != nil {
+		return err
+	}
+	return addChange(datastore, pb.Change_FILE_INDEXED, file.Hash, "")
+}
+
+// fileSearchText returns the searchable text of a file, which is
+// its name followed by the values of its metadata
+func fileSearchText(file *pb.FileIndex) string {
+	words := []string{file.Name}
+
+	var walk func(v *structpb.Value)
+	walk = func(v *structpb.Value) {
+		switch k := v.GetKind().(type) {
+		case *structpb.Value_StructValue:
+			walkStruct(k.StructValue, walk)
+		case *structpb.Value_ListValue:
+			for _, i := range k.ListValue.GetValues() {
+				walk(i)
+			}
+		case *structpb.Value_StringValue:
+			words = append(words, k.StringValue)
+		case *structpb.Value_NumberValue:
+			words = append(words, fmt.Sprint(k.NumberValue))
+		}
+	}
+	if file.Meta != nil {
+		walkStruct(file.Meta, walk)
+	}
+
+	return strings.TrimSpace(strings.Join(words, " "))
+}
+
+// walkStruct visits struct fields in key order
+func walkStruct(s *structpb.Struct, visit func(v *structpb.Value)) {
+	keys := make([]string, 0, len(s.GetFields()))
+	for k := range s.GetFields() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		visit(s.Fields[k])
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/textileio/go-textile/crypto"
 	"github.com/textileio/go-textile/ipfs"
 	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/db"
 	"github.com/textileio/go-textile/util"
 )
 
@@ -137,7 +138,10 @@ func (t *Textile) IgnoreInvite(id string) error {
 	if err := t.datastore.Invites().Delete(id); err != nil {
 		return err
 	}
-	return t.datastore.Notifications().DeleteByBlock(id)
+	if err := t.datastore.Notifications().DeleteByBlock(id); err != nil {
+		return err
+	}
+	return addChange(t.datastore, pb.Change_NOTIFICATION_REMOVED, "", "")
 }
 
 // handleThreadAdd uses an add block to join a thread
@@ -165,6 +169,10 @@ func (t *Textile) handleThreadAdd(plaintext []byte) (mh.Multihash, error) {
 		ttype:     msg.Thread.Type,
 		sharing:   msg.Thread.Sharing,
 		whitelist: msg.Thread.Whitelist,
+		roles:     make(map[string]pb.Thread_Role),
+	}
+	for _, r := range msg.Roles {
+		dummy.roles[r.Address] = r.Role
 	}
 	if !dummy.shareable(msg.Inviter.Address, t.config.Account.Address) {
 		return nil, ErrNotShareable
@@ -204,6 +212,16 @@ func (t *Textile) handleThreadAdd(plaintext []byte) (mh.Multihash, error) {
 		return nil, err
 	}
 
+	// store rotated keys so that newer blocks can be decrypted
+	for _, key := range msg.Keys {
+		key.Thread = thrd.Id
+		if err := t.datastore.ThreadKeys().Add(key); err != nil {
+			if !db.ConflictError(err) {
+				return nil, err
+			}
+		}
+	}
+
 	// follow parents, update head
 	if err := thrd.handleAddBlock(block); err != nil {
 		return nil, err
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	files "github.com/ipfs/go-ipfs-files"
 	utilmain "github.com/ipfs/go-ipfs/cmd/ipfs/util"
 	oldcmds "github.com/ipfs/go-ipfs/commands"
 	"github.com/ipfs/go-ipfs/core"
@@ -61,6 +62,7 @@ type InitConfig struct {
 	CafeOpen        bool
 	CafeURL         string
 	CafeNeighborURL string
+	CafeAdminToken  string
 }
 
 // MigrateConfig is used to define options during a major migration
@@ -93,6 +95,7 @@ type Textile struct {
 	updates           chan *pb.WalletUpdate
 	threadUpdates     *broadcast.Broadcaster
 	notifications     chan *pb.Notification
+	events            *broadcast.Broadcaster
 	threads           *ThreadsService
 	blockOutbox       *BlockOutbox
 	cafe              *CafeService
@@ -209,6 +212,7 @@ func NewTextile(conf RunConfig) (*Textile, error) {
 		updates:           make(chan *pb.WalletUpdate, 10),
 		threadUpdates:     broadcast.NewBroadcaster(10),
 		notifications:     make(chan *pb.Notification, 10),
+		events:            broadcast.NewBroadcaster(eventBuffer),
 		cafeOutboxHandler: conf.CafeOutboxHandler,
 	}
 
@@ -346,8 +350,10 @@ func (t *Textile) Start() error {
 		if t.config.Cafe.Host.Open {
 			go func() {
 				t.cafe.setAddrs(t.config)
+				t.cafe.host = t.config.Cafe.Host
 				t.cafe.open = true
 				t.startCafeApi(t.config.Addresses.CafeAPI)
+				go t.runCafeJobs()
 			}()
 		}
 
@@ -357,6 +363,7 @@ func (t *Textile) Start() error {
 			log.Errorf(err.Error())
 		}
 		log.Info("node is online")
+		t.sendEvent(&pb.Event{Type: pb.Event_NODE_ONLINE})
 
 		// tmp. publish contact for migrated users.
 		// this normally only happens when peer details are changed,
@@ -426,6 +433,7 @@ func (t *Textile) Stop() error {
 	t.loadedThreads = nil
 
 	log.Info("node is stopped")
+	t.sendEvent(&pb.Event{Type: pb.Event_NODE_OFFLINE})
 
 	return nil
 }
@@ -435,6 +443,7 @@ func (t *Textile) CloseChns() {
 	close(t.updates)
 	t.threadUpdates.Close()
 	close(t.notifications)
+	t.events.Close()
 }
 
 // Started returns node started status
@@ -500,6 +509,12 @@ func (t *Textile) NotificationCh() <-chan *pb.Notification {
 	return t.notifications
 }
 
+// EventListener returns a listener for thread, wallet, notification and node status events.
+// The listener's channel is closed if it falls too far behind.
+func (t *Textile) EventListener() *broadcast.Listener {
+	return t.events.Listen()
+}
+
 // PeerId returns peer id
 func (t *Textile) PeerId() (peer.ID, error) {
 	return t.node.Identity, nil
@@ -515,6 +530,21 @@ func (t *Textile) DataAtPath(path string) ([]byte, error) {
 	return ipfs.DataAtPath(t.node, path)
 }
 
+// FileAtPath returns a seekable reader of the file behind an ipfs path,
+// which must be closed
+func (t *Textile) FileAtPath(path string) (files.File, error) {
+	return ipfs.FileAtPath(t.node, path)
+}
+
+// ResolvePath returns the cid of the last node behind an ipfs path
+func (t *Textile) ResolvePath(path string) (string, error) {
+	id, err := ipfs.ResolvePath(t.node, path)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
 // LinksAtPath returns ipld links behind an ipfs path
 func (t *Textile) LinksAtPath(path string) ([]*ipld.Link, error) {
 	return ipfs.LinksAtPath(t.node, path)
@@ -683,6 +713,11 @@ func (t *Textile) sendUpdate(update *pb.WalletUpdate) {
 		return
 	}
 	t.updates <- update
+	t.sendEvent(&pb.Event{
+		Type:         pb.Event_WALLET_UPDATE,
+		Thread:       walletUpdateThread(update),
+		WalletUpdate: update,
+	})
 }
 
 // sendThreadUpdate sends a feed item to the update channel
@@ -691,22 +726,49 @@ func (t *Textile) sendThreadUpdate(block *pb.Block, key string) {
 		return
 	}
 
+	// edits update their target
+	if block.Type == pb.Block_EDIT {
+		block = t.datastore.Blocks().Get(block.Target)
+	}
+
 	update, err := t.feedItem(block, feedItemOpts{})
 	if err != nil {
 		log.Errorf("error building thread update: %s", err)
 		return
 	}
+	if update == nil {
+		// block type has no feed item
+		return
+	}
 
 	t.threadUpdates.Send(update)
+	t.sendEvent(&pb.Event{
+		Type:         pb.Event_THREAD_UPDATE,
+		Thread:       update.Thread,
+		ThreadUpdate: update,
+	})
 }
 
 // sendNotification adds a notification to the notification channel
 func (t *Textile) sendNotification(note *pb.Notification) error {
+	if !t.notificationAllowed(note) {
+		log.Debugf("notification %s silenced by settings", note.Id)
+		return nil
+	}
 	if err := t.datastore.Notifications().Add(note); err != nil {
 		return err
 	}
+	if err := addChange(t.datastore, pb.Change_NOTIFICATION_ADDED, note.Id, note.Subject); err != nil {
+		return err
+	}
 
-	t.notifications <- t.NotificationView(note)
+	view := t.NotificationView(note)
+	t.notifications <- view
+	t.sendEvent(&pb.Event{
+		Type:         pb.Event_NOTIFICATION,
+		Thread:       note.Subject,
+		Notification: view,
+	})
 	return nil
 }
 
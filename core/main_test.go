@@ -111,6 +111,34 @@ func TestTextile_API_Health(t *testing.T) {
 	util.TestURL(t, addr, http.MethodGet, http.StatusNoContent)
 }
 
+func TestTextile_API_CafeAdmin(t *testing.T) {
+	addr := "http://" + node.ApiAddr() + "/api/v0/cafe/clients"
+	status := func() int {
+		res, err := http.Get(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		return res.StatusCode
+	}
+
+	// the admin api is disabled without a configured token
+	if s := status(); s != http.StatusForbidden {
+		t.Fatalf("expected %d listing cafe clients, got %d", http.StatusForbidden, s)
+	}
+
+	conf := node.Config()
+	conf.Cafe.Host.AdminToken = "secret"
+	defer func() {
+		conf.Cafe.Host.AdminToken = ""
+	}()
+
+	// and refused without the token once enabled
+	if s := status(); s != http.StatusUnauthorized {
+		t.Fatalf("expected %d listing cafe clients, got %d", http.StatusUnauthorized, s)
+	}
+}
+
 func TestTextile_API_Stop(t *testing.T) {
 	if err := node.StopApi(); err != nil {
 		t.Errorf("stop api failed: %s", err)
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/strkey"
+)
+
+// mentionRx matches @ followed by a base58 string, i.e., a possible account address
+var mentionRx = regexp.MustCompile(`@([1-9A-HJ-NP-Za-km-z]+)`)
+
+// parseMentions returns the unique account addresses referenced as @address in a body
+func parseMentions(body string) []string {
+	var mentions []string
+	seen := make(map[string]struct{})
+	for _, match := range mentionRx.FindAllStringSubmatch(body, -1) {
+		addr := match[1]
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		if _, err := strkey.Decode(strkey.VersionByteAccountID, addr); err != nil {
+			continue
+		}
+		seen[addr] = struct{}{}
+		mentions = append(mentions, addr)
+	}
+	return mentions
+}
+
+// mentioned returns whether or not an address is in a list of mentions
+func mentioned(mentions []string, address string) bool {
+	for _, m := range mentions {
+		if m == address {
+			return true
+		}
+	}
+	return false
+}
+
+// RenderMentions replaces @address references in a body with the display
+// names of the given mentioned users
+func RenderMentions(body string, users []*pb.User) string {
+	for _, user := range users {
+		if user.Name == "" {
+			continue
+		}
+		body = strings.Replace(body, "@"+user.Address, "@"+user.Name, -1)
+	}
+	return body
+}
+
+// mentionUsers returns users for the account addresses mentioned in a body
+func (t *Textile) mentionUsers(body string) []*pb.User {
+	var users []*pb.User
+	for _, addr := range parseMentions(body) {
+		users = append(users, t.addressUser(addr))
+	}
+	return users
+}
+
+// addressUser returns a user object for an account address, with a generic
+// name if no contact is found
+func (t *Textile) addressUser(address string) *pb.User {
+	user := &pb.User{
+		Address: address,
+	}
+	contact := t.contact(address, false)
+	if contact != nil {
+		user.Name = contact.Name
+		user.Avatar = contact.Avatar
+	}
+	if user.Name == "" {
+		user.Name = ipfs.ShortenID(address)
+	}
+	return user
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/strkey"
+)
+
+func TestParseMentions(t *testing.T) {
+	addr1, err := strkey.Encode(strkey.VersionByteAccountID, make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr2, err := strkey.Encode(strkey.VersionByteAccountID, []byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := "hey @" + addr1 + ", @" + addr2 + " and @" + addr1 + " again, not @nobody"
+	mentions := parseMentions(body)
+	if len(mentions) != 2 || mentions[0] != addr1 || mentions[1] != addr2 {
+		t.Errorf("unexpected mentions: %v", mentions)
+	}
+	if !mentioned(mentions, addr2) || mentioned(mentions, "nobody") {
+		t.Error("mentioned failed")
+	}
+	if len(parseMentions("no mentions here")) != 0 {
+		t.Error("expected no mentions")
+	}
+
+	rendered := RenderMentions(body, []*pb.User{{Address: addr1, Name: "andrew"}})
+	expected := "hey @andrew, @" + addr2 + " and @andrew again, not @nobody"
+	if rendered != expected {
+		t.Errorf("expected %s, got %s", expected, rendered)
+	}
+}
@@ -0,0 +1,116 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+)
+
+// NotificationSettings returns the notification settings of a thread, or the account-wide
+// settings if thread is empty. Defaults (everything enabled) are returned if none are stored.
+func (t *Textile) NotificationSettings(thread string) (*pb.NotificationSettings, error) {
+	if thread != "" && t.Thread(thread) == nil {
+		return nil, ErrThreadNotFound
+	}
+
+	settings := t.datastore.NotificationSettings().Get(thread)
+	if settings == nil {
+		return &pb.NotificationSettings{Thread: thread}, nil
+	}
+	return settings, nil
+}
+
+// NotificationSettingsList lists all stored notification settings
+func (t *Textile) NotificationSettingsList() *pb.NotificationSettingsList {
+	return t.datastore.NotificationSettings().List()
+}
+
+// SetNotificationSettings stores the notification settings of a thread, or the account-wide
+// settings if the thread is empty. Thread settings take precedence over account-wide settings.
+func (t *Textile) SetNotificationSettings(settings *pb.NotificationSettings) error {
+	if settings.Thread != "" && t.Thread(settings.Thread) == nil {
+		return ErrThreadNotFound
+	}
+
+	settings.Updated = ptypes.TimestampNow()
+	return t.datastore.NotificationSettings().AddOrUpdate(settings)
+}
+
+// ResetNotificationSettings removes the stored notification settings of a thread,
+// or the account-wide settings if thread is empty
+func (t *Textile) ResetNotificationSettings(thread string) error {
+	return t.datastore.NotificationSettings().Delete(thread)
+}
+
+// notificationAllowed returns whether or not a notification passes the settings
+// of its subject thread, falling back to account-wide settings
+func (t *Textile) notificationAllowed(note *pb.Notification) bool {
+	var settings *pb.NotificationSettings
+	if note.Subject != "" {
+		settings = t.datastore.NotificationSettings().Get(note.Subject)
+	}
+	if settings == nil {
+		settings = t.datastore.NotificationSettings().Get("")
+	}
+	return allowNotification(note, settings)
+}
+
+// allowNotification returns whether or not a notification passes the given settings.
+// Nil settings allow everything, and mentions are always allowed.
+func allowNotification(note *pb.Notification, settings *pb.NotificationSettings) bool {
+	if settings == nil || note.Type == pb.Notification_MENTIONED {
+		return true
+	}
+	if settings.Muted {
+		return false
+	}
+	if len(settings.Types) > 0 {
+		var enabled bool
+		for _, t := range settings.Types {
+			if t == note.Type {
+				enabled = true
+				break
+			}
+		}
+		if !enabled {
+			return false
+		}
+	}
+	if settings.MentionsOnly {
+		return directNotification(note)
+	}
+	return true
+}
+
+// directNotification returns whether or not a notification is addressed to the account,
+// rather than reporting general thread activity
+func directNotification(note *pb.Notification) bool {
+	switch note.Type {
+	case pb.Notification_INVITE_RECEIVED,
+		pb.Notification_ACCOUNT_PEER_JOINED,
+		pb.Notification_ROLE_CHANGED,
+		pb.Notification_BLOCK_REJECTED:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseNotificationTypes parses an or'd list of notification types (e.g., MESSAGE_ADDED|COMMENT_ADDED)
+func parseNotificationTypes(list string) ([]pb.Notification_Type, error) {
+	var types []pb.Notification_Type
+	for _, name := range strings.Split(strings.ToUpper(list), "|") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		val, ok := pb.Notification_Type_value[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown notification type: %s", name)
+		}
+		types = append(types, pb.Notification_Type(val))
+	}
+	return types, nil
+}
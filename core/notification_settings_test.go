@@ -0,0 +1,53 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestAllowNotification(t *testing.T) {
+	message := &pb.Notification{Type: pb.Notification_MESSAGE_ADDED}
+	invite := &pb.Notification{Type: pb.Notification_INVITE_RECEIVED}
+
+	if !allowNotification(message, nil) {
+		t.Error("nil settings should allow everything")
+	}
+	if allowNotification(invite, &pb.NotificationSettings{Muted: true}) {
+		t.Error("muted settings should allow nothing")
+	}
+
+	types := &pb.NotificationSettings{Types: []pb.Notification_Type{pb.Notification_COMMENT_ADDED}}
+	if allowNotification(message, types) {
+		t.Error("disabled type should not be allowed")
+	}
+	if !allowNotification(&pb.Notification{Type: pb.Notification_COMMENT_ADDED}, types) {
+		t.Error("enabled type should be allowed")
+	}
+
+	mentions := &pb.NotificationSettings{MentionsOnly: true}
+	if allowNotification(message, mentions) {
+		t.Error("thread activity should not be allowed when mentions only")
+	}
+	if !allowNotification(invite, mentions) {
+		t.Error("direct notifications should be allowed when mentions only")
+	}
+
+	mention := &pb.Notification{Type: pb.Notification_MENTIONED}
+	if !allowNotification(mention, &pb.NotificationSettings{Muted: true, Types: types.Types}) {
+		t.Error("mentions should always be allowed")
+	}
+}
+
+func TestParseNotificationTypes(t *testing.T) {
+	types, err := parseNotificationTypes("message_added|COMMENT_ADDED")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(types) != 2 || types[0] != pb.Notification_MESSAGE_ADDED || types[1] != pb.Notification_COMMENT_ADDED {
+		t.Errorf("unexpected types: %v", types)
+	}
+	if _, err := parseNotificationTypes("BOGUS"); err == nil {
+		t.Error("unknown type should fail")
+	}
+}
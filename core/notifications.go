@@ -37,12 +37,18 @@ func (t *Textile) CountUnreadNotifications() int {
 
 // ReadNotification marks a notification as read
 func (t *Textile) ReadNotification(id string) error {
-	return t.datastore.Notifications().Read(id)
+	if err := t.datastore.Notifications().Read(id); err != nil {
+		return err
+	}
+	return addChange(t.datastore, pb.Change_NOTIFICATION_READ, id, "")
 }
 
 // ReadAllNotifications marks all notification as read
 func (t *Textile) ReadAllNotifications() error {
-	return t.datastore.Notifications().ReadAll()
+	if err := t.datastore.Notifications().ReadAll(); err != nil {
+		return err
+	}
+	return addChange(t.datastore, pb.Change_NOTIFICATION_READ, "", "")
 }
 
 // AcceptInviteViaNotification uses an invite notification to accept an invite to a thread
@@ -60,7 +66,7 @@ func (t *Textile) AcceptInviteViaNotification(id string) (mh.Multihash, error) {
 		return nil, err
 	}
 
-	return hash, t.datastore.Notifications().Delete(id)
+	return hash, t.deleteNotification(id)
 }
 
 // IgnoreInviteViaNotification uses an invite notification to ignore an invite to a thread
@@ -77,5 +83,13 @@ func (t *Textile) IgnoreInviteViaNotification(id string) error {
 		return err
 	}
 
-	return t.datastore.Notifications().Delete(id)
+	return t.deleteNotification(id)
+}
+
+// deleteNotification deletes a notification, recording the change
+func (t *Textile) deleteNotification(id string) error {
+	if err := t.datastore.Notifications().Delete(id); err != nil {
+		return err
+	}
+	return addChange(t.datastore, pb.Change_NOTIFICATION_REMOVED, id, "")
 }
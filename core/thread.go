@@ -80,6 +80,8 @@ type Thread struct {
 	ttype       pb.Thread_Type
 	sharing     pb.Thread_Sharing
 	whitelist   []string
+	roles       map[string]pb.Thread_Role
+	rolesMux    sync.Mutex
 	repoPath    string
 	config      *config.Config
 	account     *keypair.Full
@@ -153,13 +155,31 @@ func (t *Thread) Peers() []pb.ThreadPeer {
 }
 
 // Encrypt data with thread public key
+// Note: The latest rotated key is used if the thread key has been rotated
 func (t *Thread) Encrypt(data []byte) ([]byte, error) {
+	if key := t.datastore.ThreadKeys().GetLatest(t.Id); key != nil {
+		return encryptWithKey(key, data)
+	}
 	return crypto.Encrypt(t.PrivKey.GetPublic(), data)
 }
 
 // Decrypt data with thread secret key
+// Note: The key is selected by the key rotation id prefixed to the data, if any
 func (t *Thread) Decrypt(data []byte) ([]byte, error) {
-	return crypto.Decrypt(t.PrivKey, data)
+	id, ciphertext := splitThreadKeyId(data)
+	if id == "" {
+		return crypto.Decrypt(t.PrivKey, data)
+	}
+
+	key := t.datastore.ThreadKeys().Get(id)
+	if key == nil {
+		return nil, ErrThreadKeyNotFound
+	}
+	sk, err := ipfs.UnmarshalPrivateKey(key.Sk)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Decrypt(sk, ciphertext)
 }
 
 // UpdateSchema sets a new schema hash on the model and loads its node
@@ -168,6 +188,9 @@ func (t *Thread) UpdateSchema(hash string) error {
 	if err != nil {
 		return err
 	}
+	if err := addChange(t.datastore, pb.Change_THREAD_UPDATED, t.Id, t.Id); err != nil {
+		return err
+	}
 	t.Schema = nil
 	return t.loadSchema()
 }
@@ -223,6 +246,25 @@ func (t *Thread) followParent(parent mh.Multihash) ([]string, error) {
 
 			return []string{parent.B58String()}, nil
 		}
+		if err == ErrBlockRejected {
+			// rejected, abort
+			log.Debugf("%s rejected, aborting", parent.B58String())
+
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// ancestors may change roles, handle them first
+	ends, err := t.followParents(block.Header.Parents)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.acceptBlock(parent, ciphertext, block); err != nil {
+		if err == ErrBlockRejected {
+			return ends, nil
+		}
 		return nil, err
 	}
 
@@ -253,6 +295,14 @@ func (t *Thread) followParent(parent mh.Multihash) ([]string, error) {
 		_, err = t.handleCommentBlock(parent, block)
 	case pb.Block_LIKE:
 		_, err = t.handleLikeBlock(parent, block)
+	case pb.Block_REACTION:
+		_, err = t.handleReactionBlock(parent, block)
+	case pb.Block_ROLE_GRANT, pb.Block_ROLE_REVOKE:
+		_, err = t.handleRoleBlock(parent, block)
+	case pb.Block_KEY_ROTATE:
+		_, err = t.handleKeyRotateBlock(parent, block)
+	case pb.Block_EDIT:
+		_, err = t.handleEditBlock(parent, block)
 	default:
 		return nil, fmt.Errorf(fmt.Sprintf("invalid message type: %s", block.Type))
 	}
@@ -260,7 +310,7 @@ func (t *Thread) followParent(parent mh.Multihash) ([]string, error) {
 		return nil, err
 	}
 
-	return t.followParents(block.Header.Parents)
+	return ends, nil
 }
 
 // addOrUpdatePeer collects and saves thread peers
@@ -320,6 +370,12 @@ func (t *Thread) commitBlock(msg proto.Message, mtype pb.Block_BlockType, encryp
 	if err != nil {
 		return nil, err
 	}
+	return t.commitBlockWithHeader(header, msg, mtype, encrypt)
+}
+
+// commitBlockWithHeader is like commitBlock, but uses an existing header,
+// e.g., when the payload needs to sign over it
+func (t *Thread) commitBlockWithHeader(header *pb.ThreadBlockHeader, msg proto.Message, mtype pb.Block_BlockType, encrypt func(plaintext []byte) ([]byte, error)) (*commitResult, error) {
 	block := &pb.ThreadBlock{
 		Header: header,
 		Type:   mtype,
@@ -374,7 +430,20 @@ func (t *Thread) handleBlock(hash mh.Multihash, ciphertext []byte) (*pb.ThreadBl
 	if index != nil {
 		return nil, ErrBlockExists
 	}
+	if t.datastore.RejectedBlocks().Get(hash.B58String()) != nil {
+		return nil, ErrBlockRejected
+	}
+
+	// blocks encrypted with a rotated key need its rotation first
+	if err := t.loadKey(ciphertext); err != nil {
+		return nil, err
+	}
+
+	return t.decodeBlock(ciphertext)
+}
 
+// decodeBlock decrypts and unmarshals a block
+func (t *Thread) decodeBlock(ciphertext []byte) (*pb.ThreadBlock, error) {
 	block := new(pb.ThreadBlock)
 	plaintext, err := t.Decrypt(ciphertext)
 	if err != nil {
@@ -394,12 +463,23 @@ func (t *Thread) handleBlock(hash mh.Multihash, ciphertext []byte) (*pb.ThreadBl
 		return nil, fmt.Errorf("nil message payload")
 	}
 
-	if _, err := t.addBlock(ciphertext); err != nil {
-		return nil, err
-	}
 	return block, nil
 }
 
+// acceptBlock adds a handled block, rejecting those not allowed by thread policy
+// Note: A block's parents must be followed first so that its author's role is current
+func (t *Thread) acceptBlock(hash mh.Multihash, ciphertext []byte, block *pb.ThreadBlock) error {
+	if err := t.authorizeInbound(block); err != nil {
+		if err := t.reject(hash, block, err); err != nil {
+			return err
+		}
+		return ErrBlockRejected
+	}
+
+	_, err := t.addBlock(ciphertext)
+	return err
+}
+
 // indexBlock stores off index info for this block type
 func (t *Thread) indexBlock(commit *commitResult, blockType pb.Block_BlockType, target string, body string) error {
 	block := &pb.Block{
@@ -415,6 +495,14 @@ func (t *Thread) indexBlock(commit *commitResult, blockType pb.Block_BlockType,
 	if err := t.datastore.Blocks().Add(block); err != nil {
 		return err
 	}
+	if err := addChange(t.datastore, pb.Change_BLOCK_ADDED, block.Id, t.Id); err != nil {
+		return err
+	}
+	if _, ok := searchableBlockTypes[blockType]; ok && body != "" {
+		if err := t.datastore.SearchIndex().Index(block.Id, t.Id, body); err != nil {
+			return err
+		}
+	}
 
 	t.pushUpdate(block, t.Key)
 	return nil
@@ -541,61 +629,19 @@ func (t *Thread) store() error {
 // readable returns whether or not this thread is readable from the
 // perspective of the given address
 func (t *Thread) readable(addr string) bool {
-	if addr == t.initiator {
-		return true
-	}
-	switch t.ttype {
-	case pb.Thread_PRIVATE:
-		return false // should not happen
-	case pb.Thread_READ_ONLY:
-		return t.member(addr)
-	case pb.Thread_PUBLIC:
-		return t.member(addr)
-	case pb.Thread_OPEN:
-		return t.member(addr)
-	default:
-		return false
-	}
+	return t.role(addr) >= pb.Thread_READ
 }
 
 // annotatable returns whether or not this thread is annotatable from the
 // perspective of the given address
 func (t *Thread) annotatable(addr string) bool {
-	if addr == t.initiator {
-		return true
-	}
-	switch t.ttype {
-	case pb.Thread_PRIVATE:
-		return false // should not happen
-	case pb.Thread_READ_ONLY:
-		return false
-	case pb.Thread_PUBLIC:
-		return t.member(addr)
-	case pb.Thread_OPEN:
-		return t.member(addr)
-	default:
-		return false
-	}
+	return t.role(addr) >= pb.Thread_ANNOTATE
 }
 
 // writable returns whether or not this thread can accept files from the
 // perspective of the given address
 func (t *Thread) writable(addr string) bool {
-	if addr == t.initiator {
-		return true
-	}
-	switch t.ttype {
-	case pb.Thread_PRIVATE:
-		return false // should not happen
-	case pb.Thread_READ_ONLY:
-		return false
-	case pb.Thread_PUBLIC:
-		return false
-	case pb.Thread_OPEN:
-		return t.member(addr)
-	default:
-		return false
-	}
+	return t.role(addr) >= pb.Thread_WRITE
 }
 
 // shareable returns whether or not this thread is shareable from one address to another
@@ -615,10 +661,22 @@ func (t *Thread) shareable(from string, to string) bool {
 	}
 }
 
-// member returns whether or not the given address is a thread member
-// NOTE: Thread whitelist are a fixed set of textile addresses specified
-// when a thread is created. If empty, _everyone_ is a member.
+// member returns whether or not the given address is a thread member.
+// Granting a role adds an address to the members, revoking removes it.
 func (t *Thread) member(addr string) bool {
+	if addr == t.initiator {
+		return true
+	}
+	if role, ok := t.grantedRoles()[addr]; ok {
+		return role != pb.Thread_NONE
+	}
+	return t.whitelisted(addr)
+}
+
+// whitelisted returns whether or not the given address is in the thread whitelist
+// NOTE: Thread whitelist are a fixed set of textile addresses specified
+// when a thread is created. If empty, _everyone_ is whitelisted.
+func (t *Thread) whitelisted(addr string) bool {
 	if len(t.whitelist) == 0 || addr == t.initiator {
 		return true
 	}
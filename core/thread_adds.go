@@ -21,6 +21,8 @@ func (t *Thread) AddInvite(p *pb.Peer) (mh.Multihash, error) {
 	msg := &pb.ThreadAdd{
 		Thread:  t.datastore.Threads().Get(t.Id),
 		Inviter: self,
+		Roles:   t.Roles().Items,
+		Keys:    t.datastore.ThreadKeys().ListByThread(t.Id).Items,
 	}
 
 	pid, err := peer.IDB58Decode(p.Id)
@@ -57,10 +59,17 @@ func (t *Thread) AddExternalInvite() (mh.Multihash, []byte, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
+	return t.addExternalInvite()
+}
+
+// addExternalInvite creates an external add block without locking the thread
+func (t *Thread) addExternalInvite() (mh.Multihash, []byte, error) {
 	self := t.datastore.Peers().Get(t.node().Identity.Pretty())
 	msg := &pb.ThreadAdd{
 		Thread:  t.datastore.Threads().Get(t.Id),
 		Inviter: self,
+		Roles:   t.Roles().Items,
+		Keys:    t.datastore.ThreadKeys().ListByThread(t.Id).Items,
 	}
 
 	key, err := crypto.GenerateAESKey()
@@ -0,0 +1,205 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/mr-tron/base58/base58"
+	"github.com/textileio/go-textile/broadcast"
+	"github.com/textileio/go-textile/pb"
+)
+
+// ErrNotAdvertisable indicates a thread can't be advertised to the network
+var ErrNotAdvertisable = fmt.Errorf("only shared public or open threads can be advertised")
+
+// ErrThreadAdvertNotFound indicates a thread is not advertised
+var ErrThreadAdvertNotFound = fmt.Errorf("thread advert not found")
+
+// AdvertiseThread makes a thread discoverable by peers searching the network.
+// The advert carries an external invite, which is refreshed when the thread key rotates.
+func (t *Textile) AdvertiseThread(id string) (*pb.ThreadAdvert, error) {
+	thrd := t.Thread(id)
+	if thrd == nil {
+		return nil, ErrThreadNotFound
+	}
+	model := t.datastore.Threads().Get(id)
+	if model == nil {
+		return nil, ErrThreadNotFound
+	}
+	if !advertisable(model, t.account.Address()) {
+		return nil, ErrNotAdvertisable
+	}
+
+	thrd.mux.Lock()
+	defer thrd.mux.Unlock()
+
+	advert, err := thrd.advertise()
+	if err != nil {
+		return nil, err
+	}
+
+	return newThreadAdvert(advert, model, t.account.Address()), nil
+}
+
+// UnadvertiseThread stops advertising a thread
+func (t *Textile) UnadvertiseThread(id string) error {
+	if t.datastore.ThreadAdverts().Get(id) == nil {
+		return ErrThreadAdvertNotFound
+	}
+	return t.datastore.ThreadAdverts().Delete(id)
+}
+
+// SearchPublicThreads searches the network for advertised threads
+func (t *Textile) SearchPublicThreads(query *pb.PublicThreadQuery, options *pb.QueryOptions) (<-chan *pb.QueryResult, <-chan error, *broadcast.Broadcaster, error) {
+	payload, err := proto.Marshal(query)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// settings required for adverts
+	options.RemoteOnly = true
+	options.Filter = pb.QueryOptions_HIDE_OLDER
+
+	resCh, errCh, cancel := t.search(&pb.Query{
+		Type:    pb.Query_PUBLIC_THREADS,
+		Options: options,
+		Payload: &any.Any{
+			TypeUrl: "/PublicThreadQuery",
+			Value:   payload,
+		},
+	})
+
+	// filter out our own adverts
+	tresCh := make(chan *pb.QueryResult)
+	terrCh := make(chan error)
+	go func() {
+		for {
+			select {
+			case res, ok := <-resCh:
+				if !ok {
+					close(tresCh)
+					return
+				}
+
+				advert := new(pb.ThreadAdvert)
+				if err := ptypes.UnmarshalAny(res.Value, advert); err != nil {
+					terrCh <- err
+					break
+				}
+				if advert.Inviter == t.account.Address() {
+					continue
+				}
+				tresCh <- res
+
+			case err := <-errCh:
+				terrCh <- err
+			}
+		}
+	}()
+
+	return tresCh, terrCh, cancel, nil
+}
+
+// RefreshAdvert renews the invite of an advertised thread
+func (t *Thread) RefreshAdvert() {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	t.refreshAdvert()
+}
+
+// refreshAdvert renews the invite of an advertised thread without locking the thread,
+// or removes the advert if the thread is no longer advertisable
+func (t *Thread) refreshAdvert() {
+	if t.datastore.ThreadAdverts().Get(t.Id) == nil {
+		return
+	}
+
+	model := t.datastore.Threads().Get(t.Id)
+	if model == nil || !advertisable(model, t.account.Address()) {
+		if err := t.datastore.ThreadAdverts().Delete(t.Id); err != nil {
+			log.Warningf("error removing advert for %s: %s", t.Id, err)
+		}
+		return
+	}
+
+	if _, err := t.advertise(); err != nil {
+		log.Warningf("error refreshing advert for %s: %s", t.Id, err)
+	}
+}
+
+// advertise creates an external invite and stores it as the thread's advert
+func (t *Thread) advertise() (*pb.ThreadAdvert, error) {
+	hash, key, err := t.addExternalInvite()
+	if err != nil {
+		return nil, err
+	}
+
+	advert := &pb.ThreadAdvert{
+		Id:        t.Id,
+		Invite:    hash.B58String(),
+		InviteKey: base58.FastBase58Encoding(key),
+		Date:      ptypes.TimestampNow(),
+	}
+	if err := t.datastore.ThreadAdverts().AddOrUpdate(advert); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("advertised %s with invite %s", t.Id, advert.Invite)
+
+	return advert, nil
+}
+
+// advertisable returns whether or not a thread can be joined by anyone
+// holding an invite created by the given address
+func advertisable(thrd *pb.Thread, address string) bool {
+	switch thrd.Type {
+	case pb.Thread_PUBLIC, pb.Thread_OPEN:
+	default:
+		return false
+	}
+	if len(thrd.Whitelist) > 0 {
+		return false
+	}
+	switch thrd.Sharing {
+	case pb.Thread_SHARED:
+		return true
+	case pb.Thread_INVITE_ONLY:
+		return address == thrd.Initiator
+	default:
+		return false
+	}
+}
+
+// newThreadAdvert returns a stored advert with the current thread info
+func newThreadAdvert(advert *pb.ThreadAdvert, thrd *pb.Thread, inviter string) *pb.ThreadAdvert {
+	return &pb.ThreadAdvert{
+		Id:        advert.Id,
+		Name:      thrd.Name,
+		Schema:    thrd.Schema,
+		Initiator: thrd.Initiator,
+		Type:      thrd.Type,
+		Invite:    advert.Invite,
+		InviteKey: advert.InviteKey,
+		Inviter:   inviter,
+		Date:      advert.Date,
+	}
+}
+
+// matchesPublicThreadQuery returns whether or not an advert matches a query.
+// Names are matched by case-insensitive substring, schema and initiator exactly.
+func matchesPublicThreadQuery(advert *pb.ThreadAdvert, query *pb.PublicThreadQuery) bool {
+	if query.Name != "" && !strings.Contains(strings.ToLower(advert.Name), strings.ToLower(query.Name)) {
+		return false
+	}
+	if query.Schema != "" && advert.Schema != query.Schema {
+		return false
+	}
+	if query.Initiator != "" && advert.Initiator != query.Initiator {
+		return false
+	}
+	return true
+}
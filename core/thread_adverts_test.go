@@ -0,0 +1,58 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestAdvertisable(t *testing.T) {
+	thrd := &pb.Thread{
+		Initiator: "initiator",
+		Type:      pb.Thread_OPEN,
+		Sharing:   pb.Thread_SHARED,
+	}
+	if !advertisable(thrd, "member") {
+		t.Error("shared open thread should be advertisable")
+	}
+
+	thrd.Type = pb.Thread_READ_ONLY
+	if advertisable(thrd, "member") {
+		t.Error("read only thread should not be advertisable")
+	}
+
+	thrd.Type = pb.Thread_PUBLIC
+	thrd.Sharing = pb.Thread_INVITE_ONLY
+	if advertisable(thrd, "member") {
+		t.Error("invite only thread should only be advertisable by the initiator")
+	}
+	if !advertisable(thrd, "initiator") {
+		t.Error("invite only thread should be advertisable by the initiator")
+	}
+
+	thrd.Whitelist = []string{"initiator", "member"}
+	if advertisable(thrd, "initiator") {
+		t.Error("whitelisted thread should not be advertisable")
+	}
+}
+
+func TestMatchesPublicThreadQuery(t *testing.T) {
+	advert := &pb.ThreadAdvert{
+		Id:        "thread",
+		Name:      "Surf Photos",
+		Schema:    "schema",
+		Initiator: "initiator",
+	}
+	if !matchesPublicThreadQuery(advert, &pb.PublicThreadQuery{}) {
+		t.Error("empty query should match")
+	}
+	if !matchesPublicThreadQuery(advert, &pb.PublicThreadQuery{Name: "surf", Schema: "schema"}) {
+		t.Error("name should match case-insensitively")
+	}
+	if matchesPublicThreadQuery(advert, &pb.PublicThreadQuery{Name: "ski"}) {
+		t.Error("name should not match")
+	}
+	if matchesPublicThreadQuery(advert, &pb.PublicThreadQuery{Initiator: "other"}) {
+		t.Error("initiator should not match")
+	}
+}
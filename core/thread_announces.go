@@ -13,8 +13,8 @@ func (t *Thread) annouce(msg *pb.ThreadAnnounce) (mh.Multihash, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
+	if err := t.authorizeOutbound(pb.Block_ANNOUNCE); err != nil {
+		return nil, err
 	}
 
 	if msg == nil {
@@ -62,13 +62,6 @@ func (t *Thread) handleAnnounceBlock(hash mh.Multihash, block *pb.ThreadBlock) (
 		return nil, err
 	}
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
-	}
-	if !t.readable(block.Header.Address) {
-		return nil, ErrNotReadable
-	}
-
 	// unless this is our account thread, announce's peer _must_ match the sender
 	if msg.Peer != nil {
 		if t.Id != t.config.Account.Thread && msg.Peer.Id != block.Header.Author {
@@ -109,6 +102,9 @@ func (t *Thread) handleAnnounceBlock(hash mh.Multihash, block *pb.ThreadBlock) (
 		if err := t.datastore.Threads().UpdateName(t.Id, msg.Name); err != nil {
 			return nil, err
 		}
+		if err := addChange(t.datastore, pb.Change_THREAD_UPDATED, t.Id, t.Id); err != nil {
+			return nil, err
+		}
 	}
 
 	return msg, nil
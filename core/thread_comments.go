@@ -13,14 +13,15 @@ func (t *Thread) AddComment(target string, body string) (mh.Multihash, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
-	if !t.annotatable(t.config.Account.Address) {
-		return nil, ErrNotAnnotatable
+	if err := t.authorizeOutbound(pb.Block_COMMENT); err != nil {
+		return nil, err
 	}
 
 	body = strings.TrimSpace(body)
 	msg := &pb.ThreadComment{
-		Target: target,
-		Body:   body,
+		Target:   target,
+		Body:     body,
+		Mentions: parseMentions(body),
 	}
 
 	res, err := t.commitBlock(msg, pb.Block_COMMENT, nil)
@@ -52,13 +53,6 @@ func (t *Thread) handleCommentBlock(hash mh.Multihash, block *pb.ThreadBlock) (*
 		return nil, err
 	}
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
-	}
-	if !t.annotatable(block.Header.Address) {
-		return nil, ErrNotAnnotatable
-	}
-
 	if err := t.indexBlock(&commitResult{
 		hash:   hash,
 		header: block.Header,
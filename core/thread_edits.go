@@ -0,0 +1,164 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/pb"
+)
+
+// ErrEditTargetNotFound indicates an edit targets a block not found in the thread
+var ErrEditTargetNotFound = fmt.Errorf("edit target not found")
+
+// ErrNotEditable indicates an edit targets a block type that can't be edited
+var ErrNotEditable = fmt.Errorf("only text and comment blocks can be edited")
+
+// ErrNotBlockAuthor indicates an edit or ignore is not by the author of its target
+var ErrNotBlockAuthor = fmt.Errorf("only the original author can edit or remove a block")
+
+// AddEdit adds an outgoing edit block, replacing the body of a text or comment block
+func (t *Thread) AddEdit(target string, body string) (mh.Multihash, error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if err := t.authorizeOutbound(pb.Block_EDIT); err != nil {
+		return nil, err
+	}
+
+	tblock := t.datastore.Blocks().Get(target)
+	if err := checkEdit(tblock, t.Id, t.blockAddress(tblock), t.config.Account.Address); err != nil {
+		return nil, err
+	}
+
+	body = strings.TrimSpace(body)
+	msg := &pb.ThreadEdit{
+		Target:   target,
+		Body:     body,
+		Mentions: parseMentions(body),
+	}
+
+	res, err := t.commitBlock(msg, pb.Block_EDIT, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.indexBlock(res, pb.Block_EDIT, target, body); err != nil {
+		return nil, err
+	}
+	if err := t.indexEdit(target, body); err != nil {
+		return nil, err
+	}
+
+	if err := t.updateHead(res.hash); err != nil {
+		return nil, err
+	}
+
+	if err := t.post(res, t.Peers()); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("added EDIT to %s: %s", t.Id, res.hash.B58String())
+
+	return res.hash, nil
+}
+
+// handleEditBlock handles an incoming edit block
+func (t *Thread) handleEditBlock(hash mh.Multihash, block *pb.ThreadBlock) (*pb.ThreadEdit, error) {
+	msg := new(pb.ThreadEdit)
+	if err := ptypes.UnmarshalAny(block.Payload, msg); err != nil {
+		return nil, err
+	}
+
+	if err := t.indexBlock(&commitResult{
+		hash:   hash,
+		header: block.Header,
+	}, pb.Block_EDIT, msg.Target, msg.Body); err != nil {
+		return nil, err
+	}
+	if err := t.indexEdit(msg.Target, msg.Body); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// authorizeEditBlock returns an error if an inbound edit's author didn't author its target
+func (t *Thread) authorizeEditBlock(block *pb.ThreadBlock) error {
+	msg := new(pb.ThreadEdit)
+	if err := ptypes.UnmarshalAny(block.Payload, msg); err != nil {
+		return err
+	}
+
+	target := t.datastore.Blocks().Get(msg.Target)
+	return checkEdit(target, t.Id, t.blockAddress(target), block.Header.Address)
+}
+
+// indexEdit replaces the searchable text of an edited block
+func (t *Thread) indexEdit(target string, body string) error {
+	if err := t.datastore.SearchIndex().Index(target, t.Id, body); err != nil {
+		return err
+	}
+	return addChange(t.datastore, pb.Change_BLOCK_EDITED, target, t.Id)
+}
+
+// blockAddress returns the account address of a block's author, if known
+func (t *Thread) blockAddress(block *pb.Block) string {
+	if block == nil {
+		return ""
+	}
+	if block.Author == t.node().Identity.Pretty() {
+		return t.config.Account.Address
+	}
+	peer := t.datastore.Peers().Get(block.Author)
+	if peer == nil {
+		return ""
+	}
+	return peer.Address
+}
+
+// checkEdit returns an error if a target block in the given thread, authored by
+// author, can't be edited by addr
+func checkEdit(target *pb.Block, thread string, author string, addr string) error {
+	if target == nil || target.Thread != thread {
+		return ErrEditTargetNotFound
+	}
+	switch target.Type {
+	case pb.Block_TEXT, pb.Block_COMMENT:
+	default:
+		return ErrNotEditable
+	}
+	if author == "" || author != addr {
+		return ErrNotBlockAuthor
+	}
+	return nil
+}
+
+// blockRevisions returns the latest body of a block, along with its revisions if edited.
+// Ignored edits are not included.
+func (t *Textile) blockRevisions(block *pb.Block) (string, []*pb.Revision) {
+	query := fmt.Sprintf("type=%d and target='%s'", pb.Block_EDIT, block.Id)
+	edits := t.Blocks("", -1, query).Items
+	if len(edits) == 0 {
+		return block.Body, nil
+	}
+	return edits[0].Body, revisions(block, edits)
+}
+
+// revisions returns the revisions of a block, oldest first, given its edits
+// Note: edits are expected in descending date order, as listed by the block store
+func revisions(block *pb.Block, edits []*pb.Block) []*pb.Revision {
+	list := []*pb.Revision{{
+		Block: block.Id,
+		Date:  block.Date,
+		Body:  block.Body,
+	}}
+	for i := len(edits) - 1; i >= 0; i-- {
+		list = append(list, &pb.Revision{
+			Block: edits[i].Id,
+			Date:  edits[i].Date,
+			Body:  edits[i].Body,
+		})
+	}
+	return list
+}
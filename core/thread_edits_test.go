@@ -0,0 +1,74 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestCheckEdit(t *testing.T) {
+	text := &pb.Block{Id: "a", Thread: "t1", Type: pb.Block_TEXT}
+	files := &pb.Block{Id: "b", Thread: "t1", Type: pb.Block_FILES}
+
+	if err := checkEdit(text, "t1", "alice", "alice"); err != nil {
+		t.Errorf("author edit should pass: %s", err)
+	}
+	if err := checkEdit(text, "t1", "alice", "bob"); err != ErrNotBlockAuthor {
+		t.Error("non-author edit should fail")
+	}
+	if err := checkEdit(text, "t1", "", ""); err != ErrNotBlockAuthor {
+		t.Error("edit of block with unknown author should fail")
+	}
+	if err := checkEdit(files, "t1", "alice", "alice"); err != ErrNotEditable {
+		t.Error("files edit should fail")
+	}
+	if err := checkEdit(text, "t2", "alice", "alice"); err != ErrEditTargetNotFound {
+		t.Error("edit from another thread should fail")
+	}
+	if err := checkEdit(nil, "t1", "alice", "alice"); err != ErrEditTargetNotFound {
+		t.Error("edit of missing block should fail")
+	}
+}
+
+func TestRevisions(t *testing.T) {
+	now := ptypes.TimestampNow()
+	block := &pb.Block{Id: "a", Body: "one", Date: now}
+	edits := []*pb.Block{
+		{Id: "c", Body: "three", Date: now},
+		{Id: "b", Body: "two", Date: now},
+	}
+
+	revs := revisions(block, edits)
+	if len(revs) != 3 {
+		t.Fatalf("wrong number of revisions: %d", len(revs))
+	}
+	for i, id := range []string{"a", "b", "c"} {
+		if revs[i].Block != id {
+			t.Errorf("revision %d should be %s, got %s", i, id, revs[i].Block)
+		}
+	}
+	if revs[2].Body != "three" {
+		t.Error("latest revision has wrong body")
+	}
+}
+
+func TestCheckIgnore(t *testing.T) {
+	files := &pb.Block{Id: "b", Thread: "t1", Type: pb.Block_FILES}
+
+	if err := checkIgnore(files, "t1", "alice", "alice"); err != nil {
+		t.Errorf("author ignore should pass: %s", err)
+	}
+	if err := checkIgnore(files, "t1", "alice", "bob"); err != ErrNotBlockAuthor {
+		t.Error("non-author ignore should fail")
+	}
+	if err := checkIgnore(files, "t1", "", ""); err != ErrNotBlockAuthor {
+		t.Error("ignore of block with unknown author should fail")
+	}
+	if err := checkIgnore(files, "t2", "alice", "alice"); err != ErrIgnoreTargetNotFound {
+		t.Error("ignore from another thread should fail")
+	}
+	if err := checkIgnore(nil, "t1", "alice", "alice"); err != ErrIgnoreTargetNotFound {
+		t.Error("ignore of missing block should fail")
+	}
+}
@@ -26,8 +26,8 @@ func (t *Thread) AddFiles(node ipld.Node, caption string, keys map[string]string
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
-	if !t.writable(t.config.Account.Address) {
-		return nil, ErrNotWritable
+	if err := t.authorizeOutbound(pb.Block_FILES); err != nil {
+		return nil, err
 	}
 
 	if t.Schema == nil {
@@ -100,13 +100,6 @@ func (t *Thread) handleFilesBlock(hash mh.Multihash, block *pb.ThreadBlock) (*pb
 		return nil, err
 	}
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
-	}
-	if !t.writable(block.Header.Address) {
-		return nil, ErrNotWritable
-	}
-
 	if t.Schema == nil {
 		return nil, ErrThreadSchemaRequired
 	}
@@ -183,6 +176,8 @@ func (t *Thread) handleFilesBlock(hash mh.Multihash, block *pb.ThreadBlock) (*pb
 					return nil, err
 				}
 				log.Debugf("file exists: %s", file.Hash)
+			} else if err := fileIndexed(t.datastore, &file); err != nil {
+				return nil, err
 			}
 		}
 	}
@@ -258,6 +253,9 @@ func (t *Thread) processFileNode(node *pb.Node, inode ipld.Node, index int, keys
 		// ensure link is present
 		link := schema.LinkByName(inode.Links(), []string{name})
 		if link == nil {
+			if l.Optional {
+				continue
+			}
 			return schema.ErrFileValidationFailed
 		}
 
@@ -457,6 +455,12 @@ func (t *Thread) deIndexFileLink(inode ipld.Node, target string) error {
 			if err := t.datastore.Files().Delete(hash); err != nil {
 				return err
 			}
+			if err := t.datastore.SearchIndex().Delete(hash); err != nil {
+				return err
+			}
+			if err := addChange(t.datastore, pb.Change_FILE_REMOVED, hash, t.Id); err != nil {
+				return err
+			}
 		}
 	}
 
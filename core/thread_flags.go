@@ -13,8 +13,8 @@ func (t *Thread) AddFlag(block string) (mh.Multihash, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
-	if !t.annotatable(t.config.Account.Address) {
-		return nil, ErrNotAnnotatable
+	if err := t.authorizeOutbound(pb.Block_FLAG); err != nil {
+		return nil, err
 	}
 
 	// adding a flag specific prefix here to ensure future flexibility
@@ -53,13 +53,6 @@ func (t *Thread) handleFlagBlock(hash mh.Multihash, block *pb.ThreadBlock) (*pb.
 		return nil, err
 	}
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
-	}
-	if !t.annotatable(block.Header.Address) {
-		return nil, ErrNotAnnotatable
-	}
-
 	// TODO: how do we want to handle flags? making visible to UIs would be a good start
 
 	if err := t.indexBlock(&commitResult{
@@ -10,13 +10,22 @@ import (
 	"github.com/textileio/go-textile/pb"
 )
 
-// AddIgnore adds an outgoing ignore block targeted at another block to ignore
+// ErrIgnoreTargetNotFound indicates an ignore targets a block not found in the thread
+var ErrIgnoreTargetNotFound = fmt.Errorf("ignore target not found")
+
+// AddIgnore adds an outgoing ignore block targeted at another block to ignore.
+// Only the author of a block can ignore it.
 func (t *Thread) AddIgnore(block string) (mh.Multihash, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
-	if !t.annotatable(t.config.Account.Address) {
-		return nil, ErrNotAnnotatable
+	if err := t.authorizeOutbound(pb.Block_IGNORE); err != nil {
+		return nil, err
+	}
+
+	rblock := t.datastore.Blocks().Get(block)
+	if err := checkIgnore(rblock, t.Id, t.blockAddress(rblock), t.config.Account.Address); err != nil {
+		return nil, err
 	}
 
 	// adding an ignore specific prefix here to ensure future flexibility
@@ -35,7 +44,6 @@ func (t *Thread) AddIgnore(block string) (mh.Multihash, error) {
 		return nil, err
 	}
 
-	rblock := t.datastore.Blocks().Get(block)
 	if err := t.ignoreBlockTarget(rblock); err != nil {
 		return nil, err
 	}
@@ -52,6 +60,9 @@ func (t *Thread) AddIgnore(block string) (mh.Multihash, error) {
 	if err := t.datastore.Notifications().DeleteByBlock(block); err != nil {
 		return nil, err
 	}
+	if err := addChange(t.datastore, pb.Change_BLOCK_IGNORED, block, t.Id); err != nil {
+		return nil, err
+	}
 
 	log.Debugf("added IGNORE to %s: %s", t.Id, res.hash.B58String())
 
@@ -65,18 +76,14 @@ func (t *Thread) handleIgnoreBlock(hash mh.Multihash, block *pb.ThreadBlock) (*p
 		return nil, err
 	}
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
-	}
-	if !t.annotatable(block.Header.Address) {
-		return nil, ErrNotAnnotatable
-	}
-
 	// cleanup
 	blockId := strings.Replace(msg.Target, "ignore-", "", 1)
 	if err := t.datastore.Notifications().DeleteByBlock(blockId); err != nil {
 		return nil, err
 	}
+	if err := addChange(t.datastore, pb.Change_BLOCK_IGNORED, blockId, t.Id); err != nil {
+		return nil, err
+	}
 
 	if err := t.indexBlock(&commitResult{
 		hash:   hash,
@@ -93,6 +100,29 @@ func (t *Thread) handleIgnoreBlock(hash mh.Multihash, block *pb.ThreadBlock) (*p
 	return msg, nil
 }
 
+// authorizeIgnoreBlock returns an error if an inbound ignore's author didn't author its target
+func (t *Thread) authorizeIgnoreBlock(block *pb.ThreadBlock) error {
+	msg := new(pb.ThreadIgnore)
+	if err := ptypes.UnmarshalAny(block.Payload, msg); err != nil {
+		return err
+	}
+
+	target := t.datastore.Blocks().Get(strings.Replace(msg.Target, "ignore-", "", 1))
+	return checkIgnore(target, t.Id, t.blockAddress(target), block.Header.Address)
+}
+
+// checkIgnore returns an error if a target block in the given thread, authored by
+// author, can't be ignored by addr
+func checkIgnore(target *pb.Block, thread string, author string, addr string) error {
+	if target == nil || target.Thread != thread {
+		return ErrIgnoreTargetNotFound
+	}
+	if author == "" || author != addr {
+		return ErrNotBlockAuthor
+	}
+	return nil
+}
+
 // ignoreBlockTarget conditionally removes block target and files
 func (t *Thread) ignoreBlockTarget(block *pb.Block) error {
 	if block == nil || block.Target == "" {
@@ -14,8 +14,8 @@ func (t *Thread) joinInitial() (mh.Multihash, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
+	if err := t.authorizeOutbound(pb.Block_JOIN); err != nil {
+		return nil, err
 	}
 
 	msg, err := t.buildJoin(t.node().Identity.Pretty())
@@ -46,8 +46,8 @@ func (t *Thread) join(inviterId peer.ID) (mh.Multihash, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
+	if err := t.authorizeOutbound(pb.Block_JOIN); err != nil {
+		return nil, err
 	}
 
 	msg, err := t.buildJoin(inviterId.Pretty())
@@ -84,13 +84,6 @@ func (t *Thread) handleJoinBlock(hash mh.Multihash, block *pb.ThreadBlock) (*pb.
 		return nil, err
 	}
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
-	}
-	if !t.readable(block.Header.Address) {
-		return nil, ErrNotReadable
-	}
-
 	// join's peer _must_ match the sender
 	if msg.Peer.Id != block.Header.Author {
 		return nil, ErrInvalidThreadBlock
@@ -0,0 +1,294 @@
+package core
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/golang/protobuf/ptypes"
+	libp2pc "github.com/libp2p/go-libp2p-crypto"
+	"github.com/mr-tron/base58/base58"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/crypto"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/keypair"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/db"
+)
+
+// ErrThreadKeyNotFound indicates a block was encrypted with a thread key we don't have
+var ErrThreadKeyNotFound = fmt.Errorf("thread key not found")
+
+// ErrInvalidKeyRotateSig indicates a key rotation block was not signed by its author
+var ErrInvalidKeyRotateSig = fmt.Errorf("invalid thread key rotation signature")
+
+// threadKeyPrefix marks ciphertext encrypted with a rotated thread key. It's followed
+// by the length and bytes of the key rotation block id. Ciphertext without the prefix
+// is encrypted with the original thread key.
+var threadKeyPrefix = []byte("txk")
+
+// RotateKey adds an outgoing key rotation block, distributing a new thread key to
+// each remaining member. Blocks added afterwards are encrypted with the new key.
+func (t *Thread) RotateKey() (mh.Multihash, error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if err := t.authorizeOutbound(pb.Block_KEY_ROTATE); err != nil {
+		return nil, err
+	}
+
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	skb, err := sk.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &pb.ThreadKeyRotate{
+		Epoch: 1,
+		Keys:  make(map[string][]byte),
+	}
+	if latest := t.datastore.ThreadKeys().GetLatest(t.Id); latest != nil {
+		msg.Epoch = latest.Epoch + 1
+	}
+	for _, addr := range t.keyRecipients() {
+		kp, err := keypair.Parse(addr)
+		if err != nil {
+			return nil, err
+		}
+		pk, err := kp.LibP2PPubKey()
+		if err != nil {
+			return nil, err
+		}
+		msg.Keys[addr], err = crypto.Encrypt(pk, skb)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	header, err := t.newBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+	msg.Sig, err = t.account.Sign(keyRotateSignedData(t.Id, header, msg))
+	if err != nil {
+		return nil, err
+	}
+
+	// the rotation itself is encrypted with the previous key
+	res, err := t.commitBlockWithHeader(header, msg, pb.Block_KEY_ROTATE, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.indexBlock(res, pb.Block_KEY_ROTATE, "", strconv.Itoa(int(msg.Epoch))); err != nil {
+		return nil, err
+	}
+
+	if err := t.datastore.ThreadKeys().Add(&pb.ThreadKey{
+		Id:     res.hash.B58String(),
+		Thread: t.Id,
+		Epoch:  msg.Epoch,
+		Sk:     skb,
+		Date:   res.header.Date,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := t.updateHead(res.hash); err != nil {
+		return nil, err
+	}
+
+	if err := t.post(res, t.Peers()); err != nil {
+		return nil, err
+	}
+
+	t.refreshAdvert()
+
+	log.Debugf("added KEY_ROTATE to %s: %s", t.Id, res.hash.B58String())
+
+	return res.hash, nil
+}
+
+// Evict revokes all access from an address, removes its peers, and rotates
+// the thread key so that it can't decrypt new blocks
+func (t *Thread) Evict(addr string) (mh.Multihash, error) {
+	if _, err := t.AddRoleRevoke(addr); err != nil {
+		return nil, err
+	}
+
+	for _, tp := range t.peersWithAddress(addr) {
+		if err := t.datastore.ThreadPeers().Delete(tp.Id, t.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	return t.RotateKey()
+}
+
+// handleKeyRotateBlock handles an incoming key rotation block, storing the new key
+// if it was distributed to this account
+func (t *Thread) handleKeyRotateBlock(hash mh.Multihash, block *pb.ThreadBlock) (*pb.ThreadKeyRotate, error) {
+	msg := new(pb.ThreadKeyRotate)
+	if err := ptypes.UnmarshalAny(block.Payload, msg); err != nil {
+		return nil, err
+	}
+
+	if ciphertext, ok := msg.Keys[t.account.Address()]; ok {
+		skb, err := t.account.Decrypt(ciphertext)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := ipfs.UnmarshalPrivateKey(skb); err != nil {
+			return nil, err
+		}
+
+		if err := t.datastore.ThreadKeys().Add(&pb.ThreadKey{
+			Id:     hash.B58String(),
+			Thread: t.Id,
+			Epoch:  msg.Epoch,
+			Sk:     skb,
+			Date:   block.Header.Date,
+		}); err != nil {
+			if !db.ConflictError(err) {
+				return nil, err
+			}
+		}
+	} else {
+		log.Warningf("key rotation %s in %s does not include this account", hash.B58String(), t.Id)
+	}
+
+	if err := t.indexBlock(&commitResult{
+		hash:   hash,
+		header: block.Header,
+	}, pb.Block_KEY_ROTATE, "", strconv.Itoa(int(msg.Epoch))); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// authorizeKeyRotateBlock returns an error if an inbound key rotation block
+// was not signed by its author
+func (t *Thread) authorizeKeyRotateBlock(block *pb.ThreadBlock) error {
+	msg := new(pb.ThreadKeyRotate)
+	if err := ptypes.UnmarshalAny(block.Payload, msg); err != nil {
+		return err
+	}
+
+	data := keyRotateSignedData(t.Id, block.Header, msg)
+	if err := verifyBlockSig(block, data, msg.Sig); err != nil {
+		return ErrInvalidKeyRotateSig
+	}
+	return nil
+}
+
+// keyRecipients returns the account addresses of the local account and
+// each thread peer that can still read the thread
+func (t *Thread) keyRecipients() []string {
+	addrs := map[string]struct{}{
+		t.account.Address(): {},
+	}
+	for _, tp := range t.Peers() {
+		p := t.datastore.Peers().Get(tp.Id)
+		if p == nil || p.Address == "" {
+			continue
+		}
+		if t.readable(p.Address) {
+			addrs[p.Address] = struct{}{}
+		}
+	}
+
+	var list []string
+	for addr := range addrs {
+		list = append(list, addr)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// peersWithAddress returns the thread peers belonging to an account address
+func (t *Thread) peersWithAddress(addr string) []pb.ThreadPeer {
+	var list []pb.ThreadPeer
+	for _, tp := range t.Peers() {
+		p := t.datastore.Peers().Get(tp.Id)
+		if p != nil && p.Address == addr {
+			list = append(list, tp)
+		}
+	}
+	return list
+}
+
+// loadKey follows the key rotation block a ciphertext was encrypted under if its key is missing
+func (t *Thread) loadKey(ciphertext []byte) error {
+	id, _ := splitThreadKeyId(ciphertext)
+	if id == "" || t.datastore.ThreadKeys().Get(id) != nil {
+		return nil
+	}
+
+	hash, err := mh.FromB58String(id)
+	if err != nil {
+		return err
+	}
+	_, err = t.followParent(hash)
+	return err
+}
+
+// encryptWithKey encrypts data with a rotated thread key, prefixing its id
+func encryptWithKey(key *pb.ThreadKey, data []byte) ([]byte, error) {
+	sk, err := ipfs.UnmarshalPrivateKey(key.Sk)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := crypto.Encrypt(sk.GetPublic(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := base58.Decode(key.Id)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, len(threadKeyPrefix)+1+len(id)+len(ciphertext))
+	out = append(out, threadKeyPrefix...)
+	out = append(out, byte(len(id)))
+	out = append(out, id...)
+	return append(out, ciphertext...), nil
+}
+
+// splitThreadKeyId returns the rotated thread key id and remaining ciphertext,
+// or an empty id if the ciphertext uses the original thread key
+func splitThreadKeyId(ciphertext []byte) (string, []byte) {
+	n := len(threadKeyPrefix)
+	if len(ciphertext) <= n || !bytes.HasPrefix(ciphertext, threadKeyPrefix) {
+		return "", ciphertext
+	}
+	end := n + 1 + int(ciphertext[n])
+	if len(ciphertext) < end {
+		return "", ciphertext
+	}
+	id, err := mh.Cast(ciphertext[n+1 : end])
+	if err != nil {
+		return "", ciphertext
+	}
+	return id.B58String(), ciphertext[end:]
+}
+
+// keyRotateSignedData returns the data an author signs for a key rotation block
+func keyRotateSignedData(thread string, header *pb.ThreadBlockHeader, msg *pb.ThreadKeyRotate) []byte {
+	fields := []string{strconv.Itoa(int(msg.Epoch))}
+
+	var addrs []string
+	for addr := range msg.Keys {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		fields = append(fields, addr+":"+base58.FastBase58Encoding(msg.Keys[addr]))
+	}
+
+	return signedBlockData(thread, pb.Block_KEY_ROTATE, header, fields...)
+}
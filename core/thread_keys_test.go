@@ -0,0 +1,104 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/keypair"
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestSplitThreadKeyId(t *testing.T) {
+	hash, err := mh.Sum([]byte("rotation"), mh.SHA2_256, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext := []byte("ciphertext")
+
+	data := append([]byte{}, threadKeyPrefix...)
+	data = append(data, byte(len(hash)))
+	data = append(data, hash...)
+	data = append(data, ciphertext...)
+
+	id, rest := splitThreadKeyId(data)
+	if id != hash.B58String() {
+		t.Errorf("expected id %s, got %s", hash.B58String(), id)
+	}
+	if !bytes.Equal(rest, ciphertext) {
+		t.Errorf("expected ciphertext %s, got %s", ciphertext, rest)
+	}
+
+	// original thread key
+	id, rest = splitThreadKeyId(ciphertext)
+	if id != "" || !bytes.Equal(rest, ciphertext) {
+		t.Error("unprefixed ciphertext should use the original thread key")
+	}
+
+	// truncated
+	id, rest = splitThreadKeyId(data[:len(threadKeyPrefix)+4])
+	if id != "" || len(rest) != len(threadKeyPrefix)+4 {
+		t.Error("truncated ciphertext should use the original thread key")
+	}
+}
+
+func TestThread_AuthorizeKeyRotateBlock(t *testing.T) {
+	initiator := keypair.Random()
+	thrd := newPolicyThread(pb.Thread_OPEN, initiator.Address())
+	thrd.Id = "thread"
+	thrd.initiator = initiator.Address()
+
+	newKeyRotateBlock := func(signer *keypair.Full) *pb.ThreadBlock {
+		header := &pb.ThreadBlockHeader{
+			Date:    ptypes.TimestampNow(),
+			Parents: []string{"parent"},
+			Address: initiator.Address(),
+		}
+		msg := &pb.ThreadKeyRotate{
+			Epoch: 2,
+			Keys: map[string][]byte{
+				initiator.Address(): []byte("key"),
+				policyMember:        []byte("key"),
+			},
+		}
+		sig, err := signer.Sign(keyRotateSignedData(thrd.Id, header, msg))
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg.Sig = sig
+		payload, err := ptypes.MarshalAny(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &pb.ThreadBlock{Header: header, Type: pb.Block_KEY_ROTATE, Payload: payload}
+	}
+
+	block := newKeyRotateBlock(initiator)
+	if err := thrd.authorizeInbound(block); err != nil {
+		t.Errorf("signed rotation should be accepted, got %s", err)
+	}
+
+	// a recipient was dropped in transit
+	msg := new(pb.ThreadKeyRotate)
+	if err := ptypes.UnmarshalAny(block.Payload, msg); err != nil {
+		t.Fatal(err)
+	}
+	delete(msg.Keys, policyMember)
+	block.Payload, _ = ptypes.MarshalAny(msg)
+	if err := thrd.authorizeInbound(block); err != ErrInvalidKeyRotateSig {
+		t.Errorf("altered rotation: expected %s, got %v", ErrInvalidKeyRotateSig, err)
+	}
+
+	block = newKeyRotateBlock(keypair.Random())
+	if err := thrd.authorizeInbound(block); err != ErrInvalidKeyRotateSig {
+		t.Errorf("forged rotation: expected %s, got %v", ErrInvalidKeyRotateSig, err)
+	}
+
+	// only admins may rotate keys
+	block = newKeyRotateBlock(initiator)
+	block.Header.Address = policyMember
+	if err := thrd.authorizeInbound(block); err != ErrNotAdministrable {
+		t.Errorf("member rotation: expected %s, got %v", ErrNotAdministrable, err)
+	}
+}
@@ -12,8 +12,8 @@ func (t *Thread) leave() (mh.Multihash, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
+	if err := t.authorizeOutbound(pb.Block_LEAVE); err != nil {
+		return nil, err
 	}
 
 	res, err := t.commitBlock(nil, pb.Block_LEAVE, nil)
@@ -39,6 +39,21 @@ func (t *Thread) leave() (mh.Multihash, error) {
 	if err := t.datastore.Blocks().DeleteByThread(t.Id); err != nil {
 		return nil, err
 	}
+	if err := t.datastore.RejectedBlocks().DeleteByThread(t.Id); err != nil {
+		return nil, err
+	}
+	if err := t.datastore.ThreadKeys().DeleteByThread(t.Id); err != nil {
+		return nil, err
+	}
+	if err := t.datastore.SearchIndex().DeleteByThread(t.Id); err != nil {
+		return nil, err
+	}
+	if err := t.datastore.ThreadAdverts().Delete(t.Id); err != nil {
+		return nil, err
+	}
+	if err := t.datastore.NotificationSettings().Delete(t.Id); err != nil {
+		return nil, err
+	}
 	if err := t.datastore.ThreadPeers().DeleteByThread(t.Id); err != nil {
 		return nil, err
 	}
@@ -53,19 +68,15 @@ func (t *Thread) leave() (mh.Multihash, error) {
 
 // handleLeaveBlock handles an incoming leave block
 func (t *Thread) handleLeaveBlock(hash mh.Multihash, block *pb.ThreadBlock) error {
-	if !t.readable(t.config.Account.Address) {
-		return ErrNotReadable
-	}
-	if !t.readable(block.Header.Address) {
-		return ErrNotReadable
-	}
-
 	if err := t.datastore.ThreadPeers().Delete(block.Header.Author, t.Id); err != nil {
 		return err
 	}
 	if err := t.datastore.Notifications().DeleteByActor(block.Header.Author); err != nil {
 		return err
 	}
+	if err := addChange(t.datastore, pb.Change_NOTIFICATION_REMOVED, "", t.Id); err != nil {
+		return err
+	}
 
 	return t.indexBlock(&commitResult{
 		hash:   hash,
@@ -11,8 +11,8 @@ func (t *Thread) AddLike(target string) (mh.Multihash, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
-	if !t.annotatable(t.config.Account.Address) {
-		return nil, ErrNotAnnotatable
+	if err := t.authorizeOutbound(pb.Block_LIKE); err != nil {
+		return nil, err
 	}
 
 	msg := &pb.ThreadLike{
@@ -48,13 +48,6 @@ func (t *Thread) handleLikeBlock(hash mh.Multihash, block *pb.ThreadBlock) (*pb.
 		return nil, err
 	}
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
-	}
-	if !t.annotatable(block.Header.Address) {
-		return nil, ErrNotAnnotatable
-	}
-
 	if err := t.indexBlock(&commitResult{
 		hash:   hash,
 		header: block.Header,
@@ -16,8 +16,8 @@ func (t *Thread) merge(head mh.Multihash) (mh.Multihash, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
+	if err := t.authorizeOutbound(pb.Block_MERGE); err != nil {
+		return nil, err
 	}
 
 	// build custom merge header
@@ -84,13 +84,6 @@ func (t *Thread) merge(head mh.Multihash) (mh.Multihash, error) {
 
 // handleMergeBlock handles an incoming merge block
 func (t *Thread) handleMergeBlock(hash mh.Multihash, block *pb.ThreadBlock) error {
-	if !t.readable(t.config.Account.Address) {
-		return ErrNotReadable
-	}
-	if !t.readable(block.Header.Address) {
-		return ErrNotReadable
-	}
-
 	return t.indexBlock(&commitResult{
 		hash:   hash,
 		header: block.Header,
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/golang/protobuf/ptypes"
@@ -8,18 +9,35 @@ import (
 	"github.com/textileio/go-textile/pb"
 )
 
+// ErrReplyTargetNotFound indicates a reply targets a message not found in the thread
+var ErrReplyTargetNotFound = fmt.Errorf("reply target not found")
+
 // AddMessage adds an outgoing message block
 func (t *Thread) AddMessage(body string) (mh.Multihash, error) {
+	return t.AddReply("", body)
+}
+
+// AddReply adds an outgoing message block in reply to another message,
+// which is a plain message if replyTo is empty
+func (t *Thread) AddReply(replyTo string, body string) (mh.Multihash, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
-	if !t.writable(t.config.Account.Address) {
-		return nil, ErrNotWritable
+	if err := t.authorizeOutbound(pb.Block_TEXT); err != nil {
+		return nil, err
+	}
+
+	if replyTo != "" {
+		if err := checkReply(t.datastore.Blocks().Get(replyTo), t.Id); err != nil {
+			return nil, err
+		}
 	}
 
 	body = strings.TrimSpace(body)
 	msg := &pb.ThreadMessage{
-		Body: body,
+		Body:     body,
+		Mentions: parseMentions(body),
+		ReplyTo:  replyTo,
 	}
 
 	res, err := t.commitBlock(msg, pb.Block_TEXT, nil)
@@ -27,7 +45,7 @@ func (t *Thread) AddMessage(body string) (mh.Multihash, error) {
 		return nil, err
 	}
 
-	if err := t.indexBlock(res, pb.Block_TEXT, "", body); err != nil {
+	if err := t.indexBlock(res, pb.Block_TEXT, replyTo, body); err != nil {
 		return nil, err
 	}
 
@@ -51,18 +69,27 @@ func (t *Thread) handleMessageBlock(hash mh.Multihash, block *pb.ThreadBlock) (*
 		return nil, err
 	}
 
-	if !t.readable(t.config.Account.Address) {
-		return nil, ErrNotReadable
-	}
-	if !t.writable(block.Header.Address) {
-		return nil, ErrNotWritable
+	// keep the message, but not a reply to something it can't reply to
+	if msg.ReplyTo != "" {
+		if err := checkReply(t.datastore.Blocks().Get(msg.ReplyTo), t.Id); err != nil {
+			log.Warningf("dropping reply target %s of %s: %s", msg.ReplyTo, hash.B58String(), err)
+			msg.ReplyTo = ""
+		}
 	}
 
 	if err := t.indexBlock(&commitResult{
 		hash:   hash,
 		header: block.Header,
-	}, pb.Block_TEXT, "", msg.Body); err != nil {
+	}, pb.Block_TEXT, msg.ReplyTo, msg.Body); err != nil {
 		return nil, err
 	}
 	return msg, nil
 }
+
+// checkReply returns an error if a target block isn't a message in the given thread
+func checkReply(target *pb.Block, thread string) error {
+	if target == nil || target.Thread != thread || target.Type != pb.Block_TEXT {
+		return ErrReplyTargetNotFound
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/keypair"
+	"github.com/textileio/go-textile/pb"
+)
+
+// ErrBlockRejected indicates an inbound block was rejected by thread policy
+var ErrBlockRejected = fmt.Errorf("block rejected by thread policy")
+
+// ErrBlockTypeNotAllowed indicates a block type has no thread policy
+var ErrBlockTypeNotAllowed = fmt.Errorf("block type not allowed")
+
+// permission is a capability a thread may grant to an address
+type permission int
+
+const (
+	// permissionNone is required of authorless blocks, i.e., merges
+	permissionNone permission = iota
+	permissionRead
+	permissionAnnotate
+	permissionWrite
+	permissionAdmin
+)
+
+// blockPermissions is the permission required to author each block type.
+// Invites (ADD) are not included since they're checked against both inviter
+// and invitee with shareable.
+var blockPermissions = map[pb.Block_BlockType]permission{
+	pb.Block_MERGE:       permissionNone,
+	pb.Block_IGNORE:      permissionAnnotate,
+	pb.Block_FLAG:        permissionAnnotate,
+	pb.Block_JOIN:        permissionRead,
+	pb.Block_ANNOUNCE:    permissionRead,
+	pb.Block_LEAVE:       permissionRead,
+	pb.Block_TEXT:        permissionWrite,
+	pb.Block_FILES:       permissionWrite,
+	pb.Block_COMMENT:     permissionAnnotate,
+	pb.Block_LIKE:        permissionAnnotate,
+	pb.Block_ROLE_GRANT:  permissionAdmin,
+	pb.Block_ROLE_REVOKE: permissionAdmin,
+	pb.Block_KEY_ROTATE:  permissionAdmin,
+	pb.Block_EDIT:        permissionAnnotate,
+	pb.Block_REACTION:    permissionAnnotate,
+}
+
+// authorize returns an error if the given address may not author a block type
+func (t *Thread) authorize(btype pb.Block_BlockType, addr string) error {
+	perm, ok := blockPermissions[btype]
+	if !ok {
+		return ErrBlockTypeNotAllowed
+	}
+
+	switch perm {
+	case permissionRead:
+		if !t.readable(addr) {
+			return ErrNotReadable
+		}
+	case permissionAnnotate:
+		if !t.annotatable(addr) {
+			return ErrNotAnnotatable
+		}
+	case permissionWrite:
+		if !t.writable(addr) {
+			return ErrNotWritable
+		}
+	case permissionAdmin:
+		if t.role(addr) != pb.Thread_ADMIN {
+			return ErrNotAdministrable
+		}
+	}
+	return nil
+}
+
+// authorizeOutbound returns an error if the local account may not author a block type
+func (t *Thread) authorizeOutbound(btype pb.Block_BlockType) error {
+	if !t.readable(t.config.Account.Address) {
+		return ErrNotReadable
+	}
+	return t.authorize(btype, t.config.Account.Address)
+}
+
+// authorizeInbound returns an error if an inbound block should not be accepted.
+// The local account must be able to read the thread, and the block author must
+// be allowed to author the block type. Role and key rotation blocks must also be
+// signed by the author, edits and ignores must be by the author of their target, and reactions
+// must have a valid key.
+func (t *Thread) authorizeInbound(block *pb.ThreadBlock) error {
+	if !t.readable(t.config.Account.Address) {
+		return ErrNotReadable
+	}
+	if err := t.authorize(block.Type, block.Header.Address); err != nil {
+		return err
+	}
+
+	switch block.Type {
+	case pb.Block_ROLE_GRANT, pb.Block_ROLE_REVOKE:
+		return t.authorizeRoleBlock(block)
+	case pb.Block_KEY_ROTATE:
+		return t.authorizeKeyRotateBlock(block)
+	case pb.Block_EDIT:
+		return t.authorizeEditBlock(block)
+	case pb.Block_IGNORE:
+		return t.authorizeIgnoreBlock(block)
+	case pb.Block_REACTION:
+		return t.authorizeReactionBlock(block)
+	}
+	return nil
+}
+
+// reject records a block that failed thread policy and notifies the account
+func (t *Thread) reject(hash mh.Multihash, block *pb.ThreadBlock, reason error) error {
+	log.Warningf("rejecting %s %s from %s: %s",
+		block.Type.String(), hash.B58String(), block.Header.Author, reason)
+
+	if err := t.datastore.RejectedBlocks().Add(&pb.RejectedBlock{
+		Id:      hash.B58String(),
+		Thread:  t.Id,
+		Author:  block.Header.Author,
+		Address: block.Header.Address,
+		Type:    block.Type,
+		Date:    block.Header.Date,
+		Reason:  reason.Error(),
+	}); err != nil {
+		return err
+	}
+
+	return t.service().handleRejected(t, hash, block, reason)
+}
+
+// signedBlockData returns the data an author signs for a block payload. Including the
+// thread, block type, and header binds the payload to its position in the thread,
+// so it can't be replayed by other members.
+func signedBlockData(thread string, btype pb.Block_BlockType, header *pb.ThreadBlockHeader, fields ...string) []byte {
+	var buf bytes.Buffer
+	for _, field := range append([]string{
+		thread,
+		btype.String(),
+		ptypes.TimestampString(header.Date),
+		strings.Join(header.Parents, ","),
+		header.Address,
+	}, fields...) {
+		buf.WriteString(field)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// verifyBlockSig returns an error if sig is not the block author's account signature of data
+func verifyBlockSig(block *pb.ThreadBlock, data []byte, sig []byte) error {
+	author, err := keypair.Parse(block.Header.Address)
+	if err != nil {
+		return err
+	}
+	return author.Verify(data, sig)
+}
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo/config"
+)
+
+const (
+	policyInitiator = "initiator"
+	policyMember    = "member"
+	policyOutsider  = "outsider"
+)
+
+// policyLevels is the permission each thread type grants its (non-initiator) members
+var policyLevels = map[pb.Thread_Type]permission{
+	pb.Thread_PRIVATE:   permissionNone,
+	pb.Thread_READ_ONLY: permissionRead,
+	pb.Thread_PUBLIC:    permissionAnnotate,
+	pb.Thread_OPEN:      permissionWrite,
+}
+
+// policyBlocks is the permission each block type requires of its author
+var policyBlocks = map[pb.Block_BlockType]permission{
+	pb.Block_MERGE:    permissionNone,
+	pb.Block_IGNORE:   permissionAnnotate,
+	pb.Block_FLAG:     permissionAnnotate,
+	pb.Block_JOIN:     permissionRead,
+	pb.Block_ANNOUNCE: permissionRead,
+	pb.Block_LEAVE:    permissionRead,
+	pb.Block_TEXT:     permissionWrite,
+	pb.Block_FILES:    permissionWrite,
+	pb.Block_COMMENT:  permissionAnnotate,
+	pb.Block_LIKE:     permissionAnnotate,
+	pb.Block_REACTION: permissionAnnotate,
+}
+
+// policyPayloads are inbound block types whose payload is checked once the author is allowed
+var policyPayloads = map[pb.Block_BlockType]bool{
+	pb.Block_IGNORE:   true,
+	pb.Block_REACTION: true,
+}
+
+func newPolicyThread(ttype pb.Thread_Type, local string) *Thread {
+	return &Thread{
+		initiator: policyInitiator,
+		ttype:     ttype,
+		whitelist: []string{policyInitiator, policyMember},
+		roles:     make(map[string]pb.Thread_Role),
+		config:    &config.Config{Account: config.Account{Address: local}},
+	}
+}
+
+func TestThread_Authorize(t *testing.T) {
+	for ttype, level := range policyLevels {
+		thrd := newPolicyThread(ttype, policyInitiator)
+
+		for btype, required := range policyBlocks {
+			// initiators may author anything
+			if err := thrd.authorize(btype, policyInitiator); err != nil {
+				t.Errorf("%s by initiator in %s thread: %s", btype, ttype, err)
+			}
+
+			// members are limited by thread type
+			err := thrd.authorize(btype, policyMember)
+			if allowed := required <= level; allowed != (err == nil) {
+				t.Errorf("%s by member in %s thread: expected allowed=%t, got %v",
+					btype, ttype, allowed, err)
+			}
+
+			// outsiders may only author authorless blocks
+			err = thrd.authorize(btype, policyOutsider)
+			if allowed := required == permissionNone; allowed != (err == nil) {
+				t.Errorf("%s by outsider in %s thread: expected allowed=%t, got %v",
+					btype, ttype, allowed, err)
+			}
+		}
+
+		if err := thrd.authorize(pb.Block_ADD, policyInitiator); err != ErrBlockTypeNotAllowed {
+			t.Errorf("ADD in %s thread: expected %s, got %v", ttype, ErrBlockTypeNotAllowed, err)
+		}
+	}
+}
+
+func TestThread_AuthorizeInbound(t *testing.T) {
+	for ttype, level := range policyLevels {
+		for btype, required := range policyBlocks {
+			block := &pb.ThreadBlock{
+				Header: &pb.ThreadBlockHeader{Address: policyMember},
+				Type:   btype,
+			}
+
+			// a readable thread defers to the author's permissions
+			thrd := newPolicyThread(ttype, policyInitiator)
+			err := thrd.authorizeInbound(block)
+			allowed := required <= level
+			if allowed && policyPayloads[btype] {
+				// the test block has no payload
+				if err == nil {
+					t.Errorf("inbound %s by member in %s thread: expected payload error", btype, ttype)
+				}
+			} else if allowed != (err == nil) {
+				t.Errorf("inbound %s by member in %s thread: expected allowed=%t, got %v",
+					btype, ttype, allowed, err)
+			}
+
+			// nothing is accepted by a peer that can't read the thread
+			thrd = newPolicyThread(ttype, policyOutsider)
+			if err := thrd.authorizeInbound(block); err != ErrNotReadable {
+				t.Errorf("inbound %s to outsider in %s thread: expected %s, got %v",
+					btype, ttype, ErrNotReadable, err)
+			}
+		}
+	}
+}
+
+func TestThread_AuthorizeOutbound(t *testing.T) {
+	for ttype, level := range policyLevels {
+		thrd := newPolicyThread(ttype, policyMember)
+
+		for btype, required := range policyBlocks {
+			err := thrd.authorizeOutbound(btype)
+			allowed := required <= level && level != permissionNone
+			if allowed != (err == nil) {
+				t.Errorf("outbound %s by member in %s thread: expected allowed=%t, got %v",
+					btype, ttype, allowed, err)
+			}
+		}
+	}
+}
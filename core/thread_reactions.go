@@ -0,0 +1,160 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/pb"
+)
+
+// likeReaction is the reaction key of like blocks
+const likeReaction = "heart"
+
+// maxReactionLength is the max length of a reaction key in characters
+const maxReactionLength = 32
+
+// ErrInvalidReaction indicates a reaction key is empty, too long, or contains whitespace
+var ErrInvalidReaction = fmt.Errorf("reaction must be a short key without whitespace")
+
+// ErrReactionTargetNotFound indicates a reaction targets a block not found in the thread
+var ErrReactionTargetNotFound = fmt.Errorf("reaction target not found")
+
+// ErrReactionNotFound indicates the local account has no matching reaction on a block
+var ErrReactionNotFound = fmt.Errorf("reaction not found")
+
+// AddReaction adds an outgoing reaction block
+func (t *Thread) AddReaction(target string, reaction string) (mh.Multihash, error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if err := t.authorizeOutbound(pb.Block_REACTION); err != nil {
+		return nil, err
+	}
+
+	reaction, err := normalizeReaction(reaction)
+	if err != nil {
+		return nil, err
+	}
+	tblock := t.datastore.Blocks().Get(target)
+	if tblock == nil || tblock.Thread != t.Id {
+		return nil, ErrReactionTargetNotFound
+	}
+
+	msg := &pb.ThreadReaction{
+		Target:   target,
+		Reaction: reaction,
+	}
+
+	res, err := t.commitBlock(msg, pb.Block_REACTION, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.indexBlock(res, pb.Block_REACTION, target, reaction); err != nil {
+		return nil, err
+	}
+
+	if err := t.updateHead(res.hash); err != nil {
+		return nil, err
+	}
+
+	if err := t.post(res, t.Peers()); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("added REACTION to %s: %s", t.Id, res.hash.B58String())
+
+	return res.hash, nil
+}
+
+// RemoveReaction ignores the local account's reactions on a target block that match
+// the given reaction key. Likes are removed with the heart reaction.
+func (t *Thread) RemoveReaction(target string, reaction string) (mh.Multihash, error) {
+	reaction, err := normalizeReaction(reaction)
+	if err != nil {
+		return nil, err
+	}
+
+	var hash mh.Multihash
+	for _, block := range t.datastore.Blocks().List("", -1, reactionsQuery(target)).Items {
+		if block.Thread != t.Id || blockReaction(block) != reaction {
+			continue
+		}
+		if t.blockAddress(block) != t.config.Account.Address {
+			continue
+		}
+		ignored := t.datastore.Blocks().List("", -1, "target='ignore-"+block.Id+"'")
+		if len(ignored.Items) > 0 {
+			continue
+		}
+		hash, err = t.AddIgnore(block.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if hash == nil {
+		return nil, ErrReactionNotFound
+	}
+	return hash, nil
+}
+
+// handleReactionBlock handles an incoming reaction block
+func (t *Thread) handleReactionBlock(hash mh.Multihash, block *pb.ThreadBlock) (*pb.ThreadReaction, error) {
+	msg := new(pb.ThreadReaction)
+	if err := ptypes.UnmarshalAny(block.Payload, msg); err != nil {
+		return nil, err
+	}
+
+	if err := t.indexBlock(&commitResult{
+		hash:   hash,
+		header: block.Header,
+	}, pb.Block_REACTION, msg.Target, msg.Reaction); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// authorizeReactionBlock returns an error if an inbound reaction key is invalid
+func (t *Thread) authorizeReactionBlock(block *pb.ThreadBlock) error {
+	msg := new(pb.ThreadReaction)
+	if err := ptypes.UnmarshalAny(block.Payload, msg); err != nil {
+		return err
+	}
+	return checkReaction(msg.Reaction)
+}
+
+// checkReaction returns an error if an inbound reaction key is not already normalized
+func checkReaction(reaction string) error {
+	normalized, err := normalizeReaction(reaction)
+	if err != nil {
+		return err
+	}
+	if normalized != reaction {
+		return ErrInvalidReaction
+	}
+	return nil
+}
+
+// normalizeReaction trims a reaction key and returns an error if it's invalid
+func normalizeReaction(reaction string) (string, error) {
+	reaction = strings.TrimSpace(reaction)
+	if reaction == "" || utf8.RuneCountInString(reaction) > maxReactionLength {
+		return "", ErrInvalidReaction
+	}
+	if strings.IndexFunc(reaction, unicode.IsSpace) >= 0 {
+		return "", ErrInvalidReaction
+	}
+	return reaction, nil
+}
+
+// blockReaction returns the reaction key of a like or reaction block
+func blockReaction(block *pb.Block) string {
+	if block.Type == pb.Block_LIKE {
+		return likeReaction
+	}
+	return block.Body
+}
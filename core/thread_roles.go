@@ -0,0 +1,235 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/pb"
+)
+
+// ErrNotAdministrable indicates the thread roles cannot be changed, at least by _you_
+var ErrNotAdministrable = fmt.Errorf("thread roles are not administrable")
+
+// ErrRoleNotAllowed indicates a role change is not allowed for the issuer
+var ErrRoleNotAllowed = fmt.Errorf("role change not allowed")
+
+// ErrInvalidRole indicates a role block contains an unusable role
+var ErrInvalidRole = fmt.Errorf("invalid thread role")
+
+// ErrInvalidRoleSig indicates a role block was not signed by its issuer
+var ErrInvalidRoleSig = fmt.Errorf("invalid thread role signature")
+
+// AddRoleGrant adds an outgoing role grant block, giving an address a role
+// regardless of thread type and whitelist
+func (t *Thread) AddRoleGrant(addr string, role pb.Thread_Role) (mh.Multihash, error) {
+	if role == pb.Thread_NONE {
+		return nil, ErrInvalidRole
+	}
+	return t.addRole(pb.Block_ROLE_GRANT, addr, role)
+}
+
+// AddRoleRevoke adds an outgoing role revoke block, removing all access from an address
+func (t *Thread) AddRoleRevoke(addr string) (mh.Multihash, error) {
+	return t.addRole(pb.Block_ROLE_REVOKE, addr, pb.Thread_NONE)
+}
+
+// Roles returns the effective role of each address with a granted or revoked role
+func (t *Thread) Roles() *pb.ThreadRoleList {
+	list := &pb.ThreadRoleList{Items: make([]*pb.ThreadRole, 0)}
+	for addr, role := range t.grantedRoles() {
+		list.Items = append(list.Items, &pb.ThreadRole{
+			Address: addr,
+			Role:    role,
+		})
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].Address < list.Items[j].Address
+	})
+	return list
+}
+
+// addRole signs and commits a role block
+func (t *Thread) addRole(btype pb.Block_BlockType, addr string, role pb.Thread_Role) (mh.Multihash, error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if err := t.authorizeOutbound(btype); err != nil {
+		return nil, err
+	}
+	if err := t.authorizeRole(t.config.Account.Address, addr, role); err != nil {
+		return nil, err
+	}
+
+	header, err := t.newBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &pb.ThreadRole{
+		Address: addr,
+		Role:    role,
+	}
+	msg.Sig, err = t.account.Sign(roleSignedData(t.Id, btype, header, msg))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.commitBlockWithHeader(header, msg, btype, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.indexRoleBlock(res, btype, msg); err != nil {
+		return nil, err
+	}
+
+	if err := t.updateHead(res.hash); err != nil {
+		return nil, err
+	}
+
+	if err := t.post(res, t.Peers()); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("added %s to %s: %s", btype.String(), t.Id, res.hash.B58String())
+
+	return res.hash, nil
+}
+
+// handleRoleBlock handles an incoming role block
+// Note: Policy (including the issuer signature) has already been checked by authorizeInbound
+func (t *Thread) handleRoleBlock(hash mh.Multihash, block *pb.ThreadBlock) (*pb.ThreadRole, error) {
+	msg := new(pb.ThreadRole)
+	if err := ptypes.UnmarshalAny(block.Payload, msg); err != nil {
+		return nil, err
+	}
+
+	if err := t.indexRoleBlock(&commitResult{
+		hash:   hash,
+		header: block.Header,
+	}, block.Type, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// indexRoleBlock indexes a role block and resets roles so that they're replayed on next use
+func (t *Thread) indexRoleBlock(commit *commitResult, btype pb.Block_BlockType, msg *pb.ThreadRole) error {
+	if err := t.indexBlock(commit, btype, msg.Address, msg.Role.String()); err != nil {
+		return err
+	}
+
+	t.rolesMux.Lock()
+	t.roles = nil
+	t.rolesMux.Unlock()
+	return nil
+}
+
+// authorizeRole returns an error if an issuer may not give an address a role.
+// The initiator may assign any role, while admins may only manage non-admins.
+// Nobody can change the initiator's role.
+func (t *Thread) authorizeRole(issuer string, addr string, role pb.Thread_Role) error {
+	if _, ok := pb.Thread_Role_name[int32(role)]; !ok || addr == "" {
+		return ErrInvalidRole
+	}
+	if t.role(issuer) != pb.Thread_ADMIN {
+		return ErrNotAdministrable
+	}
+	if addr == t.initiator {
+		return ErrRoleNotAllowed
+	}
+	if issuer == t.initiator {
+		return nil
+	}
+	if role == pb.Thread_ADMIN || t.role(addr) == pb.Thread_ADMIN {
+		return ErrRoleNotAllowed
+	}
+	return nil
+}
+
+// authorizeRoleBlock returns an error if an inbound role block is malformed,
+// not signed by its issuer, or not allowed for its issuer
+func (t *Thread) authorizeRoleBlock(block *pb.ThreadBlock) error {
+	msg := new(pb.ThreadRole)
+	if err := ptypes.UnmarshalAny(block.Payload, msg); err != nil {
+		return err
+	}
+
+	switch block.Type {
+	case pb.Block_ROLE_GRANT:
+		if msg.Role == pb.Thread_NONE {
+			return ErrInvalidRole
+		}
+	case pb.Block_ROLE_REVOKE:
+		if msg.Role != pb.Thread_NONE {
+			return ErrInvalidRole
+		}
+	}
+
+	data := roleSignedData(t.Id, block.Type, block.Header, msg)
+	if err := verifyBlockSig(block, data, msg.Sig); err != nil {
+		return ErrInvalidRoleSig
+	}
+
+	return t.authorizeRole(block.Header.Address, msg.Address, msg.Role)
+}
+
+// role returns the effective role of an address. Granted and revoked roles
+// take precedence over those given by thread type and whitelist.
+func (t *Thread) role(addr string) pb.Thread_Role {
+	if addr == t.initiator {
+		return pb.Thread_ADMIN
+	}
+	if role, ok := t.grantedRoles()[addr]; ok {
+		return role
+	}
+	if !t.whitelisted(addr) {
+		return pb.Thread_NONE
+	}
+	switch t.ttype {
+	case pb.Thread_READ_ONLY:
+		return pb.Thread_READ
+	case pb.Thread_PUBLIC:
+		return pb.Thread_ANNOTATE
+	case pb.Thread_OPEN:
+		return pb.Thread_WRITE
+	default:
+		return pb.Thread_NONE
+	}
+}
+
+// grantedRoles returns the roles given by role blocks, replaying them if needed
+func (t *Thread) grantedRoles() map[string]pb.Thread_Role {
+	t.rolesMux.Lock()
+	defer t.rolesMux.Unlock()
+
+	if t.roles == nil {
+		query := fmt.Sprintf("threadId='%s' and (type=%d or type=%d)",
+			t.Id, pb.Block_ROLE_GRANT, pb.Block_ROLE_REVOKE)
+		t.roles = replayRoles(t.datastore.Blocks().List("", -1, query).Items)
+	}
+	return t.roles
+}
+
+// replayRoles applies indexed role blocks from oldest to newest
+// Note: blocks are expected in descending date order, as listed by the block store
+func replayRoles(blocks []*pb.Block) map[string]pb.Thread_Role {
+	roles := make(map[string]pb.Thread_Role)
+	for i := len(blocks) - 1; i >= 0; i-- {
+		block := blocks[i]
+		switch block.Type {
+		case pb.Block_ROLE_GRANT:
+			roles[block.Target] = pb.Thread_Role(pb.Thread_Role_value[block.Body])
+		case pb.Block_ROLE_REVOKE:
+			roles[block.Target] = pb.Thread_NONE
+		}
+	}
+	return roles
+}
+
+// roleSignedData returns the data an issuer signs for a role block
+func roleSignedData(thread string, btype pb.Block_BlockType, header *pb.ThreadBlockHeader, msg *pb.ThreadRole) []byte {
+	return signedBlockData(thread, btype, header, msg.Address, msg.Role.String())
+}
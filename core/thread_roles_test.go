@@ -0,0 +1,144 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/keypair"
+	"github.com/textileio/go-textile/pb"
+)
+
+const (
+	roleAdmin   = "admin"
+	roleRevoked = "revoked"
+)
+
+func TestReplayRoles(t *testing.T) {
+	// newest first, as listed by the block store
+	blocks := []*pb.Block{
+		{Type: pb.Block_ROLE_GRANT, Target: policyOutsider, Body: "ANNOTATE"},
+		{Type: pb.Block_ROLE_REVOKE, Target: policyMember, Body: "NONE"},
+		{Type: pb.Block_ROLE_GRANT, Target: policyOutsider, Body: "WRITE"},
+		{Type: pb.Block_ROLE_GRANT, Target: policyMember, Body: "ADMIN"},
+		{Type: pb.Block_TEXT, Target: policyMember, Body: "hi"},
+	}
+
+	roles := replayRoles(blocks)
+	if len(roles) != 2 {
+		t.Fatalf("expected 2 roles, got %d", len(roles))
+	}
+	if roles[policyMember] != pb.Thread_NONE {
+		t.Errorf("expected member to be revoked, got %s", roles[policyMember])
+	}
+	if roles[policyOutsider] != pb.Thread_ANNOTATE {
+		t.Errorf("expected outsider to annotate, got %s", roles[policyOutsider])
+	}
+}
+
+func TestThread_Role(t *testing.T) {
+	thrd := newPolicyThread(pb.Thread_READ_ONLY, policyInitiator)
+	thrd.whitelist = append(thrd.whitelist, roleRevoked)
+	thrd.roles[policyOutsider] = pb.Thread_WRITE
+	thrd.roles[roleRevoked] = pb.Thread_NONE
+
+	if thrd.role(policyInitiator) != pb.Thread_ADMIN {
+		t.Error("initiator should be admin")
+	}
+	if thrd.role(policyMember) != pb.Thread_READ || thrd.annotatable(policyMember) {
+		t.Error("member should only read")
+	}
+	if !thrd.writable(policyOutsider) || !thrd.member(policyOutsider) {
+		t.Error("granted outsider should be a writable member")
+	}
+	if thrd.readable(roleRevoked) || thrd.member(roleRevoked) {
+		t.Error("revoked whitelist member should not be a readable member")
+	}
+	if thrd.readable("other") {
+		t.Error("non-whitelisted address should not be readable")
+	}
+}
+
+func TestThread_AuthorizeRole(t *testing.T) {
+	thrd := newPolicyThread(pb.Thread_OPEN, policyInitiator)
+	thrd.roles[roleAdmin] = pb.Thread_ADMIN
+
+	tests := []struct {
+		issuer string
+		addr   string
+		role   pb.Thread_Role
+		err    error
+	}{
+		{policyInitiator, policyOutsider, pb.Thread_ADMIN, nil},
+		{policyInitiator, roleAdmin, pb.Thread_NONE, nil},
+		{roleAdmin, policyOutsider, pb.Thread_WRITE, nil},
+		{roleAdmin, policyMember, pb.Thread_NONE, nil},
+		{roleAdmin, policyOutsider, pb.Thread_ADMIN, ErrRoleNotAllowed},
+		{roleAdmin, roleAdmin, pb.Thread_NONE, ErrRoleNotAllowed},
+		{roleAdmin, policyInitiator, pb.Thread_NONE, ErrRoleNotAllowed},
+		{policyInitiator, policyInitiator, pb.Thread_READ, ErrRoleNotAllowed},
+		{policyMember, policyOutsider, pb.Thread_READ, ErrNotAdministrable},
+		{policyInitiator, "", pb.Thread_READ, ErrInvalidRole},
+		{policyInitiator, policyOutsider, pb.Thread_Role(99), ErrInvalidRole},
+	}
+	for _, test := range tests {
+		if err := thrd.authorizeRole(test.issuer, test.addr, test.role); err != test.err {
+			t.Errorf("%s giving %s %s: expected %v, got %v",
+				test.issuer, test.addr, test.role, test.err, err)
+		}
+	}
+}
+
+func TestThread_AuthorizeRoleBlock(t *testing.T) {
+	initiator := keypair.Random()
+	thrd := newPolicyThread(pb.Thread_OPEN, initiator.Address())
+	thrd.Id = "thread"
+	thrd.initiator = initiator.Address()
+
+	newRoleBlock := func(btype pb.Block_BlockType, signer *keypair.Full, role pb.Thread_Role) *pb.ThreadBlock {
+		header := &pb.ThreadBlockHeader{
+			Date:    ptypes.TimestampNow(),
+			Parents: []string{"parent"},
+			Address: initiator.Address(),
+		}
+		msg := &pb.ThreadRole{
+			Address: policyMember,
+			Role:    role,
+		}
+		sig, err := signer.Sign(roleSignedData(thrd.Id, btype, header, msg))
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg.Sig = sig
+		payload, err := ptypes.MarshalAny(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &pb.ThreadBlock{Header: header, Type: btype, Payload: payload}
+	}
+
+	block := newRoleBlock(pb.Block_ROLE_GRANT, initiator, pb.Thread_WRITE)
+	if err := thrd.authorizeInbound(block); err != nil {
+		t.Errorf("signed grant should be accepted, got %s", err)
+	}
+
+	// replayed at a different position
+	block.Header.Parents = []string{"other"}
+	if err := thrd.authorizeInbound(block); err != ErrInvalidRoleSig {
+		t.Errorf("replayed grant: expected %s, got %v", ErrInvalidRoleSig, err)
+	}
+
+	block = newRoleBlock(pb.Block_ROLE_GRANT, keypair.Random(), pb.Thread_WRITE)
+	if err := thrd.authorizeInbound(block); err != ErrInvalidRoleSig {
+		t.Errorf("forged grant: expected %s, got %v", ErrInvalidRoleSig, err)
+	}
+
+	block = newRoleBlock(pb.Block_ROLE_REVOKE, initiator, pb.Thread_READ)
+	if err := thrd.authorizeInbound(block); err != ErrInvalidRole {
+		t.Errorf("revoke with role: expected %s, got %v", ErrInvalidRole, err)
+	}
+
+	block = newRoleBlock(pb.Block_ROLE_REVOKE, initiator, pb.Thread_NONE)
+	if err := thrd.authorizeInbound(block); err != nil {
+		t.Errorf("signed revoke should be accepted, got %s", err)
+	}
+}
@@ -0,0 +1,489 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	cid "github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/schema"
+)
+
+// Verify walks the thread from HEAD, checking that each block is present, pinned,
+// decryptable and indexed, that role grants, role revokes and key rotations carry a
+// valid payload signature, and that the nodes of each files block are present and
+// pinned as the thread schema dictates. Indexed blocks that aren't reachable from
+// HEAD are reported as orphans.
+//
+// With repair, missing blocks and file nodes are fetched from the network, unpinned
+// ones are pinned, unindexed blocks are handled again, and orphans are merged into
+// HEAD, or removed from the index if their block is gone.
+func (t *Thread) Verify(repair bool) (*pb.ThreadVerifyReport, error) {
+	v, err := t.verify(repair)
+	if err != nil {
+		return nil, err
+	}
+
+	// handling blocks takes the thread lock, so unindexed blocks are handled and
+	// orphans are merged once the walk is done
+	v.handleUnindexed()
+	v.mergeOrphans()
+
+	return v.report, nil
+}
+
+// verify walks the thread under lock, collecting the unindexed blocks to handle and
+// the orphans to merge with repair
+func (t *Thread) verify(repair bool) (*threadVerifier, error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	head, err := t.Head()
+	if err != nil {
+		return nil, err
+	}
+
+	v := &threadVerifier{
+		thread: t,
+		repair: repair,
+		report: &pb.ThreadVerifyReport{
+			Thread: t.Id,
+			Head:   head,
+			Issues: make([]*pb.ThreadVerifyIssue, 0),
+		},
+	}
+
+	var queue []string
+	if head != "" {
+		queue = strings.Split(head, ",")
+	}
+	seen := make(map[string]struct{})
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		v.report.Checked++
+
+		block, err := v.verifyBlock(id)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			continue
+		}
+		for _, p := range block.Header.Parents {
+			if strings.TrimSpace(p) != "" {
+				queue = append(queue, p)
+			}
+		}
+	}
+
+	if err := v.verifyOrphans(seen); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// threadVerifier collects issues found while verifying a thread
+type threadVerifier struct {
+	thread    *Thread
+	repair    bool
+	report    *pb.ThreadVerifyReport
+	unindexed []unindexedBlock
+	merges    []orphanMerge
+}
+
+// unindexedBlock is a block reachable from HEAD to handle again, along with its issue
+type unindexedBlock struct {
+	id    string
+	issue *pb.ThreadVerifyIssue
+}
+
+// orphanMerge is an orphan to merge into HEAD, along with the issues it repairs
+type orphanMerge struct {
+	id      string
+	parents []string
+	issue   *pb.ThreadVerifyIssue
+	repairs []*pb.ThreadVerifyIssue
+}
+
+// issue adds an issue to the report
+func (v *threadVerifier) issue(block string, itype pb.ThreadVerifyIssue_Type, detail string) *pb.ThreadVerifyIssue {
+	issue := &pb.ThreadVerifyIssue{
+		Block:  block,
+		Type:   itype,
+		Detail: detail,
+	}
+	v.report.Issues = append(v.report.Issues, issue)
+	return issue
+}
+
+// verifyBlock checks a block reachable from HEAD, returning it if its parents can be walked
+func (v *threadVerifier) verifyBlock(id string) (*pb.ThreadBlock, error) {
+	t := v.thread
+
+	bid, err := cid.Decode(id)
+	if err != nil {
+		v.issue(id, pb.ThreadVerifyIssue_MISSING_BLOCK, err.Error())
+		return nil, nil
+	}
+
+	has, err := ipfs.HasBlock(t.node(), bid)
+	if err != nil {
+		return nil, err
+	}
+	var missing *pb.ThreadVerifyIssue
+	if !has {
+		missing = v.issue(id, pb.ThreadVerifyIssue_MISSING_BLOCK, "not in the local blockstore")
+		if !v.repair {
+			return nil, nil
+		}
+	}
+
+	// fetches from the network if missing
+	ciphertext, err := ipfs.DataAtPath(t.node(), id)
+	if err != nil {
+		if missing != nil {
+			missing.Detail = err.Error()
+		} else {
+			v.issue(id, pb.ThreadVerifyIssue_MISSING_BLOCK, err.Error())
+		}
+		return nil, nil
+	}
+	if missing != nil {
+		missing.Repaired = true
+	}
+
+	if err := v.verifyPinned(id, bid, false, pb.ThreadVerifyIssue_UNPINNED_BLOCK); err != nil {
+		return nil, err
+	}
+
+	if v.repair {
+		if err := t.loadKey(ciphertext); err != nil {
+			log.Warningf("unable to load key for %s: %s", id, err)
+		}
+	}
+	block, err := t.decodeBlock(ciphertext)
+	if err != nil {
+		v.issue(id, pb.ThreadVerifyIssue_UNDECRYPTABLE, err.Error())
+		return nil, nil
+	}
+
+	if err := t.verifyBlockSig(block); err != nil {
+		v.issue(id, pb.ThreadVerifyIssue_INVALID_SIG, err.Error())
+	}
+
+	index := t.datastore.Blocks().Get(id)
+	if index == nil {
+		if t.datastore.RejectedBlocks().Get(id) != nil {
+			return block, nil
+		}
+
+		issue := v.issue(id, pb.ThreadVerifyIssue_UNINDEXED_BLOCK, block.Type.String())
+		if v.repair {
+			v.unindexed = append(v.unindexed, unindexedBlock{id: id, issue: issue})
+		}
+		return block, nil
+	}
+
+	if index.Type == pb.Block_FILES {
+		if err := v.verifyFiles(index); err != nil {
+			return nil, err
+		}
+	}
+	return block, nil
+}
+
+// verifyFiles checks that the nodes of a files block are present and pinned as
+// the thread schema dictates (see handleFilesBlock)
+func (v *threadVerifier) verifyFiles(index *pb.Block) error {
+	t := v.thread
+	if t.Schema == nil {
+		return nil
+	}
+
+	// ignored files are unpinned on purpose
+	ignored := t.datastore.Blocks().List("", -1, "target='ignore-"+index.Id+"'").Items
+	if len(ignored) > 0 {
+		return nil
+	}
+
+	target, err := cid.Parse(index.Target)
+	if err != nil {
+		v.issue(index.Id, pb.ThreadVerifyIssue_MISSING_FILE, err.Error())
+		return nil
+	}
+	node, err := v.verifyFileNode(index.Id, target, true, false)
+	if err != nil || node == nil {
+		return err
+	}
+
+	for _, link := range node.Links() {
+		inode, err := v.verifyFileNode(index.Id, link.Cid, false, false)
+		if err != nil {
+			return err
+		}
+		if inode == nil {
+			continue
+		}
+
+		if len(t.Schema.Links) == 0 {
+			if t.Schema.Pin {
+				if err := v.verifyPinned(index.Id, inode.Cid(), true, pb.ThreadVerifyIssue_UNPINNED_FILE); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		for name, l := range t.Schema.Links {
+			flink := schema.LinkByName(inode.Links(), []string{name})
+			if flink == nil {
+				if l.Optional {
+					continue
+				}
+				v.issue(index.Id, pb.ThreadVerifyIssue_MISSING_FILE,
+					fmt.Sprintf("%s is missing link %s", inode.Cid().String(), name))
+				continue
+			}
+			if _, err := v.verifyFileNode(index.Id, flink.Cid, l.Pin, true); err != nil {
+				return err
+			}
+		}
+		if t.Schema.Pin {
+			if err := v.verifyPinned(index.Id, inode.Cid(), false, pb.ThreadVerifyIssue_UNPINNED_FILE); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// verifyFileNode checks that a files block node is present, and pinned if needed,
+// returning the node if it's available
+func (v *threadVerifier) verifyFileNode(block string, id cid.Cid, pin bool, recursive bool) (ipld.Node, error) {
+	t := v.thread
+
+	has, err := ipfs.HasBlock(t.node(), id)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		issue := v.issue(block, pb.ThreadVerifyIssue_MISSING_FILE, id.String())
+		if !v.repair {
+			return nil, nil
+		}
+		if _, err := ipfs.NodeAtCid(t.node(), id); err != nil {
+			issue.Detail += ": " + err.Error()
+			return nil, nil
+		}
+		issue.Repaired = true
+	}
+
+	node, err := ipfs.NodeAtCid(t.node(), id)
+	if err != nil {
+		return nil, err
+	}
+	if pin {
+		if err := v.verifyPinned(block, id, recursive, pb.ThreadVerifyIssue_UNPINNED_FILE); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// verifyPinned checks that a node is pinned, pinning it with repair
+func (v *threadVerifier) verifyPinned(block string, id cid.Cid, recursive bool, itype pb.ThreadVerifyIssue_Type) error {
+	t := v.thread
+
+	pinned, err := ipfs.IsPinned(t.node(), id)
+	if err != nil {
+		return err
+	}
+	if pinned {
+		return nil
+	}
+
+	issue := v.issue(block, itype, id.String())
+	if !v.repair {
+		return nil
+	}
+	node, err := ipfs.NodeAtCid(t.node(), id)
+	if err != nil {
+		issue.Detail += ": " + err.Error()
+		return nil
+	}
+	if err := ipfs.PinNode(t.node(), node, recursive); err != nil {
+		issue.Detail += ": " + err.Error()
+		return nil
+	}
+	issue.Repaired = true
+	return nil
+}
+
+// verifyOrphans reports indexed blocks that weren't reached from HEAD. With repair,
+// the newest orphans are queued to be merged into HEAD (see mergeOrphans), which also
+// makes their orphaned ancestors reachable. Orphans whose block is gone are removed
+// from the index.
+func (v *threadVerifier) verifyOrphans(reached map[string]struct{}) error {
+	t := v.thread
+
+	query := fmt.Sprintf("threadId='%s'", t.Id)
+	orphans := make(map[string]*pb.Block)
+	issues := make(map[string]*pb.ThreadVerifyIssue)
+	for _, block := range t.datastore.Blocks().List("", -1, query).Items {
+		if _, ok := reached[block.Id]; !ok {
+			orphans[block.Id] = block
+			issues[block.Id] = v.issue(block.Id, pb.ThreadVerifyIssue_ORPHAN_INDEX, block.Type.String())
+		}
+	}
+	if !v.repair || len(orphans) == 0 {
+		return nil
+	}
+
+	for _, id := range orphanHeads(orphans) {
+		bid, err := cid.Decode(id)
+		if err != nil {
+			return err
+		}
+		has, err := ipfs.HasBlock(t.node(), bid)
+		if err != nil {
+			return err
+		}
+		if !has {
+			if err := t.datastore.Blocks().Delete(id); err != nil {
+				return err
+			}
+			if err := addChange(t.datastore, pb.Change_BLOCK_REMOVED, id, t.Id); err != nil {
+				return err
+			}
+			issues[id].Repaired = true
+			continue
+		}
+
+		// ancestors become reachable too
+		var repairs []*pb.ThreadVerifyIssue
+		for _, aid := range orphanAncestors(orphans, id) {
+			repairs = append(repairs, issues[aid])
+		}
+		v.merges = append(v.merges, orphanMerge{
+			id:      id,
+			parents: orphans[id].Parents,
+			issue:   issues[id],
+			repairs: repairs,
+		})
+	}
+	return nil
+}
+
+// handleUnindexed handles the collected unindexed blocks again, oldest first, along
+// with any unindexed ancestors. It must be called without holding the thread lock.
+func (v *threadVerifier) handleUnindexed() {
+	t := v.thread
+
+	for i := len(v.unindexed) - 1; i >= 0; i-- {
+		u := v.unindexed[i]
+		hash, err := mh.FromB58String(u.id)
+		if err != nil {
+			u.issue.Detail += ": " + err.Error()
+			continue
+		}
+		if _, err := t.followParent(hash); err != nil {
+			u.issue.Detail += ": " + err.Error()
+			continue
+		}
+		u.issue.Repaired = true
+	}
+}
+
+// mergeOrphans hands the collected orphans to the thread as new heads, which either
+// fast-forwards HEAD or merges. It must be called without holding the thread lock.
+func (v *threadVerifier) mergeOrphans() {
+	t := v.thread
+
+	for _, m := range v.merges {
+		hash, err := mh.FromB58String(m.id)
+		if err != nil {
+			m.issue.Detail += ": " + err.Error()
+			continue
+		}
+		if _, err := t.handleHead(hash, m.parents); err != nil {
+			m.issue.Detail += ": " + err.Error()
+			continue
+		}
+		for _, issue := range m.repairs {
+			issue.Repaired = true
+		}
+	}
+}
+
+// orphanHeads returns the orphans that aren't a parent of another orphan, sorted
+func orphanHeads(orphans map[string]*pb.Block) []string {
+	parents := make(map[string]struct{})
+	for _, block := range orphans {
+		for _, p := range block.Parents {
+			parents[p] = struct{}{}
+		}
+	}
+
+	var heads []string
+	for id := range orphans {
+		if _, ok := parents[id]; !ok {
+			heads = append(heads, id)
+		}
+	}
+	sort.Strings(heads)
+	return heads
+}
+
+// orphanAncestors returns an orphan and its orphaned ancestors
+func orphanAncestors(orphans map[string]*pb.Block, id string) []string {
+	var list []string
+	seen := make(map[string]struct{})
+	stack := []string{id}
+	for len(stack) > 0 {
+		next := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, ok := seen[next]; ok {
+			continue
+		}
+		seen[next] = struct{}{}
+
+		block, ok := orphans[next]
+		if !ok {
+			continue
+		}
+		list = append(list, next)
+		stack = append(stack, block.Parents...)
+	}
+	return list
+}
+
+// verifyBlockSig checks the payload signature of block types that carry one, i.e.,
+// role grants, role revokes and key rotations. The envelope signature of other
+// blocks is checked on receipt and isn't stored, so it can't be checked here.
+func (t *Thread) verifyBlockSig(block *pb.ThreadBlock) error {
+	switch block.Type {
+	case pb.Block_ROLE_GRANT, pb.Block_ROLE_REVOKE:
+		msg := new(pb.ThreadRole)
+		if err := ptypes.UnmarshalAny(block.Payload, msg); err != nil {
+			return err
+		}
+		data := roleSignedData(t.Id, block.Type, block.Header, msg)
+		if err := verifyBlockSig(block, data, msg.Sig); err != nil {
+			return ErrInvalidRoleSig
+		}
+	case pb.Block_KEY_ROTATE:
+		return t.authorizeKeyRotateBlock(block)
+	}
+	return nil
+}
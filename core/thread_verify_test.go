@@ -0,0 +1,154 @@
+package core
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	libp2pc "github.com/libp2p/go-libp2p-crypto"
+	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/keypair"
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestOrphanAncestors(t *testing.T) {
+	// c and d are orphaned tips, b is shared, and a was reached from HEAD
+	orphans := map[string]*pb.Block{
+		"b": {Id: "b", Parents: []string{"a"}},
+		"c": {Id: "c", Parents: []string{"b"}},
+		"d": {Id: "d", Parents: []string{"b", "x"}},
+		"x": {Id: "x"},
+	}
+
+	list := orphanAncestors(orphans, "c")
+	sort.Strings(list)
+	if strings.Join(list, ",") != "b,c" {
+		t.Errorf("expected b,c, got %s", strings.Join(list, ","))
+	}
+
+	list = orphanAncestors(orphans, "d")
+	sort.Strings(list)
+	if strings.Join(list, ",") != "b,d,x" {
+		t.Errorf("expected b,d,x, got %s", strings.Join(list, ","))
+	}
+
+	if len(orphanAncestors(orphans, "a")) != 0 {
+		t.Error("reached blocks should not be included")
+	}
+}
+
+func TestOrphanHeads(t *testing.T) {
+	// HEAD is a, while c diverged from a with its own history b, and e diverged
+	// from x, which was reached too
+	orphans := map[string]*pb.Block{
+		"b": {Id: "b", Parents: []string{"a"}},
+		"c": {Id: "c", Parents: []string{"b"}},
+		"e": {Id: "e", Parents: []string{"x"}},
+	}
+
+	heads := orphanHeads(orphans)
+	if strings.Join(heads, ",") != "c,e" {
+		t.Errorf("expected c,e, got %s", strings.Join(heads, ","))
+	}
+
+	if len(orphanHeads(map[string]*pb.Block{})) != 0 {
+		t.Error("expected no heads without orphans")
+	}
+}
+
+func TestThread_VerifyRepairDivergentOrphan(t *testing.T) {
+	repoPath := "testdata/.textile_verify"
+	_ = os.RemoveAll(repoPath)
+	defer os.RemoveAll(repoPath)
+
+	if err := InitRepo(InitConfig{
+		Account:  keypair.Random(),
+		RepoPath: repoPath,
+		ApiAddr:  fmt.Sprintf("127.0.0.1:%s", GetRandomPort()),
+	}); err != nil {
+		t.Fatalf("init node failed: %s", err)
+	}
+	node, err := NewTextile(RunConfig{RepoPath: repoPath})
+	if err != nil {
+		t.Fatalf("create node failed: %s", err)
+	}
+	if err := node.Start(); err != nil {
+		t.Fatalf("start node failed: %s", err)
+	}
+	defer node.Stop()
+	<-node.OnlineCh()
+
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thrd, err := node.AddThread(pb.AddThreadConfig{
+		Key:     ksuid.New().String(),
+		Name:    "verify",
+		Type:    pb.Thread_PRIVATE,
+		Sharing: pb.Thread_NOT_SHARED,
+	}, sk, node.Account().Address(), true, true)
+	if err != nil {
+		t.Fatalf("add thread failed: %s", err)
+	}
+
+	base, err := thrd.AddMessage("base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	orphan, err := thrd.AddMessage("orphan")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// rewind HEAD and diverge, so the orphan can't be fast-forwarded to
+	if err := node.datastore.Threads().UpdateHead(thrd.Id, base.B58String()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := thrd.AddMessage("divergent"); err != nil {
+		t.Fatal(err)
+	}
+
+	var report *pb.ThreadVerifyReport
+	done := make(chan struct{})
+	go func() {
+		report, err = thrd.Verify(true)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Minute):
+		t.Fatal("verify with repair did not return")
+	}
+	if err != nil {
+		t.Fatalf("verify failed: %s", err)
+	}
+
+	var found bool
+	for _, issue := range report.Issues {
+		if issue.Type == pb.ThreadVerifyIssue_ORPHAN_INDEX && issue.Block == orphan.B58String() {
+			found = true
+			if !issue.Repaired {
+				t.Errorf("orphan was not merged: %s", issue.Detail)
+			}
+		}
+	}
+	if !found {
+		t.Fatal("orphan was not reported")
+	}
+
+	// the merge makes the orphan reachable from HEAD
+	report, err = thrd.Verify(false)
+	if err != nil {
+		t.Fatalf("verify failed: %s", err)
+	}
+	for _, issue := range report.Issues {
+		if issue.Type == pb.ThreadVerifyIssue_ORPHAN_INDEX {
+			t.Errorf("unexpected orphan after repair: %s", issue.Block)
+		}
+	}
+}
@@ -0,0 +1,283 @@
+package core
+
+import (
+	"container/heap"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+)
+
+// errWalkLimit stops a walk once the step limit is reached
+var errWalkLimit = fmt.Errorf("walk limit reached")
+
+// Walk visits the blocks reachable from a starting block (or HEAD) by following parents.
+// Parents that aren't indexed are visited as missing (or unfetchable) steps, but can't be
+// walked past. Visiting stops at the first error returned by visit.
+//
+// Blocks are loaded as they're walked, newest first. Since a topological walk emits parents
+// first, its steps are found before any are emitted, bounded by the limit and depth.
+func (t *Thread) Walk(opts *pb.WalkOptions, visit func(*pb.WalkStep) error) error {
+	var starts []string
+	if opts.Start != "" {
+		block := t.datastore.Blocks().Get(opts.Start)
+		if block == nil || block.Thread != t.Id {
+			return ErrBlockNotFound
+		}
+		starts = []string{opts.Start}
+	} else {
+		head, err := t.Head()
+		if err != nil {
+			return err
+		}
+		if head == "" {
+			return nil
+		}
+		starts = strings.Split(head, ",")
+	}
+
+	w := newWalker(starts, opts.Depth, func(id string, depth int32) *walkStep {
+		return t.loadWalkStep(id, depth, opts.Fetch)
+	})
+
+	types := make(map[pb.Block_BlockType]struct{})
+	for _, btype := range opts.Types {
+		types[btype] = struct{}{}
+	}
+	matches := func(step *walkStep) bool {
+		if len(types) == 0 || step.Block == nil {
+			return true
+		}
+		_, ok := types[step.Block.Type]
+		return ok
+	}
+
+	var count int32
+	emit := func(step *walkStep) error {
+		if !matches(step) {
+			return nil
+		}
+		if err := visit(step.WalkStep); err != nil {
+			return err
+		}
+		count++
+		if opts.Limit > 0 && count >= opts.Limit {
+			return errWalkLimit
+		}
+		return nil
+	}
+
+	var err error
+	switch opts.Order {
+	case pb.WalkOptions_TOPOLOGICAL:
+		var steps []*walkStep
+		var found int32
+		for step := w.next(); step != nil; step = w.next() {
+			steps = append(steps, step)
+			if matches(step) {
+				found++
+				if opts.Limit > 0 && found >= opts.Limit {
+					break
+				}
+			}
+		}
+		err = walkTopological(steps, emit)
+	default:
+		err = w.walk(emit)
+	}
+	if err == errWalkLimit {
+		return nil
+	}
+	return err
+}
+
+// walkStep is a walk step along with its sort date
+type walkStep struct {
+	*pb.WalkStep
+	date time.Time
+}
+
+// walker walks the steps reachable from a set of starts newest first, loading the
+// parents of a step only once it's walked
+type walker struct {
+	load  func(id string, depth int32) *walkStep
+	depth int32
+	heap  *walkHeap
+	found map[string]*walkStep
+}
+
+// newWalker returns a walker that loads steps with load, walking no deeper than
+// depth if it's positive
+func newWalker(starts []string, depth int32, load func(id string, depth int32) *walkStep) *walker {
+	w := &walker{
+		load:  load,
+		depth: depth,
+		heap:  &walkHeap{newest: true},
+		found: make(map[string]*walkStep),
+	}
+	for _, id := range starts {
+		w.push(id, 0, time.Time{})
+	}
+	return w
+}
+
+// push loads and queues a step if it hasn't been found yet
+func (w *walker) push(id string, depth int32, childDate time.Time) {
+	if step, ok := w.found[id]; ok {
+		// keep the shortest depth
+		if depth < step.Depth {
+			step.Depth = depth
+		}
+		return
+	}
+
+	step := w.load(id, depth)
+	if step.date.IsZero() {
+		// missing steps sort with the child that found them
+		step.date = childDate
+	}
+	w.found[id] = step
+	heap.Push(w.heap, step)
+}
+
+// next returns the newest step not yet walked, queueing its parents, or nil when done
+func (w *walker) next() *walkStep {
+	if w.heap.Len() == 0 {
+		return nil
+	}
+	step := heap.Pop(w.heap).(*walkStep)
+	if step.Status != pb.WalkStep_OK {
+		return step
+	}
+	if w.depth > 0 && step.Depth >= w.depth {
+		return step
+	}
+	for _, p := range step.Block.Parents {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		w.push(p, step.Depth+1, step.date)
+	}
+	return step
+}
+
+// walk emits steps newest first until done, or emit returns an error
+func (w *walker) walk(emit func(*walkStep) error) error {
+	for step := w.next(); step != nil; step = w.next() {
+		if err := emit(step); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadWalkStep returns the step for a block id, checking if it's indexed or rejected
+func (t *Thread) loadWalkStep(id string, depth int32, fetch bool) *walkStep {
+	step := &walkStep{WalkStep: &pb.WalkStep{
+		Id:    id,
+		Depth: depth,
+	}}
+
+	if block := t.datastore.Blocks().Get(id); block != nil {
+		step.Block = block
+		step.date, _ = ptypes.Timestamp(block.Date)
+		return step
+	}
+
+	if rejected := t.datastore.RejectedBlocks().Get(id); rejected != nil {
+		step.Status = pb.WalkStep_REJECTED
+		step.Block = &pb.Block{
+			Id:     rejected.Id,
+			Thread: rejected.Thread,
+			Author: rejected.Author,
+			Type:   rejected.Type,
+			Date:   rejected.Date,
+		}
+		step.date, _ = ptypes.Timestamp(rejected.Date)
+		return step
+	}
+
+	step.Status = pb.WalkStep_MISSING
+	if fetch {
+		if _, err := ipfs.DataAtPath(t.node(), id); err != nil {
+			log.Debugf("unable to fetch %s: %s", id, err)
+			step.Status = pb.WalkStep_UNFETCHABLE
+		}
+	}
+	return step
+}
+
+// walkTopological emits steps so that each comes after all of its walked parents.
+// Steps that are ready at the same time are emitted oldest first.
+func walkTopological(steps []*walkStep, emit func(*walkStep) error) error {
+	walked := make(map[string]struct{})
+	for _, step := range steps {
+		walked[step.Id] = struct{}{}
+	}
+
+	pending := make(map[string]int)
+	children := make(map[string][]*walkStep)
+	h := &walkHeap{}
+	for _, step := range steps {
+		if step.Status == pb.WalkStep_OK {
+			for _, p := range step.Block.Parents {
+				if _, ok := walked[p]; ok {
+					pending[step.Id]++
+					children[p] = append(children[p], step)
+				}
+			}
+		}
+		if pending[step.Id] == 0 {
+			h.steps = append(h.steps, step)
+		}
+	}
+
+	heap.Init(h)
+	for h.Len() > 0 {
+		step := heap.Pop(h).(*walkStep)
+		if err := emit(step); err != nil {
+			return err
+		}
+		for _, child := range children[step.Id] {
+			pending[child.Id]--
+			if pending[child.Id] == 0 {
+				heap.Push(h, child)
+			}
+		}
+	}
+	return nil
+}
+
+// walkHeap orders steps by date, and then by id for stability
+type walkHeap struct {
+	steps  []*walkStep
+	newest bool
+}
+
+func (h *walkHeap) Len() int { return len(h.steps) }
+
+func (h *walkHeap) Less(i, j int) bool {
+	a, b := h.steps[i], h.steps[j]
+	if !a.date.Equal(b.date) {
+		if h.newest {
+			return a.date.After(b.date)
+		}
+		return a.date.Before(b.date)
+	}
+	return a.Id < b.Id
+}
+
+func (h *walkHeap) Swap(i, j int) { h.steps[i], h.steps[j] = h.steps[j], h.steps[i] }
+
+func (h *walkHeap) Push(x interface{}) { h.steps = append(h.steps, x.(*walkStep)) }
+
+func (h *walkHeap) Pop() interface{} {
+	old := h.steps
+	n := len(old)
+	step := old[n-1]
+	h.steps = old[:n-1]
+	return step
+}
@@ -0,0 +1,136 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// newWalkSteps returns steps for a dag where d merges b and c, which both
+// have parent a, and c has a missing parent x
+func newWalkSteps() []*walkStep {
+	now := time.Now()
+	step := func(id string, minutes int, status pb.WalkStep_Status, parents ...string) *walkStep {
+		s := &walkStep{
+			WalkStep: &pb.WalkStep{Id: id, Status: status},
+			date:     now.Add(time.Duration(minutes) * time.Minute),
+		}
+		if status == pb.WalkStep_OK {
+			s.Block = &pb.Block{Id: id, Parents: parents}
+		}
+		return s
+	}
+	return []*walkStep{
+		step("d", 3, pb.WalkStep_OK, "b", "c"),
+		step("b", 2, pb.WalkStep_OK, "a"),
+		step("c", 1, pb.WalkStep_OK, "a", "x"),
+		step("a", 0, pb.WalkStep_OK),
+		step("x", 1, pb.WalkStep_MISSING),
+	}
+}
+
+// newTestWalker returns a walker over newWalkSteps, counting loaded steps
+func newTestWalker(depth int32, loads *int) *walker {
+	steps := make(map[string]*walkStep)
+	for _, step := range newWalkSteps() {
+		steps[step.Id] = step
+	}
+	return newWalker([]string{"d"}, depth, func(id string, depth int32) *walkStep {
+		*loads++
+		step := steps[id]
+		step.Depth = depth
+		return step
+	})
+}
+
+func walkIds(walk func([]*walkStep, func(*walkStep) error) error) ([]string, error) {
+	var ids []string
+	err := walk(newWalkSteps(), func(step *walkStep) error {
+		ids = append(ids, step.Id)
+		return nil
+	})
+	return ids, err
+}
+
+func TestWalker(t *testing.T) {
+	var loads int
+	var ids []string
+	err := newTestWalker(0, &loads).walk(func(step *walkStep) error {
+		ids = append(ids, step.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"d", "b", "c", "x", "a"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+	if loads != 5 {
+		t.Errorf("expected each step to be loaded once, got %d loads", loads)
+	}
+}
+
+func TestWalker_Stop(t *testing.T) {
+	var loads int
+	var count int
+	err := newTestWalker(0, &loads).walk(func(step *walkStep) error {
+		count++
+		if count == 1 {
+			return errWalkLimit
+		}
+		return nil
+	})
+	if err != errWalkLimit || count != 1 {
+		t.Fatalf("expected walk to stop after 1 step, got %d: %v", count, err)
+	}
+	if loads != 3 {
+		t.Errorf("expected only d and its parents to be loaded, got %d loads", loads)
+	}
+}
+
+func TestWalker_Depth(t *testing.T) {
+	var loads int
+	var ids []string
+	err := newTestWalker(1, &loads).walk(func(step *walkStep) error {
+		ids = append(ids, step.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"d", "b", "c"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+	if loads != 3 {
+		t.Errorf("expected steps past the depth to not be loaded, got %d loads", loads)
+	}
+}
+
+func TestWalkTopological(t *testing.T) {
+	ids, err := walkIds(walkTopological)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"a", "x", "c", "b", "d"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+}
+
+func TestWalkStop(t *testing.T) {
+	var count int
+	err := walkTopological(newWalkSteps(), func(step *walkStep) error {
+		count++
+		if count == 2 {
+			return errWalkLimit
+		}
+		return nil
+	})
+	if err != errWalkLimit || count != 2 {
+		t.Errorf("expected walk to stop after 2 steps, got %d: %v", count, err)
+	}
+}
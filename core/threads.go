@@ -127,6 +127,9 @@ func (t *Textile) AddThread(conf pb.AddThreadConfig, sk libp2pc.PrivKey, initiat
 		}
 		return nil, err
 	}
+	if err := addChange(t.datastore, pb.Change_THREAD_ADDED, model.Id, model.Id); err != nil {
+		return nil, err
+	}
 
 	thrd, err := t.loadThread(model)
 	if err != nil {
@@ -174,6 +177,7 @@ func (t *Textile) AddOrUpdateThread(thrd *pb.Thread) error {
 		ttype:     thrd.Type,
 		sharing:   thrd.Sharing,
 		whitelist: thrd.Whitelist,
+		roles:     make(map[string]pb.Thread_Role),
 	}
 	if !dummy.shareable(t.config.Account.Address, t.config.Account.Address) {
 		return ErrNotShareable
@@ -275,6 +279,9 @@ func (t *Textile) RenameThread(id string, name string) error {
 	if err != nil {
 		return err
 	}
+	if err := addChange(t.datastore, pb.Change_THREAD_UPDATED, thrd.Id, thrd.Id); err != nil {
+		return err
+	}
 
 	_, err = thrd.annouce(&pb.ThreadAnnounce{Name: trimmed})
 	return err
@@ -320,6 +327,36 @@ func (t *Textile) ThreadPeers(id string) (*pb.PeerList, error) {
 	return peers, nil
 }
 
+// ThreadRoles returns a list of granted and revoked roles in a thread
+func (t *Textile) ThreadRoles(id string) (*pb.ThreadRoleList, error) {
+	thrd := t.Thread(id)
+	if thrd == nil {
+		return nil, ErrThreadNotFound
+	}
+
+	return thrd.Roles(), nil
+}
+
+// WalkThread visits the blocks in a thread's history, see Thread.Walk
+func (t *Textile) WalkThread(id string, opts *pb.WalkOptions, visit func(*pb.WalkStep) error) error {
+	thrd := t.Thread(id)
+	if thrd == nil {
+		return ErrThreadNotFound
+	}
+
+	return thrd.Walk(opts, visit)
+}
+
+// VerifyThread checks a thread's integrity, optionally repairing it, see Thread.Verify
+func (t *Textile) VerifyThread(id string, repair bool) (*pb.ThreadVerifyReport, error) {
+	thrd := t.Thread(id)
+	if thrd == nil {
+		return nil, ErrThreadNotFound
+	}
+
+	return thrd.Verify(repair)
+}
+
 // RemoveThread removes a thread
 func (t *Textile) RemoveThread(id string) (mh.Multihash, error) {
 	var thrd *Thread
@@ -351,6 +388,9 @@ func (t *Textile) RemoveThread(id string) (mh.Multihash, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := addChange(t.datastore, pb.Change_THREAD_REMOVED, thrd.Id, thrd.Id); err != nil {
+		return nil, err
+	}
 
 	copy(t.loadedThreads[index:], t.loadedThreads[index+1:])
 	t.loadedThreads[len(t.loadedThreads)-1] = nil
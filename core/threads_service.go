@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
@@ -109,6 +110,26 @@ func (h *ThreadsService) Handle(pid peer.ID, env *pb.Envelope) (*pb.Envelope, er
 			log.Debugf("%s exists, aborting", hash.B58String())
 			return nil, nil
 		}
+		if err == ErrBlockRejected {
+			// rejected, abort
+			log.Debugf("%s rejected, aborting", hash.B58String())
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// ancestors may change roles, handle them first
+	parents, err := thrd.followParents(block.Header.Parents)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := thrd.acceptBlock(hash, tenv.Ciphertext, block); err != nil {
+		if err == ErrBlockRejected {
+			// rejected, abort
+			log.Debugf("%s rejected, aborting", hash.B58String())
+			return nil, nil
+		}
 		return nil, err
 	}
 
@@ -147,6 +168,14 @@ func (h *ThreadsService) Handle(pid peer.ID, env *pb.Envelope) (*pb.Envelope, er
 		err = h.handleComment(thrd, hash, block)
 	case pb.Block_LIKE:
 		err = h.handleLike(thrd, hash, block)
+	case pb.Block_REACTION:
+		err = h.handleReaction(thrd, hash, block)
+	case pb.Block_ROLE_GRANT, pb.Block_ROLE_REVOKE:
+		err = h.handleRole(thrd, hash, block)
+	case pb.Block_KEY_ROTATE:
+		_, err = thrd.handleKeyRotateBlock(hash, block)
+	case pb.Block_EDIT:
+		_, err = thrd.handleEditBlock(hash, block)
 	default:
 		return nil, nil
 	}
@@ -154,11 +183,6 @@ func (h *ThreadsService) Handle(pid peer.ID, env *pb.Envelope) (*pb.Envelope, er
 		return nil, err
 	}
 
-	parents, err := thrd.followParents(block.Header.Parents)
-	if err != nil {
-		return nil, err
-	}
-
 	if _, err := thrd.handleHead(hash, parents); err != nil {
 		return nil, err
 	}
@@ -168,6 +192,20 @@ func (h *ThreadsService) Handle(pid peer.ID, env *pb.Envelope) (*pb.Envelope, er
 		return nil, err
 	}
 
+	// the initiator rotates the thread key once an account has left with all its peers
+	if block.Type == pb.Block_LEAVE && !accountPeer &&
+		thrd.initiator == h.service.Account.Address() &&
+		len(thrd.peersWithAddress(block.Header.Address)) == 0 {
+		if _, err := thrd.RotateKey(); err != nil {
+			log.Warningf("error rotating key for %s: %s", thrd.Id, err)
+		}
+	}
+
+	// advertised invites carry the thread keys
+	if block.Type == pb.Block_KEY_ROTATE {
+		thrd.RefreshAdvert()
+	}
+
 	// we may be auto-leaving
 	if leave {
 		if _, err := h.removeThread(thrd.Id); err != nil {
@@ -327,7 +365,13 @@ func (h *ThreadsService) handleMessage(thrd *Thread, hash mh.Multihash, block *p
 		return err
 	}
 
-	note := h.newNotification(block.Header, pb.Notification_MESSAGE_ADDED)
+	// mentions bypass mute, so they're parsed from the body rather than trusted
+	ntype := pb.Notification_MESSAGE_ADDED
+	if mentioned(parseMentions(msg.Body), h.service.Account.Address()) {
+		ntype = pb.Notification_MENTIONED
+	}
+
+	note := h.newNotification(block.Header, ntype)
 	note.Body = msg.Body
 	note.Block = hash.B58String()
 	note.SubjectDesc = thrd.Name
@@ -373,6 +417,10 @@ func (h *ThreadsService) handleComment(thrd *Thread, hash mh.Multihash, block *p
 
 	note := h.newNotification(block.Header, pb.Notification_COMMENT_ADDED)
 	note.Body = fmt.Sprintf("commented on %s: \"%s\"", desc, msg.Body)
+	if mentioned(parseMentions(msg.Body), h.service.Account.Address()) {
+		note.Type = pb.Notification_MENTIONED
+		note.Body = fmt.Sprintf("mentioned you in a comment on %s: \"%s\"", desc, msg.Body)
+	}
 	note.Block = hash.B58String()
 	note.Target = target.Target
 	note.SubjectDesc = thrd.Name
@@ -409,6 +457,70 @@ func (h *ThreadsService) handleLike(thrd *Thread, hash mh.Multihash, block *pb.T
 	return h.sendNotification(note)
 }
 
+// handleReaction receives a reaction message
+func (h *ThreadsService) handleReaction(thrd *Thread, hash mh.Multihash, block *pb.ThreadBlock) error {
+	msg, err := thrd.handleReactionBlock(hash, block)
+	if err != nil {
+		return err
+	}
+
+	target := h.datastore.Blocks().Get(msg.Target)
+	if target == nil {
+		return nil
+	}
+	var desc string
+	if target.Author == h.service.Node().Identity.Pretty() {
+		desc = "your " + threadSubject(thrd.Schema.Name)
+	} else {
+		desc = "a " + threadSubject(thrd.Schema.Name)
+	}
+
+	note := h.newNotification(block.Header, pb.Notification_REACTION_ADDED)
+	note.Body = "reacted " + msg.Reaction + " to " + desc
+	note.Block = hash.B58String()
+	note.Target = target.Target
+	note.SubjectDesc = thrd.Name
+	note.Subject = thrd.Id
+
+	return h.sendNotification(note)
+}
+
+// handleRole receives a role grant or revoke message
+func (h *ThreadsService) handleRole(thrd *Thread, hash mh.Multihash, block *pb.ThreadBlock) error {
+	msg, err := thrd.handleRoleBlock(hash, block)
+	if err != nil {
+		return err
+	}
+
+	// only notify the account whose role changed
+	if msg.Address != h.service.Account.Address() {
+		return nil
+	}
+
+	note := h.newNotification(block.Header, pb.Notification_ROLE_CHANGED)
+	if block.Type == pb.Block_ROLE_REVOKE {
+		note.Body = "revoked your access"
+	} else {
+		note.Body = "changed your role to " + strings.ToLower(msg.Role.String())
+	}
+	note.Block = hash.B58String()
+	note.SubjectDesc = thrd.Name
+	note.Subject = thrd.Id
+
+	return h.sendNotification(note)
+}
+
+// handleRejected notifies the account of a block rejected by thread policy
+func (h *ThreadsService) handleRejected(thrd *Thread, hash mh.Multihash, block *pb.ThreadBlock, reason error) error {
+	note := h.newNotification(block.Header, pb.Notification_BLOCK_REJECTED)
+	note.Body = fmt.Sprintf("%s was rejected: %s", strings.ToLower(block.Type.String()), reason)
+	note.Block = hash.B58String()
+	note.SubjectDesc = thrd.Name
+	note.Subject = thrd.Id
+
+	return h.sendNotification(note)
+}
+
 // newNotification returns new thread notification
 func (h *ThreadsService) newNotification(header *pb.ThreadBlockHeader, ntype pb.Notification_Type) *pb.Notification {
 	return &pb.Notification{
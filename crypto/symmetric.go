@@ -34,10 +34,17 @@ func EncryptAES(bytes []byte, key []byte) ([]byte, error) {
 }
 
 // DecryptAES uses key (:32 key, 32:12 nonce) to perform AES-256 GCM decryption on bytes.
+// Bytes in AES stream format are also accepted.
 func DecryptAES(bytes []byte, key []byte) ([]byte, error) {
 	if len(key) != 44 {
 		return nil, fmt.Errorf("invalid key")
 	}
+	if IsAESStream(bytes) {
+		if plain, err := decryptAESStreamBytes(bytes, key); err == nil {
+			return plain, nil
+		}
+		// fallback to the legacy format on the off chance the header is a coincidence
+	}
 	block, err := aes.NewCipher(key[:32])
 	if err != nil {
 		return nil, err
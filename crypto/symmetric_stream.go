@@ -0,0 +1,408 @@
+package crypto
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// AES streams are a segmented form of AES-256 GCM, which allows large inputs to be
+// encrypted and decrypted with bounded memory.
+//
+// Format:
+//   header:   magic (4) | version (1) | reserved (3) | chunk size (4) | reserved (4)
+//   segments: GCM sealed plaintext chunks, each chunk size + 16 bytes, except the last
+//
+// Each segment's nonce is the key's nonce with the segment counter (+1) XOR'd into its
+// last 8 bytes. The header, counter, and a final segment flag are authenticated as
+// additional data, so segments can't be reordered, truncated, or extended.
+
+// AESStreamVersion is the current AES stream format version
+const AESStreamVersion = 1
+
+// AESStreamChunkSize is the default plaintext size of an AES stream segment
+const AESStreamChunkSize = 64 * 1024
+
+// AESStreamHeaderLen is the length of an AES stream header
+const AESStreamHeaderLen = 16
+
+// aesStreamMagic starts every AES stream
+var aesStreamMagic = []byte("txas")
+
+// ErrAESStreamInvalid indicates the input is not a valid AES stream
+var ErrAESStreamInvalid = fmt.Errorf("invalid aes stream")
+
+// EncryptAESStream returns a reader of src's contents encrypted with key in
+// AES stream format
+func EncryptAESStream(src io.Reader, key []byte) (io.Reader, error) {
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &aesStreamEncrypter{
+		src:    bufio.NewReader(src),
+		aead:   aead,
+		nonce:  key[32:],
+		header: newAESStreamHeader(AESStreamChunkSize),
+		chunk:  make([]byte, AESStreamChunkSize),
+	}, nil
+}
+
+// DecryptAESStream returns a reader of src's contents, in AES stream format,
+// decrypted with key
+func DecryptAESStream(src io.Reader, key []byte) (io.Reader, error) {
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, AESStreamHeaderLen)
+	if _, err := io.ReadFull(src, header); err != nil {
+		return nil, ErrAESStreamInvalid
+	}
+	size, err := parseAESStreamHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aesStreamDecrypter{
+		src:    bufio.NewReader(src),
+		aead:   aead,
+		nonce:  key[32:],
+		header: header,
+		chunk:  make([]byte, size+aead.Overhead()),
+	}, nil
+}
+
+// DecryptAESStreamSeeker returns a seekable reader of src's contents, in AES stream format,
+// decrypted with key. Only the segments covering a read are decrypted.
+func DecryptAESStreamSeeker(src io.ReadSeeker, key []byte) (io.ReadSeeker, error) {
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := src.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := src.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, AESStreamHeaderLen)
+	if _, err := io.ReadFull(src, header); err != nil {
+		return nil, ErrAESStreamInvalid
+	}
+	size, err := parseAESStreamHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	// every stream has at least one, possibly empty, segment
+	body := total - AESStreamHeaderLen
+	segSize := int64(size + aead.Overhead())
+	segs := (body + segSize - 1) / segSize
+	if segs == 0 || body-(segs-1)*segSize < int64(aead.Overhead()) {
+		return nil, ErrAESStreamInvalid
+	}
+
+	return &aesStreamSeeker{
+		src:     src,
+		aead:    aead,
+		nonce:   key[32:],
+		header:  header,
+		chunk:   make([]byte, segSize),
+		size:    int64(size),
+		segs:    segs,
+		length:  body - segs*int64(aead.Overhead()),
+		current: -1,
+	}, nil
+}
+
+// DecryptAESReader returns a seekable reader of src's contents decrypted with key.
+// AES stream format is decrypted lazily, while the legacy format is decrypted in memory.
+func DecryptAESReader(src io.ReadSeeker, key []byte) (io.ReadSeeker, error) {
+	header := make([]byte, AESStreamHeaderLen)
+	n, err := io.ReadFull(src, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	if IsAESStream(header[:n]) {
+		return DecryptAESStreamSeeker(src, key)
+	}
+
+	if _, err := src.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(src); err != nil {
+		return nil, err
+	}
+	plain, err := DecryptAES(buf.Bytes(), key)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(plain), nil
+}
+
+// IsAESStream returns whether or not data starts with an AES stream header
+func IsAESStream(data []byte) bool {
+	if len(data) < AESStreamHeaderLen {
+		return false
+	}
+	_, err := parseAESStreamHeader(data[:AESStreamHeaderLen])
+	return err == nil
+}
+
+type aesStreamEncrypter struct {
+	src     *bufio.Reader
+	aead    cipher.AEAD
+	nonce   []byte
+	header  []byte
+	chunk   []byte
+	counter uint64
+	out     bytes.Buffer
+	started bool
+	done    bool
+}
+
+func (e *aesStreamEncrypter) Read(p []byte) (int, error) {
+	for e.out.Len() == 0 {
+		if e.done {
+			return 0, io.EOF
+		}
+		if err := e.next(); err != nil {
+			return 0, err
+		}
+	}
+	return e.out.Read(p)
+}
+
+// next seals the next chunk into the output buffer
+func (e *aesStreamEncrypter) next() error {
+	if !e.started {
+		e.out.Write(e.header)
+		e.started = true
+		return nil
+	}
+
+	n, err := io.ReadFull(e.src, e.chunk)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	final := err != nil
+	if !final {
+		if _, err := e.src.Peek(1); err == io.EOF {
+			final = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	seg := e.aead.Seal(nil, aesStreamNonce(e.nonce, e.counter),
+		e.chunk[:n], aesStreamAD(e.header, e.counter, final))
+	e.out.Write(seg)
+	e.counter++
+	e.done = final
+	return nil
+}
+
+type aesStreamDecrypter struct {
+	src     *bufio.Reader
+	aead    cipher.AEAD
+	nonce   []byte
+	header  []byte
+	chunk   []byte
+	counter uint64
+	out     []byte
+	done    bool
+}
+
+func (d *aesStreamDecrypter) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+// next opens the next segment into the output buffer
+func (d *aesStreamDecrypter) next() error {
+	n, err := io.ReadFull(d.src, d.chunk)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF // missing final segment
+		}
+		return err
+	}
+	final := err != nil
+	if !final {
+		if _, err := d.src.Peek(1); err == io.EOF {
+			final = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	plain, err := d.aead.Open(d.chunk[:0], aesStreamNonce(d.nonce, d.counter),
+		d.chunk[:n], aesStreamAD(d.header, d.counter, final))
+	if err != nil {
+		return err
+	}
+	d.out = plain
+	d.counter++
+	d.done = final
+	return nil
+}
+
+type aesStreamSeeker struct {
+	src     io.ReadSeeker
+	aead    cipher.AEAD
+	nonce   []byte
+	header  []byte
+	chunk   []byte
+	size    int64 // plaintext segment size
+	segs    int64
+	length  int64 // plaintext length
+	pos     int64
+	current int64 // index of the segment in plain
+	plain   []byte
+}
+
+func (s *aesStreamSeeker) Read(p []byte) (int, error) {
+	if s.pos >= s.length {
+		return 0, io.EOF
+	}
+	seg := s.pos / s.size
+	if seg != s.current {
+		if err := s.load(seg); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, s.plain[s.pos-seg*s.size:])
+	s.pos += int64(n)
+	return n, nil
+}
+
+func (s *aesStreamSeeker) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = s.pos + offset
+	case io.SeekEnd:
+		pos = s.length + offset
+	default:
+		return 0, fmt.Errorf("invalid whence")
+	}
+	if pos < 0 {
+		return 0, fmt.Errorf("negative position")
+	}
+	s.pos = pos
+	return pos, nil
+}
+
+// load reads and opens a single segment
+func (s *aesStreamSeeker) load(seg int64) error {
+	segSize := int64(len(s.chunk))
+	if _, err := s.src.Seek(AESStreamHeaderLen+seg*segSize, io.SeekStart); err != nil {
+		return err
+	}
+	n, err := io.ReadFull(s.src, s.chunk)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	final := seg == s.segs-1
+	plain, err := s.aead.Open(s.chunk[:0], aesStreamNonce(s.nonce, uint64(seg)),
+		s.chunk[:n], aesStreamAD(s.header, uint64(seg), final))
+	if err != nil {
+		s.current = -1
+		return err
+	}
+	s.plain = plain
+	s.current = seg
+	return nil
+}
+
+// decryptAESStreamBytes decrypts a whole AES stream in memory
+func decryptAESStreamBytes(data []byte, key []byte) ([]byte, error) {
+	reader, err := DecryptAESStream(bytes.NewReader(data), key)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(reader); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// newAESGCM returns a GCM cipher for the key part of a 44 byte key
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != 44 {
+		return nil, fmt.Errorf("invalid key")
+	}
+	block, err := aes.NewCipher(key[:32])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// newAESStreamHeader returns a header for the current version
+func newAESStreamHeader(chunkSize uint32) []byte {
+	header := make([]byte, AESStreamHeaderLen)
+	copy(header, aesStreamMagic)
+	header[4] = AESStreamVersion
+	binary.BigEndian.PutUint32(header[8:], chunkSize)
+	return header
+}
+
+// parseAESStreamHeader validates a header, returning its chunk size
+func parseAESStreamHeader(header []byte) (int, error) {
+	if !bytes.Equal(header[:4], aesStreamMagic) || header[4] != AESStreamVersion {
+		return 0, ErrAESStreamInvalid
+	}
+	size := binary.BigEndian.Uint32(header[8:])
+	if size == 0 || size > 1<<24 {
+		return 0, ErrAESStreamInvalid
+	}
+	return int(size), nil
+}
+
+// aesStreamNonce derives a segment nonce, starting at one so as not
+// to collide with the key's plain nonce
+func aesStreamNonce(base []byte, counter uint64) []byte {
+	nonce := make([]byte, len(base))
+	copy(nonce, base)
+	var ctr [8]byte
+	binary.BigEndian.PutUint64(ctr[:], counter+1)
+	for i := range ctr {
+		nonce[len(nonce)-8+i] ^= ctr[i]
+	}
+	return nonce
+}
+
+// aesStreamAD returns the additional data authenticated with a segment
+func aesStreamAD(header []byte, counter uint64, final bool) []byte {
+	ad := make([]byte, len(header)+9)
+	copy(ad, header)
+	binary.BigEndian.PutUint64(ad[len(header):], counter)
+	if final {
+		ad[len(ad)-1] = 1
+	}
+	return ad
+}
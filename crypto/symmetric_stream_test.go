@@ -0,0 +1,166 @@
+package crypto_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	. "github.com/textileio/go-textile/crypto"
+)
+
+var streamTestData = struct {
+	plaintext  []byte
+	key        []byte
+	ciphertext []byte
+}{}
+
+func TestEncryptAESStream(t *testing.T) {
+	streamTestData.plaintext = make([]byte, AESStreamChunkSize*2+512)
+	if _, err := rand.Read(streamTestData.plaintext); err != nil {
+		t.Fatal(err)
+	}
+	key, err := GenerateAESKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	streamTestData.key = key
+
+	reader, err := EncryptAESStream(bytes.NewReader(streamTestData.plaintext), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsAESStream(ciphertext) {
+		t.Error("encrypt AES stream produced bad header")
+	}
+	streamTestData.ciphertext = ciphertext
+}
+
+func TestDecryptAESStream(t *testing.T) {
+	reader, err := DecryptAESStream(bytes.NewReader(streamTestData.ciphertext), streamTestData.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(streamTestData.plaintext, plaintext) {
+		t.Error("decrypt AES stream failed")
+	}
+
+	key, err := GenerateAESKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err = DecryptAESStream(bytes.NewReader(streamTestData.ciphertext), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(reader); err == nil {
+		t.Error("decrypt AES stream with bad key succeeded")
+	}
+}
+
+func TestDecryptAESStreamTruncated(t *testing.T) {
+	truncated := streamTestData.ciphertext[:16+AESStreamChunkSize+16]
+	reader, err := DecryptAESStream(bytes.NewReader(truncated), streamTestData.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(reader); err == nil {
+		t.Error("decrypt truncated AES stream succeeded")
+	}
+}
+
+func TestAESStreamEmpty(t *testing.T) {
+	reader, err := EncryptAESStream(bytes.NewReader(nil), streamTestData.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err = DecryptAESStream(bytes.NewReader(ciphertext), streamTestData.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plaintext) != 0 {
+		t.Error("decrypt empty AES stream failed")
+	}
+}
+
+func TestDecryptAESStreamSeeker(t *testing.T) {
+	reader, err := DecryptAESStreamSeeker(bytes.NewReader(streamTestData.ciphertext), streamTestData.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	end, err := reader.Seek(0, io.SeekEnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if end != int64(len(streamTestData.plaintext)) {
+		t.Errorf("wrong plaintext length: %d", end)
+	}
+
+	// read across a segment boundary
+	start := int64(AESStreamChunkSize - 100)
+	if _, err := reader.Seek(start, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	part := make([]byte, 200)
+	if _, err := io.ReadFull(reader, part); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(streamTestData.plaintext[start:start+200], part) {
+		t.Error("decrypt AES stream range failed")
+	}
+
+	// read the tail
+	if _, err := reader.Seek(-10, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+	tail, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(streamTestData.plaintext[len(streamTestData.plaintext)-10:], tail) {
+		t.Error("decrypt AES stream tail failed")
+	}
+}
+
+func TestDecryptAESLegacyAndStream(t *testing.T) {
+	plaintext, err := DecryptAES(streamTestData.ciphertext, streamTestData.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(streamTestData.plaintext, plaintext) {
+		t.Error("decrypt AES of stream format failed")
+	}
+
+	ciphertext, err := EncryptAES(streamTestData.plaintext, streamTestData.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if IsAESStream(ciphertext) {
+		t.Error("legacy ciphertext detected as stream")
+	}
+	plaintext, err = DecryptAES(ciphertext, streamTestData.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(streamTestData.plaintext, plaintext) {
+		t.Error("decrypt AES of legacy format failed")
+	}
+}
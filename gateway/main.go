@@ -5,7 +5,9 @@ import (
 	"encoding/base64"
 	"fmt"
 	"html/template"
+	"io"
 	"net/http"
+	"path"
 	"strings"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/render"
 	"github.com/golang/protobuf/jsonpb"
+	files "github.com/ipfs/go-ipfs-files"
 	ipld "github.com/ipfs/go-ipld-format"
 	logging "github.com/ipfs/go-log"
 	ipfspath "github.com/ipfs/go-path"
@@ -77,8 +80,12 @@ func (g *Gateway) Start(addr string) {
 
 	router.GET("/ipfs/:root", g.ipfsHandler)
 	router.GET("/ipfs/:root/*path", g.ipfsHandler)
+	router.HEAD("/ipfs/:root", g.ipfsHandler)
+	router.HEAD("/ipfs/:root/*path", g.ipfsHandler)
 	router.GET("/ipns/:root", g.ipnsHandler)
 	router.GET("/ipns/:root/*path", g.ipnsHandler)
+	router.HEAD("/ipns/:root", g.ipnsHandler)
+	router.HEAD("/ipns/:root/*path", g.ipnsHandler)
 
 	router.GET("/cafe", g.cafeHandler)
 	router.GET("/cafes", g.cafesHandler)
@@ -134,12 +141,14 @@ func (g *Gateway) Addr() string {
 func (g *Gateway) ipfsHandler(c *gin.Context) {
 	contentPath := c.Param("root") + c.Param("path")
 
-	data := g.getDataAtPath(c, contentPath)
-	if data == nil {
+	file := g.getFileAtPath(c, contentPath)
+	if file == nil {
 		return
 	}
+	defer file.Close()
 
 	// attempt decrypt if key present
+	var content io.ReadSeeker = file
 	key, exists := c.GetQuery("key")
 	if exists {
 		keyb, err := base58.Decode(key)
@@ -148,17 +157,15 @@ func (g *Gateway) ipfsHandler(c *gin.Context) {
 			render404(c)
 			return
 		}
-		plain, err := crypto.DecryptAES(data, keyb)
+		content, err = crypto.DecryptAESReader(file, keyb)
 		if err != nil {
 			log.Debugf("error decrypting %s: %s", contentPath, err)
 			render404(c)
 			return
 		}
-		c.Render(200, render.Data{Data: plain})
-		return
 	}
 
-	c.Render(200, render.Data{Data: data})
+	g.serveContent(c, contentPath, content)
 }
 
 // ipnsHandler renders data behind an IPNS address
@@ -182,12 +189,28 @@ func (g *Gateway) ipnsHandler(c *gin.Context) {
 		return
 	}
 
-	data := g.getDataAtPath(c, pth.String()+pathp)
-	if data == nil {
+	contentPath := pth.String() + pathp
+	file := g.getFileAtPath(c, contentPath)
+	if file == nil {
+		return
+	}
+	defer file.Close()
+
+	g.serveContent(c, contentPath, file)
+}
+
+// serveContent writes content with support for range and conditional requests,
+// using the resolved cid as the etag
+func (g *Gateway) serveContent(c *gin.Context, pth string, content io.ReadSeeker) {
+	id, err := g.Node.ResolvePath(pth)
+	if err != nil {
+		log.Debugf("error resolving path %s: %s", pth, err)
+		render404(c)
 		return
 	}
+	c.Header("ETag", `"`+id+`"`)
 
-	c.Render(200, render.Data{Data: data})
+	http.ServeContent(c.Writer, c.Request, path.Base(pth), time.Time{}, content)
 }
 
 // cafeHandler returns this peer's cafe info
@@ -234,9 +257,9 @@ type link struct {
 	Size string
 }
 
-// getDataAtPath get raw data or directory links at path
-func (g *Gateway) getDataAtPath(c *gin.Context, pth string) []byte {
-	data, err := g.Node.DataAtPath(pth)
+// getFileAtPath get a file reader or renders directory links at path
+func (g *Gateway) getFileAtPath(c *gin.Context, pth string) files.File {
+	file, err := g.Node.FileAtPath(pth)
 	if err != nil {
 		if err == iface.ErrIsDir {
 			root, err := ipfspath.ParsePath(pth)
@@ -290,7 +313,7 @@ func (g *Gateway) getDataAtPath(c *gin.Context, pth string) []byte {
 		render404(c)
 		return nil
 	}
-	return data
+	return file
 }
 
 // render404 renders the 404 template
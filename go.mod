@@ -21,6 +21,8 @@ require (
 	github.com/go-openapi/swag v0.19.0 // indirect
 	github.com/gogo/protobuf v1.2.1
 	github.com/golang/protobuf v1.3.1
+	github.com/gorilla/websocket v1.4.0
+	github.com/ipfs/go-block-format v0.0.2
 	github.com/ipfs/go-cid v0.0.2
 	github.com/ipfs/go-ipfs v0.4.21-rc3
 	github.com/ipfs/go-ipfs-addr v0.0.1
@@ -3,11 +3,13 @@ package ipfs
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"strings"
 	"time"
 
+	blocks "github.com/ipfs/go-block-format"
 	cid "github.com/ipfs/go-cid"
 	files "github.com/ipfs/go-ipfs-files"
 	"github.com/ipfs/go-ipfs/core"
@@ -24,6 +26,9 @@ import (
 
 var log = logging.Logger("tex-ipfs")
 
+// ErrBlockMismatch indicates block data does not hash to its cid
+var ErrBlockMismatch = fmt.Errorf("block data does not match cid")
+
 const pinTimeout = time.Minute
 const catTimeout = time.Minute
 const connectTimeout = time.Second * 10
@@ -57,6 +62,73 @@ func DataAtPath(node *core.IpfsNode, pth string) ([]byte, error) {
 	return ioutil.ReadAll(file)
 }
 
+// FileAtPath returns a seekable reader of the file under an ipfs path.
+// Unlike DataAtPath, the file is read lazily, so the reader must be closed.
+// Finding the file is bound by catTimeout, after which reads are only
+// canceled by closing the reader.
+func FileAtPath(node *core.IpfsNode, pth string) (files.File, error) {
+	api, err := coreapi.NewCoreAPI(node)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(node.Context())
+	timer := time.AfterFunc(catTimeout, cancel)
+	f, err := api.Unixfs().Get(ctx, path.New(pth))
+	if !timer.Stop() {
+		// timed out, the file may have been found just as it fired
+		if err == nil {
+			_ = f.Close()
+		}
+		return nil, context.DeadlineExceeded
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	switch f := f.(type) {
+	case files.File:
+		return &cancelFile{File: f, cancel: cancel}, nil
+	case files.Directory:
+		_ = f.Close()
+		cancel()
+		return nil, iface.ErrIsDir
+	default:
+		_ = f.Close()
+		cancel()
+		return nil, iface.ErrNotSupported
+	}
+}
+
+// ResolvePath returns the cid of the last node under path
+func ResolvePath(node *core.IpfsNode, pth string) (cid.Cid, error) {
+	api, err := coreapi.NewCoreAPI(node)
+	if err != nil {
+		return cid.Cid{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(node.Context(), catTimeout)
+	defer cancel()
+
+	res, err := api.ResolvePath(ctx, path.New(pth))
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	return res.Cid(), nil
+}
+
+// cancelFile cancels the context of a lazily read file on close
+type cancelFile struct {
+	files.File
+	cancel context.CancelFunc
+}
+
+func (f *cancelFile) Close() error {
+	defer f.cancel()
+	return f.File.Close()
+}
+
 // LinksAtPath return ipld links under a path
 func LinksAtPath(node *core.IpfsNode, pth string) ([]*ipld.Link, error) {
 	api, err := coreapi.NewCoreAPI(node)
@@ -159,6 +231,32 @@ func AddData(node *core.IpfsNode, reader io.Reader, pin bool) (*cid.Cid, error)
 	return &id, nil
 }
 
+// AddDataStream takes a reader of any size and adds it, optionally pins it.
+// Unlike AddData, adding is not bound by a timeout, since large local inputs take
+// as long as they take. It's canceled when the node stops.
+func AddDataStream(node *core.IpfsNode, reader io.Reader, pin bool) (*cid.Cid, error) {
+	api, err := coreapi.NewCoreAPI(node)
+	if err != nil {
+		return nil, err
+	}
+
+	pth, err := api.Unixfs().Add(node.Context(), files.NewReaderFile(reader))
+	if err != nil {
+		return nil, err
+	}
+
+	if pin {
+		ctx, cancel := context.WithTimeout(node.Context(), pinTimeout)
+		defer cancel()
+		if err := api.Pin().Add(ctx, pth, options.Pin.Recursive(false)); err != nil {
+			return nil, err
+		}
+	}
+	id := pth.Cid()
+
+	return &id, nil
+}
+
 // AddObject takes a reader and adds it as a DAG node, optionally pins it
 func AddObject(node *core.IpfsNode, reader io.Reader, pin bool) (*cid.Cid, error) {
 	api, err := coreapi.NewCoreAPI(node)
@@ -221,6 +319,67 @@ func NodeAtPath(node *core.IpfsNode, pth string) (ipld.Node, error) {
 	return api.ResolveNode(ctx, path.New(pth))
 }
 
+// DagCids returns the cids of each node in the dag under a cid, parents first.
+// Nodes in seen are skipped along with their children, and visited nodes are added to it.
+func DagCids(node *core.IpfsNode, id cid.Cid, seen map[string]struct{}) ([]cid.Cid, error) {
+	if _, ok := seen[id.String()]; ok {
+		return nil, nil
+	}
+	seen[id.String()] = struct{}{}
+
+	nd, err := NodeAtCid(node, id)
+	if err != nil {
+		return nil, err
+	}
+
+	list := []cid.Cid{id}
+	for _, link := range nd.Links() {
+		ids, err := DagCids(node, link.Cid, seen)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, ids...)
+	}
+	return list, nil
+}
+
+// BlockAtCid returns the raw data of the block behind a cid
+func BlockAtCid(node *core.IpfsNode, id cid.Cid) ([]byte, error) {
+	nd, err := NodeAtCid(node, id)
+	if err != nil {
+		return nil, err
+	}
+	return nd.RawData(), nil
+}
+
+// PutBlock adds raw block data to the local blockstore, checking that it matches its cid
+func PutBlock(node *core.IpfsNode, id cid.Cid, data []byte) error {
+	sum, err := id.Prefix().Sum(data)
+	if err != nil {
+		return err
+	}
+	if !sum.Equals(id) {
+		return ErrBlockMismatch
+	}
+
+	blk, err := blocks.NewBlockWithCid(data, id)
+	if err != nil {
+		return err
+	}
+	return node.Blocks.AddBlock(blk)
+}
+
+// HasBlock returns whether or not a block is in the local blockstore
+func HasBlock(node *core.IpfsNode, id cid.Cid) (bool, error) {
+	return node.Blockstore.Has(id)
+}
+
+// IsPinned returns whether or not a cid is pinned, either directly or by an ancestor
+func IsPinned(node *core.IpfsNode, id cid.Cid) (bool, error) {
+	_, pinned, err := node.Pinning.IsPinned(id)
+	return pinned, err
+}
+
 type Node struct {
 	Links []Link
 	Data  string
@@ -303,6 +462,19 @@ func PinNode(node *core.IpfsNode, nd ipld.Node, recursive bool) error {
 	return node.Pinning.Flush()
 }
 
+// PinCid pins a cid, fetching its content from the network if needed
+func PinCid(node *core.IpfsNode, id cid.Cid, recursive bool) error {
+	api, err := coreapi.NewCoreAPI(node)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(node.Context(), pinTimeout)
+	defer cancel()
+
+	return api.Pin().Add(ctx, path.New("/ipfs/"+id.String()), options.Pin.Recursive(recursive))
+}
+
 // UnpinNode unpins an ipld node
 func UnpinNode(node *core.IpfsNode, nd ipld.Node, recursive bool) error {
 	return UnpinCid(node, nd.Cid(), recursive)
@@ -1,5 +1,7 @@
 package mill
 
+import "io"
+
 type Blob struct{}
 
 func (m *Blob) ID() string {
@@ -25,3 +27,7 @@ func (m *Blob) Options(add map[string]interface{}) (string, error) {
 func (m *Blob) Mill(input []byte, name string) (*Result, error) {
 	return &Result{File: input}, nil
 }
+
+func (m *Blob) MillStream(input io.Reader, name string) (*StreamResult, error) {
+	return &StreamResult{File: input}, nil
+}
@@ -1,7 +1,10 @@
 package mill
 
 import (
+	"bufio"
 	"encoding/json"
+	"fmt"
+	"io"
 )
 
 type Json struct{}
@@ -43,3 +46,85 @@ func (m *Json) Mill(input []byte, name string) (*Result, error) {
 
 	return &Result{File: data}, nil
 }
+
+// MillStream validates and compacts json of any size token by token.
+// Unlike Mill, object keys keep their input order.
+func (m *Json) MillStream(input io.Reader, name string) (*StreamResult, error) {
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(compactJson(input, writer))
+	}()
+	return &StreamResult{File: reader}, nil
+}
+
+// compactJson re-encodes a single json value without whitespace,
+// failing if the input is not valid json
+func compactJson(input io.Reader, output io.Writer) error {
+	dec := json.NewDecoder(input)
+	dec.UseNumber()
+	w := bufio.NewWriter(output)
+
+	// open containers and the number of keys + values written to each
+	var objects []bool
+	var counts []int
+	var done bool
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			if !done {
+				return io.ErrUnexpectedEOF
+			}
+			return w.Flush()
+		}
+		if err != nil {
+			return err
+		}
+		if done {
+			return fmt.Errorf("invalid json: unexpected data after top-level value")
+		}
+
+		if d, ok := tok.(json.Delim); ok && (d == '}' || d == ']') {
+			w.WriteRune(rune(d))
+			objects = objects[:len(objects)-1]
+			counts = counts[:len(counts)-1]
+			done = len(objects) == 0
+			continue
+		}
+
+		if n := len(counts); n > 0 {
+			if counts[n-1] > 0 {
+				if objects[n-1] && counts[n-1]%2 == 1 {
+					w.WriteByte(':')
+				} else {
+					w.WriteByte(',')
+				}
+			}
+			counts[n-1]++
+		}
+
+		switch v := tok.(type) {
+		case json.Delim:
+			w.WriteRune(rune(v))
+			objects = append(objects, v == '{')
+			counts = append(counts, 0)
+			continue
+		case string:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			w.Write(data)
+		case json.Number:
+			w.WriteString(v.String())
+		case bool:
+			if v {
+				w.WriteString("true")
+			} else {
+				w.WriteString("false")
+			}
+		case nil:
+			w.WriteString("null")
+		}
+		done = len(objects) == 0
+	}
+}
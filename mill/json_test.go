@@ -1,6 +1,8 @@
 package mill
 
 import (
+	"io/ioutil"
+	"strings"
 	"testing"
 )
 
@@ -13,3 +15,34 @@ func TestJson_Mill(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestJson_MillStream(t *testing.T) {
+	m := &Json{}
+
+	obj := `{"firstName": "Grigori", "lastName": "Rasputin", "age": 47.0,
+  "aliases": ["The Mad Monk", {"nested": [true, false, null]}], "empty": {}}`
+
+	res, err := m.MillStream(strings.NewReader(obj), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	output, err := ioutil.ReadAll(res.File)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"firstName":"Grigori","lastName":"Rasputin","age":47.0,` +
+		`"aliases":["The Mad Monk",{"nested":[true,false,null]}],"empty":{}}`
+	if string(output) != expected {
+		t.Errorf("wrong output: %s", output)
+	}
+
+	for _, bad := range []string{"", `{"age": }`, `{"age": 47`, `{} {}`} {
+		res, err := m.MillStream(strings.NewReader(bad), "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ioutil.ReadAll(res.File); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"io"
 
 	logging "github.com/ipfs/go-log"
 	"github.com/mr-tron/base58/base58"
@@ -13,6 +14,16 @@ var log = logging.Logger("tex-mill")
 
 var ErrMediaTypeNotSupported = fmt.Errorf("media type not supported")
 
+// NoOutput returns whether or not a mill error means the mill has nothing to produce
+// for its input, e.g., a video without a poster frame. Optional schema links are left
+// out in this case. Errors received from the API are matched by message.
+func NoOutput(err error) bool {
+	if err == nil {
+		return false
+	}
+	return err == ErrNoPosterFrame || err.Error() == ErrNoPosterFrame.Error()
+}
+
 type Result struct {
 	File []byte
 	Meta map[string]interface{}
@@ -27,6 +38,19 @@ type Mill interface {
 	Mill(input []byte, name string) (*Result, error)
 }
 
+// StreamResult is the output of a streaming mill.
+// Meta is only complete once File has been read to EOF.
+type StreamResult struct {
+	File io.Reader
+	Meta map[string]interface{}
+}
+
+// StreamMill is a mill that can process input of any size with bounded memory
+type StreamMill interface {
+	Mill
+	MillStream(input io.Reader, name string) (*StreamResult, error)
+}
+
 func accepts(list []string, media string) error {
 	for _, m := range list {
 		if media == m {
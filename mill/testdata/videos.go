@@ -0,0 +1,63 @@
+package testdata
+
+type TestVideo struct {
+	Path         string
+	Container    string
+	Duration     float64
+	Width        int
+	Height       int
+	Codec        string
+	Rotation     int
+	HasPoster    bool
+	PosterWidth  int
+	PosterHeight int
+}
+
+var Videos = []TestVideo{
+	{
+		Path:         "testdata/video.mp4",
+		Container:    "mp4",
+		Duration:     2.5,
+		Width:        320,
+		Height:       240,
+		Codec:        "jpeg",
+		Rotation:     90,
+		HasPoster:    true,
+		PosterWidth:  240,
+		PosterHeight: 320,
+	},
+	{
+		Path:         "testdata/video.mov",
+		Container:    "quicktime",
+		Duration:     10,
+		Width:        1280,
+		Height:       720,
+		Codec:        "avc1",
+		Rotation:     0,
+		HasPoster:    true,
+		PosterWidth:  64,
+		PosterHeight: 48,
+	},
+	{
+		Path:      "testdata/video.webm",
+		Container: "webm",
+		Duration:  1.5,
+		Width:     640,
+		Height:    360,
+		Codec:     "V_VP9",
+		Rotation:  0,
+		HasPoster: false,
+	},
+	{
+		Path:         "testdata/video.mkv",
+		Container:    "matroska",
+		Duration:     3,
+		Width:        160,
+		Height:       120,
+		Codec:        "V_MJPEG",
+		Rotation:     0,
+		HasPoster:    true,
+		PosterWidth:  160,
+		PosterHeight: 120,
+	},
+}
@@ -0,0 +1,555 @@
+package mill
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ErrUnknownVideoContainer indicates the input is not a supported video container
+var ErrUnknownVideoContainer = fmt.Errorf("unknown video container")
+
+// ErrVideoMalformed indicates the video container could not be parsed
+var ErrVideoMalformed = fmt.Errorf("malformed video container")
+
+// videoInfo holds what we can learn about a video by only reading its container
+type videoInfo struct {
+	container  string
+	duration   float64 // seconds
+	width      int
+	height     int
+	codec      string
+	audioCodec string
+	rotation   int // degrees, clockwise
+
+	// poster is a still image found in the container, i.e., embedded cover art
+	// or the first frame of a motion-jpeg track
+	poster []byte
+
+	// posterIsFrame is true if the poster came from the video track itself,
+	// meaning it's subject to the track's rotation
+	posterIsFrame bool
+}
+
+// parseVideo inspects the container header and dispatches to the right parser
+func parseVideo(input []byte) (*videoInfo, error) {
+	switch {
+	case len(input) >= 8 && isMP4Box(string(input[4:8])):
+		return parseMP4(input)
+	case len(input) >= 4 && binary.BigEndian.Uint32(input) == ebmlHeaderID:
+		return parseEBML(input)
+	default:
+		return nil, ErrUnknownVideoContainer
+	}
+}
+
+// ------------------------------------
+// > ISO base media (mp4, mov)
+
+// mp4Containers are boxes whose payload is a list of child boxes
+var mp4Containers = map[string]bool{
+	"moov": true,
+	"trak": true,
+	"mdia": true,
+	"minf": true,
+	"stbl": true,
+	"udta": true,
+	"edts": true,
+	"ilst": true,
+	"covr": true,
+}
+
+func isMP4Box(typ string) bool {
+	switch typ {
+	case "ftyp", "moov", "mdat", "free", "skip", "wide", "pnot":
+		return true
+	}
+	return false
+}
+
+// mp4Track collects the parts of a trak box we care about
+type mp4Track struct {
+	handler     string
+	codec       string
+	width       int
+	height      int
+	rotation    int
+	chunkOffset int64
+	sampleSize  int64
+}
+
+type mp4Parser struct {
+	data   []byte
+	info   *videoInfo
+	track  *mp4Track
+	tracks []*mp4Track
+}
+
+func parseMP4(input []byte) (*videoInfo, error) {
+	p := &mp4Parser{
+		data: input,
+		info: &videoInfo{container: "mp4"},
+	}
+	if err := p.walk(0, int64(len(input)), ""); err != nil {
+		return nil, err
+	}
+
+	var found bool
+	for _, t := range p.tracks {
+		switch t.handler {
+		case "vide":
+			if found {
+				continue
+			}
+			found = true
+			p.info.codec = t.codec
+			p.info.width = t.width
+			p.info.height = t.height
+			p.info.rotation = t.rotation
+
+			if p.info.poster == nil && isMJPEG(t.codec) && t.sampleSize > 0 {
+				if t.chunkOffset > 0 && t.chunkOffset < int64(len(input)) && t.sampleSize <= int64(len(input))-t.chunkOffset {
+					p.info.poster = input[t.chunkOffset : t.chunkOffset+t.sampleSize]
+					p.info.posterIsFrame = true
+				}
+			}
+		case "soun":
+			if p.info.audioCodec == "" {
+				p.info.audioCodec = t.codec
+			}
+		}
+	}
+	if !found {
+		return nil, ErrVideoMalformed
+	}
+	return p.info, nil
+}
+
+// walk visits every box between start and end
+func (p *mp4Parser) walk(start int64, end int64, parent string) error {
+	for pos := start; pos+8 <= end; {
+		size := int64(binary.BigEndian.Uint32(p.data[pos:]))
+		typ := string(p.data[pos+4 : pos+8])
+		hdr := int64(8)
+
+		switch size {
+		case 0:
+			size = end - pos
+		case 1:
+			if pos+16 > end {
+				return ErrVideoMalformed
+			}
+			size = int64(binary.BigEndian.Uint64(p.data[pos+8:]))
+			hdr = 16
+		}
+		if size < hdr || size > end-pos {
+			return ErrVideoMalformed
+		}
+		body := p.data[pos+hdr : pos+size]
+
+		if err := p.handle(typ, parent, pos+hdr, body); err != nil {
+			return err
+		}
+		pos += size
+	}
+	return nil
+}
+
+func (p *mp4Parser) handle(typ string, parent string, offset int64, body []byte) error {
+	if typ == "trak" {
+		p.track = &mp4Track{}
+		p.tracks = append(p.tracks, p.track)
+	}
+	if mp4Containers[typ] {
+		return p.walk(offset, offset+int64(len(body)), typ)
+	}
+
+	switch typ {
+	case "ftyp":
+		if len(body) >= 4 && string(body[:4]) == "qt  " {
+			p.info.container = "quicktime"
+		}
+	case "meta":
+		// iso meta boxes are "full" boxes, quicktime ones are not
+		skip := int64(0)
+		if len(body) >= 8 && string(body[4:8]) != "hdlr" {
+			skip = 4
+		}
+		return p.walk(offset+skip, offset+int64(len(body)), typ)
+	case "mvhd":
+		timescale, duration, ok := readMP4Duration(body)
+		if ok && timescale > 0 {
+			p.info.duration = float64(duration) / float64(timescale)
+		}
+	case "tkhd":
+		if p.track != nil {
+			p.readTkhd(body)
+		}
+	case "hdlr":
+		if p.track != nil && parent == "mdia" && len(body) >= 12 {
+			p.track.handler = string(body[8:12])
+		}
+	case "stsd":
+		if p.track != nil && len(body) >= 16 {
+			p.track.codec = strings.TrimSpace(string(body[12:16]))
+			// visual sample entries carry the coded dimensions
+			if p.track.handler == "vide" && p.track.width == 0 && len(body) >= 44 {
+				p.track.width = int(binary.BigEndian.Uint16(body[40:]))
+				p.track.height = int(binary.BigEndian.Uint16(body[42:]))
+			}
+		}
+	case "stsz":
+		if p.track != nil && len(body) >= 12 {
+			p.track.sampleSize = int64(binary.BigEndian.Uint32(body[4:]))
+			if p.track.sampleSize == 0 && len(body) >= 16 {
+				p.track.sampleSize = int64(binary.BigEndian.Uint32(body[12:]))
+			}
+		}
+	case "stco":
+		if p.track != nil && len(body) >= 12 {
+			p.track.chunkOffset = int64(binary.BigEndian.Uint32(body[8:]))
+		}
+	case "co64":
+		if p.track != nil && len(body) >= 16 {
+			p.track.chunkOffset = int64(binary.BigEndian.Uint64(body[8:]))
+		}
+	case "data":
+		// cover art lives at ilst/covr/data: type(4), locale(4), image
+		if parent == "covr" && p.info.poster == nil && len(body) > 8 {
+			p.info.poster = body[8:]
+		}
+	}
+	return nil
+}
+
+// readTkhd reads display dimensions and rotation from a track header
+func (p *mp4Parser) readTkhd(body []byte) {
+	if len(body) < 1 {
+		return
+	}
+	// skip version/flags, times, id, reserved, and duration
+	var pos int
+	if body[0] == 1 {
+		pos = 4 + 8 + 8 + 4 + 4 + 8
+	} else {
+		pos = 4 + 4 + 4 + 4 + 4 + 4
+	}
+	// skip reserved, layer, alternate group, volume, reserved
+	pos += 8 + 2 + 2 + 2 + 2
+	if len(body) < pos+36+8 {
+		return
+	}
+
+	matrix := body[pos : pos+36]
+	a := fixed16(matrix[0:])
+	b := fixed16(matrix[4:])
+	p.track.rotation = normalizeRotation(math.Atan2(b, a) * 180 / math.Pi)
+
+	pos += 36
+	p.track.width = int(binary.BigEndian.Uint32(body[pos:]) >> 16)
+	p.track.height = int(binary.BigEndian.Uint32(body[pos+4:]) >> 16)
+}
+
+// readMP4Duration reads timescale and duration from a movie header
+func readMP4Duration(body []byte) (uint32, uint64, bool) {
+	if len(body) < 1 {
+		return 0, 0, false
+	}
+	if body[0] == 1 {
+		if len(body) < 32 {
+			return 0, 0, false
+		}
+		return binary.BigEndian.Uint32(body[20:]), binary.BigEndian.Uint64(body[24:]), true
+	}
+	if len(body) < 20 {
+		return 0, 0, false
+	}
+	return binary.BigEndian.Uint32(body[12:]), uint64(binary.BigEndian.Uint32(body[16:])), true
+}
+
+func fixed16(b []byte) float64 {
+	return float64(int32(binary.BigEndian.Uint32(b))) / 65536
+}
+
+// ------------------------------------
+// > matroska (webm, mkv)
+
+const (
+	ebmlHeaderID        = 0x1A45DFA3
+	ebmlDocTypeID       = 0x4282
+	mkvSegmentID        = 0x18538067
+	mkvInfoID           = 0x1549A966
+	mkvTimecodeScaleID  = 0x2AD7B1
+	mkvDurationID       = 0x4489
+	mkvTracksID         = 0x1654AE6B
+	mkvTrackEntryID     = 0xAE
+	mkvTrackNumberID    = 0xD7
+	mkvTrackTypeID      = 0x83
+	mkvCodecID          = 0x86
+	mkvVideoID          = 0xE0
+	mkvPixelWidthID     = 0xB0
+	mkvPixelHeightID    = 0xBA
+	mkvProjectionID     = 0x7670
+	mkvPoseRollID       = 0x7675
+	mkvAttachmentsID    = 0x1941A469
+	mkvAttachedFileID   = 0x61A7
+	mkvFileMimeTypeID   = 0x4660
+	mkvFileDataID       = 0x465C
+	mkvClusterID        = 0x1F43B675
+	mkvSimpleBlockID    = 0xA3
+	mkvBlockGroupID     = 0xA0
+	mkvBlockID          = 0xA1
+	mkvTrackTypeVideo   = 1
+	mkvTrackTypeAudio   = 2
+	mkvDefaultTimescale = 1000000
+)
+
+// mkvContainers are elements whose payload is a list of child elements
+var mkvContainers = map[uint32]bool{
+	ebmlHeaderID:      true,
+	mkvSegmentID:      true,
+	mkvInfoID:         true,
+	mkvTracksID:       true,
+	mkvTrackEntryID:   true,
+	mkvVideoID:        true,
+	mkvProjectionID:   true,
+	mkvAttachmentsID:  true,
+	mkvAttachedFileID: true,
+	mkvClusterID:      true,
+	mkvBlockGroupID:   true,
+}
+
+// mkvTrack collects the parts of a track entry we care about
+type mkvTrack struct {
+	number   uint64
+	kind     uint64
+	codec    string
+	width    int
+	height   int
+	rotation int
+}
+
+type mkvParser struct {
+	data      []byte
+	info      *videoInfo
+	timescale uint64
+	duration  float64
+	track     *mkvTrack
+	tracks    []*mkvTrack
+	mime      string
+	cover     []byte
+	frame     []byte
+}
+
+func parseEBML(input []byte) (*videoInfo, error) {
+	p := &mkvParser{
+		data:      input,
+		info:      &videoInfo{container: "matroska"},
+		timescale: mkvDefaultTimescale,
+	}
+	if err := p.walk(0, len(input)); err != nil {
+		return nil, err
+	}
+	p.info.duration = p.duration * float64(p.timescale) / 1e9
+
+	var found bool
+	for _, t := range p.tracks {
+		switch t.kind {
+		case mkvTrackTypeVideo:
+			if found {
+				continue
+			}
+			found = true
+			p.info.codec = t.codec
+			p.info.width = t.width
+			p.info.height = t.height
+			p.info.rotation = t.rotation
+		case mkvTrackTypeAudio:
+			if p.info.audioCodec == "" {
+				p.info.audioCodec = t.codec
+			}
+		}
+	}
+	if !found {
+		return nil, ErrVideoMalformed
+	}
+
+	if p.cover != nil {
+		p.info.poster = p.cover
+	} else if p.frame != nil {
+		p.info.poster = p.frame
+		p.info.posterIsFrame = true
+	}
+	return p.info, nil
+}
+
+// walk visits every element between start and end
+func (p *mkvParser) walk(start int, end int) error {
+	for pos := start; pos < end; {
+		id, n := readVint(p.data[pos:end], true)
+		if n == 0 {
+			return ErrVideoMalformed
+		}
+		pos += n
+		size, m := readVint(p.data[pos:end], false)
+		if m == 0 {
+			return ErrVideoMalformed
+		}
+		pos += m
+
+		// unknown sizes (all ones) extend to the end of the parent
+		if size == (uint64(1)<<(7*uint(m)))-1 || size > uint64(end-pos) {
+			if !mkvContainers[uint32(id)] {
+				return ErrVideoMalformed
+			}
+			size = uint64(end - pos)
+		}
+		body := p.data[pos : pos+int(size)]
+
+		if err := p.handle(uint32(id), pos, body); err != nil {
+			return err
+		}
+		pos += int(size)
+	}
+	return nil
+}
+
+func (p *mkvParser) handle(id uint32, offset int, body []byte) error {
+	switch id {
+	case mkvTrackEntryID:
+		p.track = &mkvTrack{}
+		p.tracks = append(p.tracks, p.track)
+	case mkvAttachedFileID:
+		p.mime = ""
+	}
+	if mkvContainers[id] {
+		return p.walk(offset, offset+len(body))
+	}
+
+	switch id {
+	case ebmlDocTypeID:
+		p.info.container = string(bytes.TrimRight(body, "\x00"))
+	case mkvTimecodeScaleID:
+		p.timescale = readUint(body)
+	case mkvDurationID:
+		p.duration = readFloat(body)
+	case mkvTrackNumberID:
+		if p.track != nil {
+			p.track.number = readUint(body)
+		}
+	case mkvTrackTypeID:
+		if p.track != nil {
+			p.track.kind = readUint(body)
+		}
+	case mkvCodecID:
+		if p.track != nil {
+			p.track.codec = string(bytes.TrimRight(body, "\x00"))
+		}
+	case mkvPixelWidthID:
+		if p.track != nil {
+			p.track.width = int(readUint(body))
+		}
+	case mkvPixelHeightID:
+		if p.track != nil {
+			p.track.height = int(readUint(body))
+		}
+	case mkvPoseRollID:
+		if p.track != nil {
+			// roll is counter-clockwise
+			p.track.rotation = normalizeRotation(-readFloat(body))
+		}
+	case mkvFileMimeTypeID:
+		p.mime = string(bytes.TrimRight(body, "\x00"))
+	case mkvFileDataID:
+		if p.cover == nil && strings.HasPrefix(p.mime, "image/") {
+			p.cover = body
+		}
+	case mkvSimpleBlockID, mkvBlockID:
+		if p.frame == nil {
+			p.readBlock(body)
+		}
+	}
+	return nil
+}
+
+// readBlock keeps the payload of the first un-laced block on a motion-jpeg track
+func (p *mkvParser) readBlock(body []byte) {
+	track, n := readVint(body, false)
+	if n == 0 || len(body) < n+3 {
+		return
+	}
+	flags := body[n+2]
+	if flags&0x06 != 0 {
+		return // laced
+	}
+	for _, t := range p.tracks {
+		if t.number == track && t.kind == mkvTrackTypeVideo && isMJPEG(t.codec) {
+			p.frame = body[n+3:]
+			return
+		}
+	}
+}
+
+// readVint reads an ebml variable length integer, optionally keeping the length marker
+func readVint(b []byte, keepMarker bool) (uint64, int) {
+	if len(b) == 0 || b[0] == 0 {
+		return 0, 0
+	}
+	n := 1
+	for mask := byte(0x80); b[0]&mask == 0; mask >>= 1 {
+		n++
+	}
+	if n > 8 || len(b) < n {
+		return 0, 0
+	}
+	v := uint64(b[0])
+	if !keepMarker {
+		v &= uint64(0xFF >> uint(n))
+	}
+	for i := 1; i < n; i++ {
+		v = v<<8 | uint64(b[i])
+	}
+	return v, n
+}
+
+func readUint(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+func readFloat(b []byte) float64 {
+	switch len(b) {
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b)))
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(b))
+	default:
+		return 0
+	}
+}
+
+// ------------------------------------
+// > helpers
+
+// isMJPEG returns whether or not frames of the given codec are plain jpegs
+func isMJPEG(codec string) bool {
+	switch codec {
+	case "jpeg", "mjpa", "V_MJPEG":
+		return true
+	}
+	return false
+}
+
+// normalizeRotation snaps an angle to a right angle in [0, 360)
+func normalizeRotation(deg float64) int {
+	r := int(math.Round(deg/90)) * 90 % 360
+	if r < 0 {
+		r += 360
+	}
+	return r
+}
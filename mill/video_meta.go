@@ -0,0 +1,83 @@
+package mill
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+)
+
+type VideoMetaSchema struct {
+	Name       string  `json:"name"`
+	Ext        string  `json:"extension"`
+	Container  string  `json:"container"`
+	Duration   float64 `json:"duration"`
+	Width      int     `json:"width"`
+	Height     int     `json:"height"`
+	Codec      string  `json:"codec"`
+	AudioCodec string  `json:"audio_codec,omitempty"`
+	Rotation   int     `json:"rotation"`
+}
+
+// VideoMeta reads video meta data directly from the container,
+// i.e., nothing is decoded or transcoded
+type VideoMeta struct{}
+
+func (m *VideoMeta) ID() string {
+	return "/video/meta"
+}
+
+func (m *VideoMeta) Encrypt() bool {
+	return true
+}
+
+func (m *VideoMeta) Pin() bool {
+	return false
+}
+
+func (m *VideoMeta) AcceptMedia(media string) error {
+	return accepts([]string{
+		"video/mp4",
+		"video/quicktime",
+		"video/webm",
+		"video/x-matroska",
+	}, media)
+}
+
+func (m *VideoMeta) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(make(map[string]string), add)
+}
+
+func (m *VideoMeta) Mill(input []byte, name string) (*Result, error) {
+	info, err := parseVideo(input)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &VideoMetaSchema{
+		Name:       name,
+		Ext:        strings.ToLower(filepath.Ext(name)),
+		Container:  info.container,
+		Duration:   info.duration,
+		Width:      info.width,
+		Height:     info.height,
+		Codec:      info.codec,
+		AudioCodec: info.audioCodec,
+		Rotation:   info.rotation,
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		File: data,
+		Meta: map[string]interface{}{
+			"duration": info.duration,
+			"width":    info.width,
+			"height":   info.height,
+			"codec":    info.codec,
+			"rotation": info.rotation,
+		},
+	}, nil
+}
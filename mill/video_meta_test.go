@@ -0,0 +1,94 @@
+package mill
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/textileio/go-textile/mill/testdata"
+)
+
+func TestVideoMeta_Mill(t *testing.T) {
+	m := &VideoMeta{}
+
+	for _, i := range testdata.Videos {
+		file, err := os.Open(i.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		input, err := ioutil.ReadAll(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.Close()
+
+		res, err := m.Mill(input, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var meta *VideoMetaSchema
+		if err := json.Unmarshal(res.File, &meta); err != nil {
+			t.Fatal(err)
+		}
+
+		if meta.Container != i.Container {
+			t.Errorf("wrong container")
+		}
+		if meta.Duration != i.Duration {
+			t.Errorf("wrong duration")
+		}
+		if meta.Width != i.Width {
+			t.Errorf("wrong width")
+		}
+		if meta.Height != i.Height {
+			t.Errorf("wrong height")
+		}
+		if meta.Codec != i.Codec {
+			t.Errorf("wrong codec")
+		}
+		if meta.Rotation != i.Rotation {
+			t.Errorf("wrong rotation")
+		}
+		if res.Meta["duration"] != i.Duration {
+			t.Errorf("wrong result meta")
+		}
+	}
+}
+
+func TestVideoMeta_MillNotVideo(t *testing.T) {
+	m := &VideoMeta{}
+
+	if _, err := m.Mill([]byte("not a video"), "test"); err != ErrUnknownVideoContainer {
+		t.Fatal("should have rejected input")
+	}
+}
+
+func TestVideoMeta_MillMalformed(t *testing.T) {
+	m := &VideoMeta{}
+
+	ftyp := mp4Box("ftyp", []byte("isom\x00\x00\x00\x00"))
+	for _, size := range []uint64{0x7FFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF, 8} {
+		// a moov box with a 64-bit size larger than the file
+		moov := make([]byte, 16)
+		binary.BigEndian.PutUint32(moov, 1)
+		copy(moov[4:], "moov")
+		binary.BigEndian.PutUint64(moov[8:], size)
+		input := append(append(ftyp, moov...), make([]byte, 8)...)
+
+		if _, err := m.Mill(input, "test"); err != ErrVideoMalformed {
+			t.Fatalf("should have rejected box size %x, got %v", size, err)
+		}
+	}
+}
+
+// mp4Box returns an iso base media box with a 32-bit size
+func mp4Box(typ string, body []byte) []byte {
+	box := make([]byte, 8, 8+len(body))
+	binary.BigEndian.PutUint32(box, uint32(8+len(body)))
+	copy(box[4:], typ)
+	return append(box, body...)
+}
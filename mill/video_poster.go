@@ -0,0 +1,88 @@
+package mill
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"io/ioutil"
+
+	"github.com/disintegration/imaging"
+)
+
+// ErrNoPosterFrame indicates a video does not contain a frame we can extract
+// without a codec, i.e., embedded cover art or a motion-jpeg frame
+var ErrNoPosterFrame = fmt.Errorf("video does not contain an extractable poster frame")
+
+// VideoPoster extracts a still jpeg from a video, which can be used as input
+// for other image mills. Frames are not decoded, so the still must come from
+// embedded cover art or a motion-jpeg track.
+type VideoPoster struct{}
+
+func (m *VideoPoster) ID() string {
+	return "/video/poster"
+}
+
+func (m *VideoPoster) Encrypt() bool {
+	return true
+}
+
+func (m *VideoPoster) Pin() bool {
+	return false
+}
+
+func (m *VideoPoster) AcceptMedia(media string) error {
+	return accepts([]string{
+		"video/mp4",
+		"video/quicktime",
+		"video/webm",
+		"video/x-matroska",
+	}, media)
+}
+
+func (m *VideoPoster) Options(add map[string]interface{}) (string, error) {
+	return hashOpts(make(map[string]string), add)
+}
+
+func (m *VideoPoster) Mill(input []byte, name string) (*Result, error) {
+	info, err := parseVideo(input)
+	if err != nil {
+		return nil, err
+	}
+	if info.poster == nil {
+		return nil, ErrNoPosterFrame
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(info.poster))
+	if err != nil {
+		return nil, err
+	}
+
+	// match the display orientation of the video (imaging rotates counter-clockwise)
+	if info.posterIsFrame {
+		switch info.rotation {
+		case 90:
+			img = imaging.Rotate270(img)
+		case 180:
+			img = imaging.Rotate180(img)
+		case 270:
+			img = imaging.Rotate90(img)
+		}
+	}
+
+	reader, err := encodeSingleImage(img, JPEG)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		File: data,
+		Meta: map[string]interface{}{
+			"width":  img.Bounds().Dx(),
+			"height": img.Bounds().Dy(),
+		},
+	}, nil
+}
@@ -0,0 +1,66 @@
+package mill
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/textileio/go-textile/mill/testdata"
+)
+
+func TestVideoPoster_Mill(t *testing.T) {
+	m := &VideoPoster{}
+
+	for _, i := range testdata.Videos {
+		file, err := os.Open(i.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		input, err := ioutil.ReadAll(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.Close()
+
+		res, err := m.Mill(input, "test")
+		if !i.HasPoster {
+			if err != ErrNoPosterFrame {
+				t.Errorf("expected no poster frame")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		conf, format, err := image.DecodeConfig(bytes.NewReader(res.File))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if Format(format) != JPEG {
+			t.Errorf("wrong format")
+		}
+		if conf.Width != i.PosterWidth {
+			t.Errorf("wrong width")
+		}
+		if conf.Height != i.PosterHeight {
+			t.Errorf("wrong height")
+		}
+	}
+}
+
+func TestNoOutput(t *testing.T) {
+	if !NoOutput(ErrNoPosterFrame) {
+		t.Error("no poster frame should be no output")
+	}
+	if !NoOutput(fmt.Errorf(ErrNoPosterFrame.Error())) {
+		t.Error("no poster frame from the api should be no output")
+	}
+	if NoOutput(nil) || NoOutput(ErrMediaTypeNotSupported) {
+		t.Error("other errors should not be no output")
+	}
+}
@@ -79,6 +79,15 @@ func (m *Mobile) DeregisterCafe(id string) error {
 	return m.node.DeregisterCafe(id)
 }
 
+// SetCafeWebhook calls core SetCafeWebhook
+func (m *Mobile) SetCafeWebhook(id string, url string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.SetCafeWebhook(id, url)
+}
+
 // CheckCafeMessages calls core CheckCafeMessages
 func (m *Mobile) CheckCafeMessages() error {
 	if !m.node.Started() {
@@ -0,0 +1,15 @@
+package mobile
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/textileio/go-textile/core"
+)
+
+// Changes calls core Changes
+func (m *Mobile) Changes(since int64, limit int) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	return proto.Marshal(m.node.Changes(since, limit))
+}
@@ -0,0 +1,27 @@
+package mobile
+
+import "github.com/textileio/go-textile/core"
+
+// AddEdit adds an edit targeted at the given text or comment block, replacing its body
+func (m *Mobile) AddEdit(blockId string, body string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	block, err := m.node.Block(blockId)
+	if err != nil {
+		return "", err
+	}
+
+	thrd := m.node.Thread(block.Thread)
+	if thrd == nil {
+		return "", core.ErrThreadNotFound
+	}
+
+	hash, err := thrd.AddEdit(block.Id, body)
+	if err != nil {
+		return "", err
+	}
+
+	return hash.B58String(), nil
+}
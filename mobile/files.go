@@ -92,8 +92,14 @@ func (m *Mobile) PrepareFilesSync(data string, threadId string) ([]byte, error)
 			return nil, err
 		}
 
-		// send each link
+		// send each link, leaving out optional links without output
+		skipped := make(map[string]bool)
 		for _, step := range steps {
+			if skipped[step.Link.Use] {
+				skipped[step.Name] = true
+				continue
+			}
+
 			mil, err := getMill(step.Link.Mill, step.Link.Opts)
 			if err != nil {
 				return nil, err
@@ -119,6 +125,10 @@ func (m *Mobile) PrepareFilesSync(data string, threadId string) ([]byte, error)
 
 			added, err := m.node.AddFileIndex(mil, *conf)
 			if err != nil {
+				if step.Link.Optional && mill.NoOutput(err) {
+					skipped[step.Name] = true
+					continue
+				}
 				return nil, err
 			}
 			mdir.Dir.Files[step.Name] = added
@@ -198,8 +208,14 @@ func (m *Mobile) PrepareFilesByPathSync(path string, threadId string) ([]byte, e
 			return nil, err
 		}
 
-		// send each link
+		// send each link, leaving out optional links without output
+		skipped := make(map[string]bool)
 		for _, step := range steps {
+			if skipped[step.Link.Use] {
+				skipped[step.Name] = true
+				continue
+			}
+
 			mil, err := getMill(step.Link.Mill, step.Link.Opts)
 			if err != nil {
 				return nil, err
@@ -225,6 +241,10 @@ func (m *Mobile) PrepareFilesByPathSync(path string, threadId string) ([]byte, e
 
 			added, err := m.node.AddFileIndex(mil, *conf)
 			if err != nil {
+				if step.Link.Optional && mill.NoOutput(err) {
+					skipped[step.Name] = true
+					continue
+				}
 				return nil, err
 			}
 			mdir.Dir.Files[step.Name] = added
@@ -370,6 +390,9 @@ func (m *Mobile) FileContent(hash string) (string, error) {
 		}
 		return "", err
 	}
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
 
 	data, err := ioutil.ReadAll(reader)
 	if err != nil {
@@ -472,6 +495,9 @@ func (m *Mobile) getFileConfig(mil mill.Mill, data []byte, use string, plaintext
 		if err != nil {
 			return nil, err
 		}
+		if closer, ok := reader.(io.Closer); ok {
+			defer closer.Close()
+		}
 
 		conf.Name = file.Name
 		conf.Use = file.Checksum
@@ -520,6 +546,9 @@ func (m *Mobile) getFileConfigByPath(mil mill.Mill, path string, use string, pla
 		if err != nil {
 			return nil, err
 		}
+		if closer, ok := reader.(io.Closer); ok {
+			defer closer.Close()
+		}
 
 		conf.Name = file.Name
 		conf.Use = file.Checksum
@@ -580,6 +609,10 @@ func getMill(id string, opts map[string]string) (mill.Mill, error) {
 		}, nil
 	case "/image/exif":
 		return &mill.ImageExif{}, nil
+	case "/video/meta":
+		return &mill.VideoMeta{}, nil
+	case "/video/poster":
+		return &mill.VideoPoster{}, nil
 	case "/json":
 		return &mill.Json{}, nil
 	default:
@@ -2,7 +2,8 @@ package mobile
 
 import "github.com/textileio/go-textile/core"
 
-// AddIgnore adds an ignore targeted at the given block and unpins any associated target data
+// AddIgnore adds an ignore targeted at the given block and unpins any associated target data.
+// Only the original author of a block can ignore it.
 func (m *Mobile) AddIgnore(blockId string) (string, error) {
 	if !m.node.Started() {
 		return "", core.ErrStopped
@@ -24,6 +24,30 @@ func (m *Mobile) AddMessage(threadId string, body string) (string, error) {
 	return hash.B58String(), nil
 }
 
+// AddReply adds a message to a thread in reply to the given message
+func (m *Mobile) AddReply(blockId string, body string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	block, err := m.node.Block(blockId)
+	if err != nil {
+		return "", err
+	}
+
+	thrd := m.node.Thread(block.Thread)
+	if thrd == nil {
+		return "", core.ErrThreadNotFound
+	}
+
+	hash, err := thrd.AddReply(block.Id, body)
+	if err != nil {
+		return "", err
+	}
+
+	return hash.B58String(), nil
+}
+
 // Messages calls core Messages
 func (m *Mobile) Messages(offset string, limit int, threadId string) ([]byte, error) {
 	if !m.node.Started() {
@@ -37,3 +61,17 @@ func (m *Mobile) Messages(offset string, limit int, threadId string) ([]byte, er
 
 	return proto.Marshal(msgs)
 }
+
+// Replies calls core Replies
+func (m *Mobile) Replies(blockId string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	replies, err := m.node.Replies(blockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(replies)
+}
@@ -421,6 +421,99 @@ func TestMobile_Messages(t *testing.T) {
 	}
 }
 
+func TestMobile_AddEdit(t *testing.T) {
+	res, err := mobile1.Messages("", -1, thrdId)
+	if err != nil {
+		t.Errorf("thread messages failed: %s", err)
+		return
+	}
+	list := new(pb.TextList)
+	if err := proto.Unmarshal(res, list); err != nil {
+		t.Error(err)
+		return
+	}
+	if _, err := mobile1.AddEdit(list.Items[0].Block, "ping pong pang"); err != nil {
+		t.Errorf("add thread edit failed: %s", err)
+		return
+	}
+
+	res, err = mobile1.Messages("", -1, thrdId)
+	if err != nil {
+		t.Errorf("thread messages failed: %s", err)
+		return
+	}
+	list = new(pb.TextList)
+	if err := proto.Unmarshal(res, list); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(list.Items) != 1 {
+		t.Error("wrong number of messages")
+		return
+	}
+	msg := list.Items[0]
+	if msg.Body != "ping pong pang" || !msg.Edited || len(msg.Revisions) != 2 {
+		t.Error("thread edit bad result")
+	}
+}
+
+func TestMobile_AddReply(t *testing.T) {
+	res, err := mobile1.Messages("", -1, thrdId)
+	if err != nil {
+		t.Errorf("thread messages failed: %s", err)
+		return
+	}
+	list := new(pb.TextList)
+	if err := proto.Unmarshal(res, list); err != nil {
+		t.Error(err)
+		return
+	}
+	parent := list.Items[0].Block
+	if _, err := mobile1.AddReply(parent, "right back"); err != nil {
+		t.Errorf("add thread reply failed: %s", err)
+		return
+	}
+
+	res, err = mobile1.Replies(parent)
+	if err != nil {
+		t.Errorf("thread replies failed: %s", err)
+		return
+	}
+	replies := new(pb.TextList)
+	if err := proto.Unmarshal(res, replies); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(replies.Items) != 1 || replies.Items[0].ReplyTo != parent {
+		t.Error("thread reply bad result")
+	}
+}
+
+func TestMobile_SearchBlocks(t *testing.T) {
+	filters, err := proto.Marshal(&pb.BlockSearchFilters{
+		Thread: thrdId,
+		Limit:  10,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	res, err := mobile1.SearchBlocks("pong", filters)
+	if err != nil {
+		t.Errorf("search blocks failed: %s", err)
+		return
+	}
+	list := new(pb.BlockSearchResultList)
+	if err := proto.Unmarshal(res, list); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(list.Items) != 1 || list.Items[0].Block.Type != pb.Block_TEXT {
+		t.Error("wrong search results")
+	}
+}
+
 func TestMobile_PrepareFilesSync(t *testing.T) {
 	input := "howdy"
 	encoded := base64.StdEncoding.EncodeToString([]byte(input))
@@ -590,6 +683,46 @@ func TestMobile_AddLike(t *testing.T) {
 	}
 }
 
+func TestMobile_AddReaction(t *testing.T) {
+	if _, err := mobile1.AddReaction(filesBlock.Id, "🎉"); err != nil {
+		t.Errorf("add thread reaction failed: %s", err)
+		return
+	}
+	res, err := mobile1.Reactions(filesBlock.Id)
+	if err != nil {
+		t.Errorf("get thread reactions failed: %s", err)
+		return
+	}
+	list := new(pb.ReactionList)
+	if err := proto.Unmarshal(res, list); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("thread reactions bad result")
+	}
+}
+
+func TestMobile_RemoveReaction(t *testing.T) {
+	if _, err := mobile1.RemoveReaction(filesBlock.Id, "🎉"); err != nil {
+		t.Errorf("remove thread reaction failed: %s", err)
+		return
+	}
+	res, err := mobile1.Reactions(filesBlock.Id)
+	if err != nil {
+		t.Errorf("get thread reactions failed: %s", err)
+		return
+	}
+	list := new(pb.ReactionList)
+	if err := proto.Unmarshal(res, list); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(list.Items) != 1 || list.Items[0].Reaction != "heart" {
+		t.Errorf("remove thread reaction bad result")
+	}
+}
+
 func TestMobile_Files(t *testing.T) {
 	res, err := mobile1.Files(thrdId, "", -1)
 	if err != nil {
@@ -671,11 +804,69 @@ func TestMobile_Feed(t *testing.T) {
 		t.Error(err)
 		return
 	}
-	if list.Count != 3 {
+	if list.Count != 4 {
 		t.Errorf("get thread feed bad result")
 	}
 }
 
+func TestMobile_FeedFiltered(t *testing.T) {
+	req, err := proto.Marshal(&pb.FeedRequest{
+		Thread: thrdId,
+		Limit:  20,
+		Mode:   pb.FeedRequest_CHRONO,
+		Media:  "image",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	res, err := mobile1.Feed(req)
+	if err != nil {
+		t.Errorf("get filtered thread feed failed: %s", err)
+		return
+	}
+	list := new(pb.FeedItemList)
+	if err := proto.Unmarshal(res, list); err != nil {
+		t.Error(err)
+		return
+	}
+	if list.Count != 1 || list.Items[0].Payload.TypeUrl != "/Files" {
+		t.Errorf("get filtered thread feed bad result")
+	}
+}
+
+func TestMobile_Changes(t *testing.T) {
+	res, err := mobile1.Changes(0, -1)
+	if err != nil {
+		t.Errorf("get changes failed: %s", err)
+		return
+	}
+	list := new(pb.ChangeList)
+	if err := proto.Unmarshal(res, list); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(list.Items) == 0 || list.Next != list.Items[len(list.Items)-1].Seq {
+		t.Errorf("get changes bad result")
+		return
+	}
+
+	res, err = mobile1.Changes(list.Next, -1)
+	if err != nil {
+		t.Errorf("get changes since cursor failed: %s", err)
+		return
+	}
+	next := new(pb.ChangeList)
+	if err := proto.Unmarshal(res, next); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(next.Items) != 0 || next.Next != list.Next {
+		t.Errorf("get changes since cursor bad result")
+	}
+}
+
 func TestMobile_ImageFileContentForMinWidth(t *testing.T) {
 	large, err := mobile1.FileContent(files[0].Files[0].Links["large"].Hash)
 	if err != nil {
@@ -939,6 +1130,32 @@ func TestMobile_AcceptExternalInvite(t *testing.T) {
 	}
 }
 
+func TestMobile_AdvertiseThread(t *testing.T) {
+	res, err := mobile1.AdvertiseThread(thrdId)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	advert := new(pb.ThreadAdvert)
+	if err := proto.Unmarshal(res, advert); err != nil {
+		t.Error(err)
+		return
+	}
+	if advert.Id != thrdId || advert.Invite == "" || advert.InviteKey == "" {
+		t.Errorf("bad advert result: %s", res)
+	}
+}
+
+func TestMobile_UnadvertiseThread(t *testing.T) {
+	if err := mobile1.UnadvertiseThread(thrdId); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := mobile1.UnadvertiseThread(thrdId); err == nil {
+		t.Error("unadvertise should fail without an advert")
+	}
+}
+
 func TestMobile_Notifications(t *testing.T) {
 	res, err := mobile1.Notifications("", -1)
 	if err != nil {
@@ -965,6 +1182,54 @@ func TestMobile_ReadAllNotifications(t *testing.T) {
 	}
 }
 
+func TestMobile_SetNotificationSettings(t *testing.T) {
+	settings, err := proto.Marshal(&pb.NotificationSettings{
+		Thread: thrdId,
+		Muted:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mobile1.SetNotificationSettings(settings); err != nil {
+		t.Error(err)
+		return
+	}
+
+	res, err := mobile1.NotificationSettings(thrdId)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	got := new(pb.NotificationSettings)
+	if err := proto.Unmarshal(res, got); err != nil {
+		t.Error(err)
+		return
+	}
+	if !got.Muted || got.Updated == nil {
+		t.Error("set notification settings bad result")
+	}
+}
+
+func TestMobile_ResetNotificationSettings(t *testing.T) {
+	if err := mobile1.ResetNotificationSettings(thrdId); err != nil {
+		t.Error(err)
+		return
+	}
+	res, err := mobile1.NotificationSettingsList()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	list := new(pb.NotificationSettingsList)
+	if err := proto.Unmarshal(res, list); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(list.Items) != 0 {
+		t.Error("reset notification settings bad result")
+	}
+}
+
 func TestMobile_SearchContacts(t *testing.T) {
 	query, err := proto.Marshal(&pb.ContactQuery{Address: mobile2.Address()})
 	if err != nil {
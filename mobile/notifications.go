@@ -3,6 +3,7 @@ package mobile
 import (
 	"github.com/golang/protobuf/proto"
 	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
 )
 
 // Notifications call core Notifications
@@ -62,3 +63,49 @@ func (m *Mobile) IgnoreInviteViaNotification(id string) error {
 
 	return m.node.IgnoreInviteViaNotification(id)
 }
+
+// NotificationSettings calls core NotificationSettings
+func (m *Mobile) NotificationSettings(thread string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	settings, err := m.node.NotificationSettings(thread)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(settings)
+}
+
+// NotificationSettingsList calls core NotificationSettingsList
+func (m *Mobile) NotificationSettingsList() ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	return proto.Marshal(m.node.NotificationSettingsList())
+}
+
+// SetNotificationSettings calls core SetNotificationSettings
+func (m *Mobile) SetNotificationSettings(settings []byte) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	msettings := new(pb.NotificationSettings)
+	if err := proto.Unmarshal(settings, msettings); err != nil {
+		return err
+	}
+
+	return m.node.SetNotificationSettings(msettings)
+}
+
+// ResetNotificationSettings calls core ResetNotificationSettings
+func (m *Mobile) ResetNotificationSettings(thread string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.ResetNotificationSettings(thread)
+}
@@ -0,0 +1,68 @@
+package mobile
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/textileio/go-textile/core"
+)
+
+// AddReaction adds a reaction targeted at the given block
+func (m *Mobile) AddReaction(blockId string, reaction string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	block, err := m.node.Block(blockId)
+	if err != nil {
+		return "", err
+	}
+
+	thrd := m.node.Thread(block.Thread)
+	if thrd == nil {
+		return "", core.ErrThreadNotFound
+	}
+
+	hash, err := thrd.AddReaction(block.Id, reaction)
+	if err != nil {
+		return "", err
+	}
+
+	return hash.B58String(), nil
+}
+
+// RemoveReaction removes the account's reaction on the given block, use heart to remove a like
+func (m *Mobile) RemoveReaction(blockId string, reaction string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	block, err := m.node.Block(blockId)
+	if err != nil {
+		return "", err
+	}
+
+	thrd := m.node.Thread(block.Thread)
+	if thrd == nil {
+		return "", core.ErrThreadNotFound
+	}
+
+	hash, err := thrd.RemoveReaction(block.Id, reaction)
+	if err != nil {
+		return "", err
+	}
+
+	return hash.B58String(), nil
+}
+
+// Reactions calls core Reactions
+func (m *Mobile) Reactions(blockId string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	reactions, err := m.node.Reactions(blockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(reactions)
+}
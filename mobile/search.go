@@ -1,8 +1,10 @@
 package mobile
 
 import (
+	"github.com/golang/protobuf/proto"
 	"github.com/segmentio/ksuid"
 	"github.com/textileio/go-textile/broadcast"
+	"github.com/textileio/go-textile/core"
 	"github.com/textileio/go-textile/pb"
 )
 
@@ -70,3 +72,22 @@ func (m *Mobile) handleSearchStream(resultCh <-chan *pb.QueryResult, errCh <-cha
 
 	return handle, nil
 }
+
+// SearchBlocks calls core SearchBlocks
+func (m *Mobile) SearchBlocks(query string, filters []byte) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	mfilters := new(pb.BlockSearchFilters)
+	if err := proto.Unmarshal(filters, mfilters); err != nil {
+		return nil, err
+	}
+
+	results, err := m.node.SearchBlocks(query, mfilters)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(results)
+}
@@ -2,6 +2,7 @@ package mobile
 
 import (
 	"crypto/rand"
+	"os"
 
 	"github.com/golang/protobuf/proto"
 	libp2pc "github.com/libp2p/go-libp2p-crypto"
@@ -111,6 +112,218 @@ func (m *Mobile) ThreadPeers(id string) ([]byte, error) {
 	return proto.Marshal(peers)
 }
 
+// ThreadRoles calls core ThreadRoles
+func (m *Mobile) ThreadRoles(id string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	roles, err := m.node.ThreadRoles(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(roles)
+}
+
+// GrantThreadRole adds a role grant block for the given address to a thread
+func (m *Mobile) GrantThreadRole(id string, address string, role int) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	thrd := m.node.Thread(id)
+	if thrd == nil {
+		return "", core.ErrThreadNotFound
+	}
+
+	hash, err := thrd.AddRoleGrant(address, pb.Thread_Role(role))
+	if err != nil {
+		return "", err
+	}
+
+	return hash.B58String(), nil
+}
+
+// RevokeThreadRole adds a role revoke block for the given address to a thread
+func (m *Mobile) RevokeThreadRole(id string, address string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	thrd := m.node.Thread(id)
+	if thrd == nil {
+		return "", core.ErrThreadNotFound
+	}
+
+	hash, err := thrd.AddRoleRevoke(address)
+	if err != nil {
+		return "", err
+	}
+
+	return hash.B58String(), nil
+}
+
+// RotateThreadKey adds a key rotation block to a thread
+func (m *Mobile) RotateThreadKey(id string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	thrd := m.node.Thread(id)
+	if thrd == nil {
+		return "", core.ErrThreadNotFound
+	}
+
+	hash, err := thrd.RotateKey()
+	if err != nil {
+		return "", err
+	}
+
+	return hash.B58String(), nil
+}
+
+// EvictThreadMember revokes all access from the given address and rotates the thread key
+func (m *Mobile) EvictThreadMember(id string, address string) (string, error) {
+	if !m.node.Started() {
+		return "", core.ErrStopped
+	}
+
+	thrd := m.node.Thread(id)
+	if thrd == nil {
+		return "", core.ErrThreadNotFound
+	}
+
+	hash, err := thrd.Evict(address)
+	if err != nil {
+		return "", err
+	}
+
+	return hash.B58String(), nil
+}
+
+// WalkThread calls core WalkThread, returning the visited steps
+func (m *Mobile) WalkThread(id string, opts []byte) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	wopts := new(pb.WalkOptions)
+	if err := proto.Unmarshal(opts, wopts); err != nil {
+		return nil, err
+	}
+
+	list := &pb.WalkStepList{Items: make([]*pb.WalkStep, 0)}
+	if err := m.node.WalkThread(id, wopts, func(step *pb.WalkStep) error {
+		list.Items = append(list.Items, step)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(list)
+}
+
+// VerifyThread calls core VerifyThread, returning the report
+func (m *Mobile) VerifyThread(id string, repair bool) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	report, err := m.node.VerifyThread(id, repair)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(report)
+}
+
+// AdvertiseThread makes a thread discoverable by peers searching the network
+func (m *Mobile) AdvertiseThread(id string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	advert, err := m.node.AdvertiseThread(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(advert)
+}
+
+// UnadvertiseThread stops advertising a thread
+func (m *Mobile) UnadvertiseThread(id string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	return m.node.UnadvertiseThread(id)
+}
+
+// SearchPublicThreads calls core SearchPublicThreads
+func (m *Mobile) SearchPublicThreads(query []byte, options []byte) (*SearchHandle, error) {
+	if !m.node.Online() {
+		return nil, core.ErrOffline
+	}
+
+	mquery := new(pb.PublicThreadQuery)
+	if err := proto.Unmarshal(query, mquery); err != nil {
+		return nil, err
+	}
+	moptions := new(pb.QueryOptions)
+	if err := proto.Unmarshal(options, moptions); err != nil {
+		return nil, err
+	}
+
+	resCh, errCh, cancel, err := m.node.SearchPublicThreads(mquery, moptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.handleSearchStream(resCh, errCh, cancel)
+}
+
+// ExportThread writes a thread archive to the given path
+func (m *Mobile) ExportThread(id string, path string) error {
+	if !m.node.Started() {
+		return core.ErrStopped
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return m.node.ExportThread(id, f)
+}
+
+// ImportThread imports a thread archive from the given path
+func (m *Mobile) ImportThread(path string) ([]byte, error) {
+	if !m.node.Started() {
+		return nil, core.ErrStopped
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	id, err := m.node.ImportThread(f)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := m.node.ThreadView(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(view)
+}
+
 // RemoveThread call core RemoveThread
 func (m *Mobile) RemoveThread(id string) (string, error) {
 	if !m.node.Started() {
@@ -29,7 +29,7 @@ func (m *CafeChallenge) Reset()         { *m = CafeChallenge{} }
 func (m *CafeChallenge) String() string { return proto.CompactTextString(m) }
 func (*CafeChallenge) ProtoMessage()    {}
 func (*CafeChallenge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{0}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{0}
 }
 func (m *CafeChallenge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeChallenge.Unmarshal(m, b)
@@ -67,7 +67,7 @@ func (m *CafeNonce) Reset()         { *m = CafeNonce{} }
 func (m *CafeNonce) String() string { return proto.CompactTextString(m) }
 func (*CafeNonce) ProtoMessage()    {}
 func (*CafeNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{1}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{1}
 }
 func (m *CafeNonce) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeNonce.Unmarshal(m, b)
@@ -109,7 +109,7 @@ func (m *CafeRegistration) Reset()         { *m = CafeRegistration{} }
 func (m *CafeRegistration) String() string { return proto.CompactTextString(m) }
 func (*CafeRegistration) ProtoMessage()    {}
 func (*CafeRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{2}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{2}
 }
 func (m *CafeRegistration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeRegistration.Unmarshal(m, b)
@@ -175,7 +175,7 @@ func (m *CafeDeregistration) Reset()         { *m = CafeDeregistration{} }
 func (m *CafeDeregistration) String() string { return proto.CompactTextString(m) }
 func (*CafeDeregistration) ProtoMessage()    {}
 func (*CafeDeregistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{3}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{3}
 }
 func (m *CafeDeregistration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeregistration.Unmarshal(m, b)
@@ -213,7 +213,7 @@ func (m *CafeDeregistrationAck) Reset()         { *m = CafeDeregistrationAck{} }
 func (m *CafeDeregistrationAck) String() string { return proto.CompactTextString(m) }
 func (*CafeDeregistrationAck) ProtoMessage()    {}
 func (*CafeDeregistrationAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{4}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{4}
 }
 func (m *CafeDeregistrationAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeregistrationAck.Unmarshal(m, b)
@@ -252,7 +252,7 @@ func (m *CafeRefreshSession) Reset()         { *m = CafeRefreshSession{} }
 func (m *CafeRefreshSession) String() string { return proto.CompactTextString(m) }
 func (*CafeRefreshSession) ProtoMessage()    {}
 func (*CafeRefreshSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{5}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{5}
 }
 func (m *CafeRefreshSession) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeRefreshSession.Unmarshal(m, b)
@@ -298,7 +298,7 @@ func (m *CafePublishPeer) Reset()         { *m = CafePublishPeer{} }
 func (m *CafePublishPeer) String() string { return proto.CompactTextString(m) }
 func (*CafePublishPeer) ProtoMessage()    {}
 func (*CafePublishPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{6}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{6}
 }
 func (m *CafePublishPeer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafePublishPeer.Unmarshal(m, b)
@@ -343,7 +343,7 @@ func (m *CafePublishPeerAck) Reset()         { *m = CafePublishPeerAck{} }
 func (m *CafePublishPeerAck) String() string { return proto.CompactTextString(m) }
 func (*CafePublishPeerAck) ProtoMessage()    {}
 func (*CafePublishPeerAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{7}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{7}
 }
 func (m *CafePublishPeerAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafePublishPeerAck.Unmarshal(m, b)
@@ -382,7 +382,7 @@ func (m *CafeStore) Reset()         { *m = CafeStore{} }
 func (m *CafeStore) String() string { return proto.CompactTextString(m) }
 func (*CafeStore) ProtoMessage()    {}
 func (*CafeStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{8}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{8}
 }
 func (m *CafeStore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStore.Unmarshal(m, b)
@@ -427,7 +427,7 @@ func (m *CafeStoreAck) Reset()         { *m = CafeStoreAck{} }
 func (m *CafeStoreAck) String() string { return proto.CompactTextString(m) }
 func (*CafeStoreAck) ProtoMessage()    {}
 func (*CafeStoreAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{9}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{9}
 }
 func (m *CafeStoreAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStoreAck.Unmarshal(m, b)
@@ -466,7 +466,7 @@ func (m *CafeUnstore) Reset()         { *m = CafeUnstore{} }
 func (m *CafeUnstore) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstore) ProtoMessage()    {}
 func (*CafeUnstore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{10}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{10}
 }
 func (m *CafeUnstore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstore.Unmarshal(m, b)
@@ -511,7 +511,7 @@ func (m *CafeUnstoreAck) Reset()         { *m = CafeUnstoreAck{} }
 func (m *CafeUnstoreAck) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstoreAck) ProtoMessage()    {}
 func (*CafeUnstoreAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{11}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{11}
 }
 func (m *CafeUnstoreAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstoreAck.Unmarshal(m, b)
@@ -549,7 +549,7 @@ func (m *CafeObjectList) Reset()         { *m = CafeObjectList{} }
 func (m *CafeObjectList) String() string { return proto.CompactTextString(m) }
 func (*CafeObjectList) ProtoMessage()    {}
 func (*CafeObjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{12}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{12}
 }
 func (m *CafeObjectList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeObjectList.Unmarshal(m, b)
@@ -590,7 +590,7 @@ func (m *CafeObject) Reset()         { *m = CafeObject{} }
 func (m *CafeObject) String() string { return proto.CompactTextString(m) }
 func (*CafeObject) ProtoMessage()    {}
 func (*CafeObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{13}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{13}
 }
 func (m *CafeObject) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeObject.Unmarshal(m, b)
@@ -651,7 +651,7 @@ func (m *CafeStoreThread) Reset()         { *m = CafeStoreThread{} }
 func (m *CafeStoreThread) String() string { return proto.CompactTextString(m) }
 func (*CafeStoreThread) ProtoMessage()    {}
 func (*CafeStoreThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{14}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{14}
 }
 func (m *CafeStoreThread) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStoreThread.Unmarshal(m, b)
@@ -703,7 +703,7 @@ func (m *CafeStoreThreadAck) Reset()         { *m = CafeStoreThreadAck{} }
 func (m *CafeStoreThreadAck) String() string { return proto.CompactTextString(m) }
 func (*CafeStoreThreadAck) ProtoMessage()    {}
 func (*CafeStoreThreadAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{15}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{15}
 }
 func (m *CafeStoreThreadAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeStoreThreadAck.Unmarshal(m, b)
@@ -742,7 +742,7 @@ func (m *CafeUnstoreThread) Reset()         { *m = CafeUnstoreThread{} }
 func (m *CafeUnstoreThread) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstoreThread) ProtoMessage()    {}
 func (*CafeUnstoreThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{16}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{16}
 }
 func (m *CafeUnstoreThread) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstoreThread.Unmarshal(m, b)
@@ -787,7 +787,7 @@ func (m *CafeUnstoreThreadAck) Reset()         { *m = CafeUnstoreThreadAck{} }
 func (m *CafeUnstoreThreadAck) String() string { return proto.CompactTextString(m) }
 func (*CafeUnstoreThreadAck) ProtoMessage()    {}
 func (*CafeUnstoreThreadAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{17}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{17}
 }
 func (m *CafeUnstoreThreadAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeUnstoreThreadAck.Unmarshal(m, b)
@@ -826,7 +826,7 @@ func (m *CafeDeliverMessage) Reset()         { *m = CafeDeliverMessage{} }
 func (m *CafeDeliverMessage) String() string { return proto.CompactTextString(m) }
 func (*CafeDeliverMessage) ProtoMessage()    {}
 func (*CafeDeliverMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{18}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{18}
 }
 func (m *CafeDeliverMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeliverMessage.Unmarshal(m, b)
@@ -871,7 +871,7 @@ func (m *CafeCheckMessages) Reset()         { *m = CafeCheckMessages{} }
 func (m *CafeCheckMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeCheckMessages) ProtoMessage()    {}
 func (*CafeCheckMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{19}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{19}
 }
 func (m *CafeCheckMessages) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeCheckMessages.Unmarshal(m, b)
@@ -909,7 +909,7 @@ func (m *CafeMessages) Reset()         { *m = CafeMessages{} }
 func (m *CafeMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeMessages) ProtoMessage()    {}
 func (*CafeMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{20}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{20}
 }
 func (m *CafeMessages) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeMessages.Unmarshal(m, b)
@@ -947,7 +947,7 @@ func (m *CafeDeleteMessages) Reset()         { *m = CafeDeleteMessages{} }
 func (m *CafeDeleteMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeDeleteMessages) ProtoMessage()    {}
 func (*CafeDeleteMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{21}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{21}
 }
 func (m *CafeDeleteMessages) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeleteMessages.Unmarshal(m, b)
@@ -985,7 +985,7 @@ func (m *CafeDeleteMessagesAck) Reset()         { *m = CafeDeleteMessagesAck{} }
 func (m *CafeDeleteMessagesAck) String() string { return proto.CompactTextString(m) }
 func (*CafeDeleteMessagesAck) ProtoMessage()    {}
 func (*CafeDeleteMessagesAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cafe_service_d48ef92619d0cc32, []int{22}
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{22}
 }
 func (m *CafeDeleteMessagesAck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CafeDeleteMessagesAck.Unmarshal(m, b)
@@ -1012,6 +1012,255 @@ func (m *CafeDeleteMessagesAck) GetMore() bool {
 	return false
 }
 
+// cafe-to-cafe replication of a client's data
+type CafeReplicate struct {
+	Client               *CafeClient            `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Pins                 []*CafeClientPin       `protobuf:"bytes,2,rep,name=pins,proto3" json:"pins,omitempty"`
+	Threads              []*CafeClientThread    `protobuf:"bytes,3,rep,name=threads,proto3" json:"threads,omitempty"`
+	Messages             []*CafeClientMessage   `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	Tombstones           []*CafeClientTombstone `protobuf:"bytes,5,rep,name=tombstones,proto3" json:"tombstones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *CafeReplicate) Reset()         { *m = CafeReplicate{} }
+func (m *CafeReplicate) String() string { return proto.CompactTextString(m) }
+func (*CafeReplicate) ProtoMessage()    {}
+func (*CafeReplicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{23}
+}
+func (m *CafeReplicate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplicate.Unmarshal(m, b)
+}
+func (m *CafeReplicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplicate.Marshal(b, m, deterministic)
+}
+func (dst *CafeReplicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplicate.Merge(dst, src)
+}
+func (m *CafeReplicate) XXX_Size() int {
+	return xxx_messageInfo_CafeReplicate.Size(m)
+}
+func (m *CafeReplicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplicate proto.InternalMessageInfo
+
+func (m *CafeReplicate) GetClient() *CafeClient {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetPins() []*CafeClientPin {
+	if m != nil {
+		return m.Pins
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetThreads() []*CafeClientThread {
+	if m != nil {
+		return m.Threads
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetMessages() []*CafeClientMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetTombstones() []*CafeClientTombstone {
+	if m != nil {
+		return m.Tombstones
+	}
+	return nil
+}
+
+type CafeReplicateAck struct {
+	Client               string   `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeReplicateAck) Reset()         { *m = CafeReplicateAck{} }
+func (m *CafeReplicateAck) String() string { return proto.CompactTextString(m) }
+func (*CafeReplicateAck) ProtoMessage()    {}
+func (*CafeReplicateAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{24}
+}
+func (m *CafeReplicateAck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplicateAck.Unmarshal(m, b)
+}
+func (m *CafeReplicateAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplicateAck.Marshal(b, m, deterministic)
+}
+func (dst *CafeReplicateAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplicateAck.Merge(dst, src)
+}
+func (m *CafeReplicateAck) XXX_Size() int {
+	return xxx_messageInfo_CafeReplicateAck.Size(m)
+}
+func (m *CafeReplicateAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplicateAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplicateAck proto.InternalMessageInfo
+
+func (m *CafeReplicateAck) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+type CafeSetWebhook struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeSetWebhook) Reset()         { *m = CafeSetWebhook{} }
+func (m *CafeSetWebhook) String() string { return proto.CompactTextString(m) }
+func (*CafeSetWebhook) ProtoMessage()    {}
+func (*CafeSetWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{25}
+}
+func (m *CafeSetWebhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeSetWebhook.Unmarshal(m, b)
+}
+func (m *CafeSetWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeSetWebhook.Marshal(b, m, deterministic)
+}
+func (dst *CafeSetWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeSetWebhook.Merge(dst, src)
+}
+func (m *CafeSetWebhook) XXX_Size() int {
+	return xxx_messageInfo_CafeSetWebhook.Size(m)
+}
+func (m *CafeSetWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeSetWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeSetWebhook proto.InternalMessageInfo
+
+func (m *CafeSetWebhook) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *CafeSetWebhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+type CafeSetWebhookAck struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeSetWebhookAck) Reset()         { *m = CafeSetWebhookAck{} }
+func (m *CafeSetWebhookAck) String() string { return proto.CompactTextString(m) }
+func (*CafeSetWebhookAck) ProtoMessage()    {}
+func (*CafeSetWebhookAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{26}
+}
+func (m *CafeSetWebhookAck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeSetWebhookAck.Unmarshal(m, b)
+}
+func (m *CafeSetWebhookAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeSetWebhookAck.Marshal(b, m, deterministic)
+}
+func (dst *CafeSetWebhookAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeSetWebhookAck.Merge(dst, src)
+}
+func (m *CafeSetWebhookAck) XXX_Size() int {
+	return xxx_messageInfo_CafeSetWebhookAck.Size(m)
+}
+func (m *CafeSetWebhookAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeSetWebhookAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeSetWebhookAck proto.InternalMessageInfo
+
+func (m *CafeSetWebhookAck) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+// posted to a client's webhook when a message is delivered to its inbox,
+// signed by the cafe's peer key
+type CafeWebhookMail struct {
+	Cafe                 string       `protobuf:"bytes,1,opt,name=cafe,proto3" json:"cafe,omitempty"`
+	Client               string       `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Message              *CafeMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CafeWebhookMail) Reset()         { *m = CafeWebhookMail{} }
+func (m *CafeWebhookMail) String() string { return proto.CompactTextString(m) }
+func (*CafeWebhookMail) ProtoMessage()    {}
+func (*CafeWebhookMail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cafe_service_27fda233a306e4b1, []int{27}
+}
+func (m *CafeWebhookMail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeWebhookMail.Unmarshal(m, b)
+}
+func (m *CafeWebhookMail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeWebhookMail.Marshal(b, m, deterministic)
+}
+func (dst *CafeWebhookMail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeWebhookMail.Merge(dst, src)
+}
+func (m *CafeWebhookMail) XXX_Size() int {
+	return xxx_messageInfo_CafeWebhookMail.Size(m)
+}
+func (m *CafeWebhookMail) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeWebhookMail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeWebhookMail proto.InternalMessageInfo
+
+func (m *CafeWebhookMail) GetCafe() string {
+	if m != nil {
+		return m.Cafe
+	}
+	return ""
+}
+
+func (m *CafeWebhookMail) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *CafeWebhookMail) GetMessage() *CafeMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CafeChallenge)(nil), "CafeChallenge")
 	proto.RegisterType((*CafeNonce)(nil), "CafeNonce")
@@ -1036,44 +1285,59 @@ func init() {
 	proto.RegisterType((*CafeMessages)(nil), "CafeMessages")
 	proto.RegisterType((*CafeDeleteMessages)(nil), "CafeDeleteMessages")
 	proto.RegisterType((*CafeDeleteMessagesAck)(nil), "CafeDeleteMessagesAck")
-}
-
-func init() { proto.RegisterFile("cafe_service.proto", fileDescriptor_cafe_service_d48ef92619d0cc32) }
-
-var fileDescriptor_cafe_service_d48ef92619d0cc32 = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdf, 0x6b, 0x13, 0x41,
-	0x10, 0xc7, 0xb9, 0x4b, 0x53, 0x9b, 0x49, 0x5a, 0xe3, 0x59, 0xe5, 0xf4, 0xa1, 0xc4, 0xa5, 0x68,
-	0xaa, 0x90, 0x87, 0x8a, 0xa8, 0xe0, 0x8b, 0x8d, 0xf8, 0xe4, 0x8f, 0x72, 0x55, 0x04, 0x11, 0x64,
-	0xb3, 0x37, 0xc9, 0xad, 0xb9, 0xdc, 0x86, 0xdd, 0x6d, 0xf0, 0xd1, 0x3f, 0x5d, 0xf6, 0xc7, 0x25,
-	0x8b, 0xb9, 0x20, 0x7d, 0x9b, 0xd9, 0xfb, 0xcc, 0x77, 0x66, 0x67, 0x66, 0x0f, 0x12, 0x46, 0xa7,
-	0xf8, 0x53, 0xa1, 0x5c, 0x71, 0x86, 0xa3, 0xa5, 0x14, 0x5a, 0x3c, 0xec, 0x2e, 0x44, 0x8e, 0xa5,
-	0x73, 0xc8, 0x19, 0x1c, 0x8e, 0xe9, 0x14, 0xc7, 0x05, 0x2d, 0x4b, 0xac, 0x66, 0x98, 0xa4, 0x70,
-	0x8b, 0xe6, 0xb9, 0x44, 0xa5, 0xd2, 0x68, 0x10, 0x0d, 0x3b, 0x59, 0xed, 0x92, 0x47, 0xd0, 0x31,
-	0xe8, 0x27, 0x51, 0x31, 0x4c, 0x8e, 0xa1, 0xbd, 0xa2, 0xe5, 0x35, 0x7a, 0xc8, 0x39, 0xe4, 0x4f,
-	0x04, 0x7d, 0xc3, 0x64, 0x38, 0xe3, 0x4a, 0x4b, 0xaa, 0xb9, 0xa8, 0x76, 0x2b, 0x6e, 0x44, 0xe2,
-	0x40, 0xc4, 0x9c, 0x56, 0x26, 0x47, 0xda, 0x72, 0xa7, 0xd6, 0x49, 0xfa, 0xd0, 0x52, 0x7c, 0x96,
-	0xee, 0x0d, 0xa2, 0x61, 0x2f, 0x33, 0xa6, 0xe1, 0xb4, 0x98, 0x63, 0x95, 0xb6, 0x1d, 0x67, 0x1d,
-	0xf2, 0x14, 0x12, 0x53, 0xc1, 0x3b, 0x94, 0x61, 0x0d, 0x6b, 0x36, 0x0a, 0xd9, 0x27, 0x70, 0x6f,
-	0x9b, 0x7d, 0xcb, 0xe6, 0xc9, 0x11, 0xc4, 0x3c, 0xf7, 0x6c, 0xcc, 0x73, 0xf2, 0xde, 0x89, 0x66,
-	0x38, 0x95, 0xa8, 0x8a, 0x2b, 0x54, 0xca, 0x88, 0xde, 0x87, 0x7d, 0xca, 0xd8, 0xe6, 0x5e, 0xde,
-	0x33, 0x17, 0x96, 0x8e, 0xf4, 0x17, 0xab, 0x5d, 0x72, 0x01, 0xb7, 0x8d, 0xce, 0xe5, 0xf5, 0xa4,
-	0xe4, 0xaa, 0xb8, 0x44, 0x94, 0xcd, 0x95, 0x25, 0x0f, 0x60, 0x6f, 0x89, 0x28, 0x6d, 0x7c, 0xf7,
-	0xbc, 0x3d, 0x32, 0x68, 0x66, 0x8f, 0xc8, 0xa9, 0xab, 0x25, 0xd0, 0x68, 0xaa, 0xf8, 0x85, 0x1b,
-	0xd6, 0x95, 0x16, 0x12, 0x77, 0xe4, 0x48, 0x60, 0x8f, 0xf1, 0x5c, 0xa5, 0xf1, 0xa0, 0x35, 0xec,
-	0x64, 0xd6, 0x26, 0x27, 0xd0, 0x5b, 0x87, 0x35, 0xc9, 0xbe, 0x84, 0xae, 0xf9, 0xfe, 0xb5, 0x52,
-	0x37, 0x14, 0x3e, 0x85, 0xa3, 0x20, 0xd0, 0x48, 0xd7, 0x54, 0xb4, 0x4d, 0x7d, 0x9e, 0xfc, 0x42,
-	0xa6, 0x3f, 0x70, 0xa5, 0x1b, 0xa9, 0x1f, 0x00, 0x1b, 0x6a, 0x47, 0x0d, 0x7d, 0x68, 0x31, 0x9e,
-	0xfb, 0xfe, 0x1b, 0xd3, 0x28, 0xe5, 0x54, 0x53, 0xbb, 0x55, 0xbd, 0xcc, 0xda, 0xe6, 0xac, 0x12,
-	0x39, 0xfa, 0xad, 0xb2, 0x36, 0xf9, 0xe6, 0x66, 0x64, 0x5b, 0xf0, 0xa5, 0x90, 0x48, 0xf3, 0x1d,
-	0x29, 0x5c, 0x6f, 0xe2, 0xba, 0x37, 0xc9, 0x09, 0x00, 0xe3, 0xcb, 0x02, 0xa5, 0xc6, 0xdf, 0xda,
-	0xa7, 0x09, 0x4e, 0xea, 0xc1, 0x05, 0xc2, 0x4d, 0x1d, 0x7e, 0x0d, 0x77, 0x82, 0x46, 0xdd, 0xa4,
-	0x00, 0xf2, 0x18, 0x8e, 0xb7, 0x42, 0x9b, 0x52, 0xbc, 0xa9, 0x9f, 0x48, 0xc9, 0x57, 0x28, 0x3f,
-	0xa2, 0x52, 0x74, 0x86, 0xff, 0x52, 0x66, 0xbb, 0x59, 0xc9, 0xb1, 0xd2, 0x3e, 0x83, 0xf7, 0xc8,
-	0x99, 0x2b, 0x70, 0x5c, 0x20, 0x9b, 0xfb, 0x58, 0xb5, 0xe3, 0x7d, 0xbd, 0x72, 0xdb, 0xb4, 0xa6,
-	0x86, 0x70, 0xb0, 0xf0, 0xb6, 0x1d, 0x68, 0xf7, 0xbc, 0x37, 0x0a, 0x80, 0x6c, 0xfd, 0x75, 0xf3,
-	0x8a, 0x4b, 0xd4, 0xf8, 0x9f, 0x2c, 0xcf, 0xea, 0x57, 0x1c, 0xb2, 0x7e, 0xc3, 0x16, 0x42, 0xba,
-	0x5f, 0xd4, 0x41, 0x66, 0xed, 0x8b, 0xbb, 0x70, 0xc8, 0xc5, 0xc8, 0xcc, 0x83, 0x97, 0x38, 0x5a,
-	0x4e, 0xbe, 0xc7, 0xcb, 0xc9, 0x64, 0xdf, 0xfe, 0x0b, 0x9f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xc4, 0x0f, 0x1d, 0x56, 0x2e, 0x05, 0x00, 0x00,
+	proto.RegisterType((*CafeReplicate)(nil), "CafeReplicate")
+	proto.RegisterType((*CafeReplicateAck)(nil), "CafeReplicateAck")
+	proto.RegisterType((*CafeSetWebhook)(nil), "CafeSetWebhook")
+	proto.RegisterType((*CafeSetWebhookAck)(nil), "CafeSetWebhookAck")
+	proto.RegisterType((*CafeWebhookMail)(nil), "CafeWebhookMail")
+}
+
+func init() { proto.RegisterFile("cafe_service.proto", fileDescriptor_cafe_service_27fda233a306e4b1) }
+
+var fileDescriptor_cafe_service_27fda233a306e4b1 = []byte{
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xdd, 0x4f, 0x13, 0x41,
+	0x10, 0xc0, 0x73, 0xfd, 0xe0, 0x63, 0x5a, 0xa0, 0xac, 0x40, 0x4e, 0x1f, 0x08, 0xae, 0x88, 0x05,
+	0x92, 0x3e, 0xa0, 0x46, 0x4c, 0x7c, 0x91, 0x1a, 0x9f, 0x44, 0xc9, 0xa1, 0x21, 0x31, 0x26, 0xe6,
+	0x7a, 0x37, 0x6d, 0xd7, 0x5e, 0x6f, 0x9b, 0xdd, 0x2d, 0xf1, 0xd1, 0xff, 0xd7, 0x7f, 0xc2, 0xec,
+	0xc7, 0xb5, 0x0b, 0xed, 0xc5, 0xf0, 0x36, 0xb3, 0xfb, 0x9b, 0xcf, 0x9d, 0xb9, 0x03, 0x92, 0xc4,
+	0x7d, 0xfc, 0x29, 0x51, 0xdc, 0xb2, 0x04, 0x3b, 0x13, 0xc1, 0x15, 0x7f, 0xd2, 0x18, 0xf3, 0x14,
+	0x33, 0xab, 0xd0, 0x63, 0xd8, 0xe8, 0xc6, 0x7d, 0xec, 0x0e, 0xe3, 0x2c, 0xc3, 0x7c, 0x80, 0x24,
+	0x84, 0xd5, 0x38, 0x4d, 0x05, 0x4a, 0x19, 0x06, 0x07, 0x41, 0x7b, 0x3d, 0x2a, 0x54, 0xfa, 0x14,
+	0xd6, 0x35, 0xfa, 0x99, 0xe7, 0x09, 0x92, 0x1d, 0xa8, 0xdf, 0xc6, 0xd9, 0x14, 0x1d, 0x64, 0x15,
+	0xfa, 0x27, 0x80, 0x96, 0x66, 0x22, 0x1c, 0x30, 0xa9, 0x44, 0xac, 0x18, 0xcf, 0xcb, 0x3d, 0xce,
+	0x9d, 0x54, 0x3c, 0x27, 0xfa, 0x34, 0xd7, 0x31, 0xc2, 0xaa, 0x3d, 0x35, 0x0a, 0x69, 0x41, 0x55,
+	0xb2, 0x41, 0x58, 0x3b, 0x08, 0xda, 0xcd, 0x48, 0x8b, 0x9a, 0x53, 0x7c, 0x84, 0x79, 0x58, 0xb7,
+	0x9c, 0x51, 0xe8, 0x09, 0x10, 0x9d, 0xc1, 0x07, 0x14, 0x7e, 0x0e, 0x33, 0x36, 0xf0, 0xd9, 0x17,
+	0xb0, 0xbb, 0xc8, 0xbe, 0x4f, 0x46, 0x64, 0x13, 0x2a, 0x2c, 0x75, 0x6c, 0x85, 0xa5, 0xf4, 0xa3,
+	0x75, 0x1a, 0x61, 0x5f, 0xa0, 0x1c, 0x5e, 0xa3, 0x94, 0xda, 0xe9, 0x1e, 0xac, 0xc4, 0x49, 0x32,
+	0xaf, 0xcb, 0x69, 0xba, 0x60, 0x61, 0x49, 0x57, 0x58, 0xa1, 0xd2, 0x0b, 0xd8, 0xd2, 0x7e, 0xae,
+	0xa6, 0xbd, 0x8c, 0xc9, 0xe1, 0x15, 0xa2, 0x58, 0x9e, 0x19, 0x79, 0x0c, 0xb5, 0x09, 0xa2, 0x30,
+	0xf6, 0x8d, 0xb3, 0x7a, 0x47, 0xa3, 0x91, 0x39, 0xa2, 0x87, 0x40, 0xee, 0xf9, 0x58, 0x96, 0xf1,
+	0x6b, 0xfb, 0x58, 0xd7, 0x8a, 0x0b, 0x2c, 0x89, 0x41, 0xa0, 0x96, 0xb0, 0x54, 0x86, 0x95, 0x83,
+	0x6a, 0x7b, 0x3d, 0x32, 0x32, 0xdd, 0x87, 0xe6, 0xcc, 0x6c, 0x99, 0xdb, 0x37, 0xd0, 0xd0, 0xf7,
+	0xdf, 0x72, 0xf9, 0x40, 0xc7, 0x87, 0xb0, 0xe9, 0x19, 0x6a, 0xd7, 0x05, 0x15, 0x2c, 0x52, 0x5f,
+	0x7a, 0xbf, 0x30, 0x51, 0x9f, 0x98, 0x54, 0x4b, 0xa9, 0x1f, 0x00, 0x73, 0xaa, 0x24, 0x87, 0x16,
+	0x54, 0x13, 0x96, 0xba, 0xfe, 0x6b, 0x51, 0x7b, 0x4a, 0x63, 0x15, 0x9b, 0xa9, 0x6a, 0x46, 0x46,
+	0xd6, 0x67, 0x39, 0x4f, 0xd1, 0x4d, 0x95, 0x91, 0xe9, 0x0d, 0x6c, 0xcd, 0x5a, 0xf0, 0x75, 0x28,
+	0x30, 0x4e, 0x4b, 0x42, 0xd8, 0xde, 0x54, 0x8a, 0xde, 0x90, 0x7d, 0x80, 0x84, 0x4d, 0x86, 0x28,
+	0x14, 0xfe, 0x56, 0x2e, 0x8c, 0x77, 0x52, 0x3c, 0x9c, 0xe7, 0x78, 0x59, 0x87, 0xdf, 0xc2, 0xb6,
+	0xd7, 0xa8, 0x87, 0x24, 0x40, 0x8f, 0x60, 0x67, 0xc1, 0x74, 0x59, 0x88, 0x77, 0xc5, 0x8a, 0x64,
+	0xec, 0x16, 0xc5, 0x25, 0x4a, 0x19, 0x0f, 0xf0, 0x3e, 0xa5, 0xa7, 0x3b, 0xc9, 0x18, 0xe6, 0xca,
+	0x45, 0x70, 0x1a, 0x3d, 0xb6, 0x09, 0x76, 0x87, 0x98, 0x8c, 0x9c, 0xad, 0x2c, 0xd9, 0xaf, 0x73,
+	0x3b, 0x4d, 0x33, 0xaa, 0x0d, 0x6b, 0x63, 0x27, 0x9b, 0x07, 0x6d, 0x9c, 0x35, 0x3b, 0x1e, 0x10,
+	0xcd, 0x6e, 0xe7, 0x5b, 0x9c, 0xa1, 0xc2, 0xff, 0x44, 0x39, 0x85, 0xdd, 0x45, 0xd6, 0x4d, 0xd8,
+	0x98, 0x0b, 0xfb, 0x89, 0x5a, 0x8b, 0x8c, 0x4c, 0xff, 0x06, 0xf6, 0x83, 0x17, 0xe1, 0x24, 0x63,
+	0x49, 0xac, 0x90, 0x3c, 0x9b, 0xd5, 0x19, 0x98, 0x65, 0x6b, 0x98, 0x94, 0xba, 0xe6, 0xa8, 0x28,
+	0x9a, 0x50, 0xa8, 0x4d, 0x58, 0x6e, 0x47, 0xba, 0x71, 0xb6, 0xe9, 0x21, 0x57, 0x2c, 0x8f, 0xcc,
+	0x1d, 0x39, 0x85, 0x55, 0x65, 0x7a, 0x2e, 0xc3, 0xaa, 0xc1, 0xb6, 0x3d, 0xcc, 0xbe, 0x46, 0x54,
+	0x10, 0xa4, 0xe3, 0xb5, 0xa2, 0x66, 0x68, 0xe2, 0xd1, 0x0b, 0x0d, 0x21, 0xaf, 0x00, 0x14, 0x1f,
+	0xf7, 0xa4, 0xe2, 0x39, 0xca, 0xb0, 0x6e, 0x2c, 0x76, 0x7c, 0xff, 0xc5, 0x65, 0xe4, 0x71, 0xf4,
+	0x04, 0x5a, 0x77, 0x8a, 0xd5, 0x5d, 0xd9, 0xbb, 0x53, 0xef, 0xfc, 0x5d, 0xcf, 0xed, 0xee, 0x5d,
+	0xa3, 0xba, 0xc1, 0xde, 0x90, 0xf3, 0x51, 0xf9, 0x66, 0x4d, 0x45, 0x56, 0x6c, 0xd6, 0x54, 0x64,
+	0xf4, 0x39, 0x6c, 0xdf, 0xb5, 0xd4, 0x61, 0x1c, 0x16, 0xcc, 0x31, 0xb4, 0x8b, 0xe5, 0x98, 0xcb,
+	0x98, 0x65, 0x66, 0xbb, 0xe3, 0x7e, 0xf1, 0x13, 0x31, 0x72, 0xd9, 0xdc, 0x91, 0x23, 0x58, 0x75,
+	0xdd, 0x30, 0xbb, 0x75, 0x7f, 0x76, 0x8a, 0xcb, 0x8b, 0x47, 0xb0, 0xc1, 0x78, 0x47, 0x6f, 0x1c,
+	0xcb, 0xb0, 0x33, 0xe9, 0x7d, 0xaf, 0x4c, 0x7a, 0xbd, 0x15, 0xf3, 0xb7, 0x7b, 0xf9, 0x6f, 0x00,
+	0x08, 0xe9, 0x63, 0x19, 0x10, 0x07, 0x00, 0x00,
 }
@@ -47,7 +47,7 @@ func (x Thread_Type) String() string {
 	return proto.EnumName(Thread_Type_name, int32(x))
 }
 func (Thread_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{5, 0}
+	return fileDescriptor_model_c52c3115150ad454, []int{5, 0}
 }
 
 // Sharing controls if (Y/N) a thread can be shared
@@ -74,7 +74,7 @@ func (x Thread_Sharing) String() string {
 	return proto.EnumName(Thread_Sharing_name, int32(x))
 }
 func (Thread_Sharing) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{5, 1}
+	return fileDescriptor_model_c52c3115150ad454, []int{5, 1}
 }
 
 // State indicates the loading state
@@ -101,23 +101,61 @@ func (x Thread_State) String() string {
 	return proto.EnumName(Thread_State_name, int32(x))
 }
 func (Thread_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{5, 2}
+	return fileDescriptor_model_c52c3115150ad454, []int{5, 2}
+}
+
+// Role overrides the access an address is given by type and whitelist
+type Thread_Role int32
+
+const (
+	Thread_NONE     Thread_Role = 0
+	Thread_READ     Thread_Role = 1
+	Thread_ANNOTATE Thread_Role = 2
+	Thread_WRITE    Thread_Role = 3
+	Thread_ADMIN    Thread_Role = 4
+)
+
+var Thread_Role_name = map[int32]string{
+	0: "NONE",
+	1: "READ",
+	2: "ANNOTATE",
+	3: "WRITE",
+	4: "ADMIN",
+}
+var Thread_Role_value = map[string]int32{
+	"NONE":     0,
+	"READ":     1,
+	"ANNOTATE": 2,
+	"WRITE":    3,
+	"ADMIN":    4,
+}
+
+func (x Thread_Role) String() string {
+	return proto.EnumName(Thread_Role_name, int32(x))
+}
+func (Thread_Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{5, 3}
 }
 
 type Block_BlockType int32
 
 const (
-	Block_MERGE    Block_BlockType = 0
-	Block_IGNORE   Block_BlockType = 1
-	Block_FLAG     Block_BlockType = 2
-	Block_JOIN     Block_BlockType = 3
-	Block_ANNOUNCE Block_BlockType = 4
-	Block_LEAVE    Block_BlockType = 5
-	Block_TEXT     Block_BlockType = 6
-	Block_FILES    Block_BlockType = 7
-	Block_COMMENT  Block_BlockType = 8
-	Block_LIKE     Block_BlockType = 9
-	Block_ADD      Block_BlockType = 50
+	Block_MERGE       Block_BlockType = 0
+	Block_IGNORE      Block_BlockType = 1
+	Block_FLAG        Block_BlockType = 2
+	Block_JOIN        Block_BlockType = 3
+	Block_ANNOUNCE    Block_BlockType = 4
+	Block_LEAVE       Block_BlockType = 5
+	Block_TEXT        Block_BlockType = 6
+	Block_FILES       Block_BlockType = 7
+	Block_COMMENT     Block_BlockType = 8
+	Block_LIKE        Block_BlockType = 9
+	Block_ROLE_GRANT  Block_BlockType = 10
+	Block_ROLE_REVOKE Block_BlockType = 11
+	Block_KEY_ROTATE  Block_BlockType = 12
+	Block_EDIT        Block_BlockType = 13
+	Block_REACTION    Block_BlockType = 14
+	Block_ADD         Block_BlockType = 50
 )
 
 var Block_BlockType_name = map[int32]string{
@@ -131,27 +169,37 @@ var Block_BlockType_name = map[int32]string{
 	7:  "FILES",
 	8:  "COMMENT",
 	9:  "LIKE",
+	10: "ROLE_GRANT",
+	11: "ROLE_REVOKE",
+	12: "KEY_ROTATE",
+	13: "EDIT",
+	14: "REACTION",
 	50: "ADD",
 }
 var Block_BlockType_value = map[string]int32{
-	"MERGE":    0,
-	"IGNORE":   1,
-	"FLAG":     2,
-	"JOIN":     3,
-	"ANNOUNCE": 4,
-	"LEAVE":    5,
-	"TEXT":     6,
-	"FILES":    7,
-	"COMMENT":  8,
-	"LIKE":     9,
-	"ADD":      50,
+	"MERGE":       0,
+	"IGNORE":      1,
+	"FLAG":        2,
+	"JOIN":        3,
+	"ANNOUNCE":    4,
+	"LEAVE":       5,
+	"TEXT":        6,
+	"FILES":       7,
+	"COMMENT":     8,
+	"LIKE":        9,
+	"ROLE_GRANT":  10,
+	"ROLE_REVOKE": 11,
+	"KEY_ROTATE":  12,
+	"EDIT":        13,
+	"REACTION":    14,
+	"ADD":         50,
 }
 
 func (x Block_BlockType) String() string {
 	return proto.EnumName(Block_BlockType_name, int32(x))
 }
 func (Block_BlockType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{8, 0}
+	return fileDescriptor_model_c52c3115150ad454, []int{8, 0}
 }
 
 type Notification_Type int32
@@ -165,17 +213,25 @@ const (
 	Notification_FILES_ADDED         Notification_Type = 5
 	Notification_COMMENT_ADDED       Notification_Type = 6
 	Notification_LIKE_ADDED          Notification_Type = 7
+	Notification_BLOCK_REJECTED      Notification_Type = 8
+	Notification_ROLE_CHANGED        Notification_Type = 9
+	Notification_MENTIONED           Notification_Type = 10
+	Notification_REACTION_ADDED      Notification_Type = 11
 )
 
 var Notification_Type_name = map[int32]string{
-	0: "INVITE_RECEIVED",
-	1: "ACCOUNT_PEER_JOINED",
-	2: "PEER_JOINED",
-	3: "PEER_LEFT",
-	4: "MESSAGE_ADDED",
-	5: "FILES_ADDED",
-	6: "COMMENT_ADDED",
-	7: "LIKE_ADDED",
+	0:  "INVITE_RECEIVED",
+	1:  "ACCOUNT_PEER_JOINED",
+	2:  "PEER_JOINED",
+	3:  "PEER_LEFT",
+	4:  "MESSAGE_ADDED",
+	5:  "FILES_ADDED",
+	6:  "COMMENT_ADDED",
+	7:  "LIKE_ADDED",
+	8:  "BLOCK_REJECTED",
+	9:  "ROLE_CHANGED",
+	10: "MENTIONED",
+	11: "REACTION_ADDED",
 }
 var Notification_Type_value = map[string]int32{
 	"INVITE_RECEIVED":     0,
@@ -186,13 +242,70 @@ var Notification_Type_value = map[string]int32{
 	"FILES_ADDED":         5,
 	"COMMENT_ADDED":       6,
 	"LIKE_ADDED":          7,
+	"BLOCK_REJECTED":      8,
+	"ROLE_CHANGED":        9,
+	"MENTIONED":           10,
+	"REACTION_ADDED":      11,
 }
 
 func (x Notification_Type) String() string {
 	return proto.EnumName(Notification_Type_name, int32(x))
 }
 func (Notification_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{16, 0}
+	return fileDescriptor_model_c52c3115150ad454, []int{24, 0}
+}
+
+type Change_Type int32
+
+const (
+	Change_BLOCK_ADDED          Change_Type = 0
+	Change_BLOCK_REMOVED        Change_Type = 1
+	Change_BLOCK_IGNORED        Change_Type = 2
+	Change_FILE_INDEXED         Change_Type = 3
+	Change_FILE_REMOVED         Change_Type = 4
+	Change_NOTIFICATION_ADDED   Change_Type = 5
+	Change_NOTIFICATION_READ    Change_Type = 6
+	Change_NOTIFICATION_REMOVED Change_Type = 7
+	Change_THREAD_ADDED         Change_Type = 8
+	Change_THREAD_UPDATED       Change_Type = 9
+	Change_THREAD_REMOVED       Change_Type = 10
+	Change_BLOCK_EDITED         Change_Type = 11
+)
+
+var Change_Type_name = map[int32]string{
+	0:  "BLOCK_ADDED",
+	1:  "BLOCK_REMOVED",
+	2:  "BLOCK_IGNORED",
+	3:  "FILE_INDEXED",
+	4:  "FILE_REMOVED",
+	5:  "NOTIFICATION_ADDED",
+	6:  "NOTIFICATION_READ",
+	7:  "NOTIFICATION_REMOVED",
+	8:  "THREAD_ADDED",
+	9:  "THREAD_UPDATED",
+	10: "THREAD_REMOVED",
+	11: "BLOCK_EDITED",
+}
+var Change_Type_value = map[string]int32{
+	"BLOCK_ADDED":          0,
+	"BLOCK_REMOVED":        1,
+	"BLOCK_IGNORED":        2,
+	"FILE_INDEXED":         3,
+	"FILE_REMOVED":         4,
+	"NOTIFICATION_ADDED":   5,
+	"NOTIFICATION_READ":    6,
+	"NOTIFICATION_REMOVED": 7,
+	"THREAD_ADDED":         8,
+	"THREAD_UPDATED":       9,
+	"THREAD_REMOVED":       10,
+	"BLOCK_EDITED":         11,
+}
+
+func (x Change_Type) String() string {
+	return proto.EnumName(Change_Type_name, int32(x))
+}
+func (Change_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{28, 0}
 }
 
 type CafeRequest_Type int32
@@ -224,7 +337,7 @@ func (x CafeRequest_Type) String() string {
 	return proto.EnumName(CafeRequest_Type_name, int32(x))
 }
 func (CafeRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{21, 0}
+	return fileDescriptor_model_c52c3115150ad454, []int{33, 0}
 }
 
 type CafeRequest_Status int32
@@ -250,7 +363,7 @@ func (x CafeRequest_Status) String() string {
 	return proto.EnumName(CafeRequest_Status_name, int32(x))
 }
 func (CafeRequest_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{21, 1}
+	return fileDescriptor_model_c52c3115150ad454, []int{33, 1}
 }
 
 type CafeHTTPRequest_Type int32
@@ -276,7 +389,36 @@ func (x CafeHTTPRequest_Type) String() string {
 	return proto.EnumName(CafeHTTPRequest_Type_name, int32(x))
 }
 func (CafeHTTPRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{24, 0}
+	return fileDescriptor_model_c52c3115150ad454, []int{36, 0}
+}
+
+type CafeClientTombstone_Type int32
+
+const (
+	CafeClientTombstone_PIN      CafeClientTombstone_Type = 0
+	CafeClientTombstone_THREAD   CafeClientTombstone_Type = 1
+	CafeClientTombstone_MESSAGES CafeClientTombstone_Type = 2
+	CafeClientTombstone_CLIENT   CafeClientTombstone_Type = 3
+)
+
+var CafeClientTombstone_Type_name = map[int32]string{
+	0: "PIN",
+	1: "THREAD",
+	2: "MESSAGES",
+	3: "CLIENT",
+}
+var CafeClientTombstone_Type_value = map[string]int32{
+	"PIN":      0,
+	"THREAD":   1,
+	"MESSAGES": 2,
+	"CLIENT":   3,
+}
+
+func (x CafeClientTombstone_Type) String() string {
+	return proto.EnumName(CafeClientTombstone_Type_name, int32(x))
+}
+func (CafeClientTombstone_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{50, 0}
 }
 
 type Peer struct {
@@ -296,7 +438,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{0}
+	return fileDescriptor_model_c52c3115150ad454, []int{0}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peer.Unmarshal(m, b)
@@ -376,7 +518,7 @@ func (m *PeerList) Reset()         { *m = PeerList{} }
 func (m *PeerList) String() string { return proto.CompactTextString(m) }
 func (*PeerList) ProtoMessage()    {}
 func (*PeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{1}
+	return fileDescriptor_model_c52c3115150ad454, []int{1}
 }
 func (m *PeerList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerList.Unmarshal(m, b)
@@ -416,7 +558,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{2}
+	return fileDescriptor_model_c52c3115150ad454, []int{2}
 }
 func (m *User) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_User.Unmarshal(m, b)
@@ -472,7 +614,7 @@ func (m *Contact) Reset()         { *m = Contact{} }
 func (m *Contact) String() string { return proto.CompactTextString(m) }
 func (*Contact) ProtoMessage()    {}
 func (*Contact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{3}
+	return fileDescriptor_model_c52c3115150ad454, []int{3}
 }
 func (m *Contact) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Contact.Unmarshal(m, b)
@@ -538,7 +680,7 @@ func (m *ContactList) Reset()         { *m = ContactList{} }
 func (m *ContactList) String() string { return proto.CompactTextString(m) }
 func (*ContactList) ProtoMessage()    {}
 func (*ContactList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{4}
+	return fileDescriptor_model_c52c3115150ad454, []int{4}
 }
 func (m *ContactList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContactList.Unmarshal(m, b)
@@ -591,7 +733,7 @@ func (m *Thread) Reset()         { *m = Thread{} }
 func (m *Thread) String() string { return proto.CompactTextString(m) }
 func (*Thread) ProtoMessage()    {}
 func (*Thread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{5}
+	return fileDescriptor_model_c52c3115150ad454, []int{5}
 }
 func (m *Thread) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Thread.Unmarshal(m, b)
@@ -727,7 +869,7 @@ func (m *ThreadList) Reset()         { *m = ThreadList{} }
 func (m *ThreadList) String() string { return proto.CompactTextString(m) }
 func (*ThreadList) ProtoMessage()    {}
 func (*ThreadList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{6}
+	return fileDescriptor_model_c52c3115150ad454, []int{6}
 }
 func (m *ThreadList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadList.Unmarshal(m, b)
@@ -767,7 +909,7 @@ func (m *ThreadPeer) Reset()         { *m = ThreadPeer{} }
 func (m *ThreadPeer) String() string { return proto.CompactTextString(m) }
 func (*ThreadPeer) ProtoMessage()    {}
 func (*ThreadPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{7}
+	return fileDescriptor_model_c52c3115150ad454, []int{7}
 }
 func (m *ThreadPeer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadPeer.Unmarshal(m, b)
@@ -828,7 +970,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{8}
+	return fileDescriptor_model_c52c3115150ad454, []int{8}
 }
 func (m *Block) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Block.Unmarshal(m, b)
@@ -922,7 +1064,7 @@ func (m *BlockList) Reset()         { *m = BlockList{} }
 func (m *BlockList) String() string { return proto.CompactTextString(m) }
 func (*BlockList) ProtoMessage()    {}
 func (*BlockList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{9}
+	return fileDescriptor_model_c52c3115150ad454, []int{9}
 }
 func (m *BlockList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockList.Unmarshal(m, b)
@@ -963,7 +1105,7 @@ func (m *BlockMessage) Reset()         { *m = BlockMessage{} }
 func (m *BlockMessage) String() string { return proto.CompactTextString(m) }
 func (*BlockMessage) ProtoMessage()    {}
 func (*BlockMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{10}
+	return fileDescriptor_model_c52c3115150ad454, []int{10}
 }
 func (m *BlockMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockMessage.Unmarshal(m, b)
@@ -1011,1439 +1153,2656 @@ func (m *BlockMessage) GetDate() *timestamp.Timestamp {
 	return nil
 }
 
-type Invite struct {
+type RejectedBlock struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Block                []byte               `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
-	Name                 string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Inviter              *Peer                `protobuf:"bytes,4,opt,name=inviter,proto3" json:"inviter,omitempty"`
-	Date                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Thread               string               `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Author               string               `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Address              string               `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Type                 Block_BlockType      `protobuf:"varint,5,opt,name=type,proto3,enum=Block_BlockType" json:"type,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	Reason               string               `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *Invite) Reset()         { *m = Invite{} }
-func (m *Invite) String() string { return proto.CompactTextString(m) }
-func (*Invite) ProtoMessage()    {}
-func (*Invite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{11}
+func (m *RejectedBlock) Reset()         { *m = RejectedBlock{} }
+func (m *RejectedBlock) String() string { return proto.CompactTextString(m) }
+func (*RejectedBlock) ProtoMessage()    {}
+func (*RejectedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{11}
 }
-func (m *Invite) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Invite.Unmarshal(m, b)
+func (m *RejectedBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RejectedBlock.Unmarshal(m, b)
 }
-func (m *Invite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Invite.Marshal(b, m, deterministic)
+func (m *RejectedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RejectedBlock.Marshal(b, m, deterministic)
 }
-func (dst *Invite) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Invite.Merge(dst, src)
+func (dst *RejectedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectedBlock.Merge(dst, src)
 }
-func (m *Invite) XXX_Size() int {
-	return xxx_messageInfo_Invite.Size(m)
+func (m *RejectedBlock) XXX_Size() int {
+	return xxx_messageInfo_RejectedBlock.Size(m)
 }
-func (m *Invite) XXX_DiscardUnknown() {
-	xxx_messageInfo_Invite.DiscardUnknown(m)
+func (m *RejectedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectedBlock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Invite proto.InternalMessageInfo
+var xxx_messageInfo_RejectedBlock proto.InternalMessageInfo
 
-func (m *Invite) GetId() string {
+func (m *RejectedBlock) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *Invite) GetBlock() []byte {
+func (m *RejectedBlock) GetThread() string {
 	if m != nil {
-		return m.Block
+		return m.Thread
 	}
-	return nil
+	return ""
 }
 
-func (m *Invite) GetName() string {
+func (m *RejectedBlock) GetAuthor() string {
 	if m != nil {
-		return m.Name
+		return m.Author
 	}
 	return ""
 }
 
-func (m *Invite) GetInviter() *Peer {
+func (m *RejectedBlock) GetAddress() string {
 	if m != nil {
-		return m.Inviter
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func (m *Invite) GetDate() *timestamp.Timestamp {
+func (m *RejectedBlock) GetType() Block_BlockType {
+	if m != nil {
+		return m.Type
+	}
+	return Block_MERGE
+}
+
+func (m *RejectedBlock) GetDate() *timestamp.Timestamp {
 	if m != nil {
 		return m.Date
 	}
 	return nil
 }
 
-type InviteList struct {
-	Items                []*Invite `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *RejectedBlock) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *InviteList) Reset()         { *m = InviteList{} }
-func (m *InviteList) String() string { return proto.CompactTextString(m) }
-func (*InviteList) ProtoMessage()    {}
-func (*InviteList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{12}
+type RejectedBlockList struct {
+	Items                []*RejectedBlock `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *InviteList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InviteList.Unmarshal(m, b)
+
+func (m *RejectedBlockList) Reset()         { *m = RejectedBlockList{} }
+func (m *RejectedBlockList) String() string { return proto.CompactTextString(m) }
+func (*RejectedBlockList) ProtoMessage()    {}
+func (*RejectedBlockList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{12}
 }
-func (m *InviteList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InviteList.Marshal(b, m, deterministic)
+func (m *RejectedBlockList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RejectedBlockList.Unmarshal(m, b)
 }
-func (dst *InviteList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InviteList.Merge(dst, src)
+func (m *RejectedBlockList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RejectedBlockList.Marshal(b, m, deterministic)
 }
-func (m *InviteList) XXX_Size() int {
-	return xxx_messageInfo_InviteList.Size(m)
+func (dst *RejectedBlockList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectedBlockList.Merge(dst, src)
 }
-func (m *InviteList) XXX_DiscardUnknown() {
-	xxx_messageInfo_InviteList.DiscardUnknown(m)
+func (m *RejectedBlockList) XXX_Size() int {
+	return xxx_messageInfo_RejectedBlockList.Size(m)
+}
+func (m *RejectedBlockList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectedBlockList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InviteList proto.InternalMessageInfo
+var xxx_messageInfo_RejectedBlockList proto.InternalMessageInfo
 
-func (m *InviteList) GetItems() []*Invite {
+func (m *RejectedBlockList) GetItems() []*RejectedBlock {
 	if m != nil {
 		return m.Items
 	}
 	return nil
 }
 
-type FileIndex struct {
-	Mill                 string               `protobuf:"bytes,1,opt,name=mill,proto3" json:"mill,omitempty"`
-	Checksum             string               `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	Source               string               `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Opts                 string               `protobuf:"bytes,4,opt,name=opts,proto3" json:"opts,omitempty"`
-	Hash                 string               `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
-	Key                  string               `protobuf:"bytes,6,opt,name=key,proto3" json:"key,omitempty"`
-	Media                string               `protobuf:"bytes,7,opt,name=media,proto3" json:"media,omitempty"`
-	Name                 string               `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
-	Size                 int64                `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
-	Added                *timestamp.Timestamp `protobuf:"bytes,10,opt,name=added,proto3" json:"added,omitempty"`
-	Meta                 *_struct.Struct      `protobuf:"bytes,11,opt,name=meta,proto3" json:"meta,omitempty"`
-	Targets              []string             `protobuf:"bytes,12,rep,name=targets,proto3" json:"targets,omitempty"`
+type ThreadKey struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Thread               string               `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Epoch                int32                `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Sk                   []byte               `protobuf:"bytes,4,opt,name=sk,proto3" json:"sk,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *FileIndex) Reset()         { *m = FileIndex{} }
-func (m *FileIndex) String() string { return proto.CompactTextString(m) }
-func (*FileIndex) ProtoMessage()    {}
-func (*FileIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{13}
+func (m *ThreadKey) Reset()         { *m = ThreadKey{} }
+func (m *ThreadKey) String() string { return proto.CompactTextString(m) }
+func (*ThreadKey) ProtoMessage()    {}
+func (*ThreadKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{13}
 }
-func (m *FileIndex) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FileIndex.Unmarshal(m, b)
+func (m *ThreadKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadKey.Unmarshal(m, b)
 }
-func (m *FileIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FileIndex.Marshal(b, m, deterministic)
+func (m *ThreadKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadKey.Marshal(b, m, deterministic)
 }
-func (dst *FileIndex) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FileIndex.Merge(dst, src)
+func (dst *ThreadKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadKey.Merge(dst, src)
 }
-func (m *FileIndex) XXX_Size() int {
-	return xxx_messageInfo_FileIndex.Size(m)
+func (m *ThreadKey) XXX_Size() int {
+	return xxx_messageInfo_ThreadKey.Size(m)
 }
-func (m *FileIndex) XXX_DiscardUnknown() {
-	xxx_messageInfo_FileIndex.DiscardUnknown(m)
+func (m *ThreadKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FileIndex proto.InternalMessageInfo
+var xxx_messageInfo_ThreadKey proto.InternalMessageInfo
 
-func (m *FileIndex) GetMill() string {
+func (m *ThreadKey) GetId() string {
 	if m != nil {
-		return m.Mill
+		return m.Id
 	}
 	return ""
 }
 
-func (m *FileIndex) GetChecksum() string {
+func (m *ThreadKey) GetThread() string {
 	if m != nil {
-		return m.Checksum
+		return m.Thread
 	}
 	return ""
 }
 
-func (m *FileIndex) GetSource() string {
+func (m *ThreadKey) GetEpoch() int32 {
 	if m != nil {
-		return m.Source
+		return m.Epoch
 	}
-	return ""
+	return 0
 }
 
-func (m *FileIndex) GetOpts() string {
+func (m *ThreadKey) GetSk() []byte {
 	if m != nil {
-		return m.Opts
+		return m.Sk
 	}
-	return ""
+	return nil
 }
 
-func (m *FileIndex) GetHash() string {
+func (m *ThreadKey) GetDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.Hash
+		return m.Date
 	}
-	return ""
+	return nil
 }
 
-func (m *FileIndex) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
+type ThreadKeyList struct {
+	Items                []*ThreadKey `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *FileIndex) GetMedia() string {
-	if m != nil {
-		return m.Media
-	}
-	return ""
+func (m *ThreadKeyList) Reset()         { *m = ThreadKeyList{} }
+func (m *ThreadKeyList) String() string { return proto.CompactTextString(m) }
+func (*ThreadKeyList) ProtoMessage()    {}
+func (*ThreadKeyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{14}
 }
-
-func (m *FileIndex) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+func (m *ThreadKeyList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadKeyList.Unmarshal(m, b)
 }
-
-func (m *FileIndex) GetSize() int64 {
-	if m != nil {
-		return m.Size
-	}
-	return 0
+func (m *ThreadKeyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadKeyList.Marshal(b, m, deterministic)
 }
-
-func (m *FileIndex) GetAdded() *timestamp.Timestamp {
-	if m != nil {
-		return m.Added
-	}
-	return nil
+func (dst *ThreadKeyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadKeyList.Merge(dst, src)
 }
-
-func (m *FileIndex) GetMeta() *_struct.Struct {
-	if m != nil {
-		return m.Meta
-	}
-	return nil
+func (m *ThreadKeyList) XXX_Size() int {
+	return xxx_messageInfo_ThreadKeyList.Size(m)
+}
+func (m *ThreadKeyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadKeyList.DiscardUnknown(m)
 }
 
-func (m *FileIndex) GetTargets() []string {
+var xxx_messageInfo_ThreadKeyList proto.InternalMessageInfo
+
+func (m *ThreadKeyList) GetItems() []*ThreadKey {
 	if m != nil {
-		return m.Targets
+		return m.Items
 	}
 	return nil
 }
 
-type Node struct {
-	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Pin                  bool              `protobuf:"varint,2,opt,name=pin,proto3" json:"pin,omitempty"`
-	Plaintext            bool              `protobuf:"varint,3,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
-	Mill                 string            `protobuf:"bytes,4,opt,name=mill,proto3" json:"mill,omitempty"`
-	Opts                 map[string]string `protobuf:"bytes,5,rep,name=opts,proto3" json:"opts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	JsonSchema           *_struct.Struct   `protobuf:"bytes,6,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
-	Links                map[string]*Link  `protobuf:"bytes,8,rep,name=links,proto3" json:"links,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type ThreadAdvert struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Schema               string               `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	Initiator            string               `protobuf:"bytes,4,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	Type                 Thread_Type          `protobuf:"varint,5,opt,name=type,proto3,enum=Thread_Type" json:"type,omitempty"`
+	Invite               string               `protobuf:"bytes,6,opt,name=invite,proto3" json:"invite,omitempty"`
+	InviteKey            string               `protobuf:"bytes,7,opt,name=invite_key,json=inviteKey,proto3" json:"invite_key,omitempty"`
+	Inviter              string               `protobuf:"bytes,8,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *Node) Reset()         { *m = Node{} }
-func (m *Node) String() string { return proto.CompactTextString(m) }
-func (*Node) ProtoMessage()    {}
-func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{14}
+func (m *ThreadAdvert) Reset()         { *m = ThreadAdvert{} }
+func (m *ThreadAdvert) String() string { return proto.CompactTextString(m) }
+func (*ThreadAdvert) ProtoMessage()    {}
+func (*ThreadAdvert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{15}
 }
-func (m *Node) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Node.Unmarshal(m, b)
+func (m *ThreadAdvert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadAdvert.Unmarshal(m, b)
 }
-func (m *Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Node.Marshal(b, m, deterministic)
+func (m *ThreadAdvert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadAdvert.Marshal(b, m, deterministic)
 }
-func (dst *Node) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Node.Merge(dst, src)
+func (dst *ThreadAdvert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadAdvert.Merge(dst, src)
 }
-func (m *Node) XXX_Size() int {
-	return xxx_messageInfo_Node.Size(m)
+func (m *ThreadAdvert) XXX_Size() int {
+	return xxx_messageInfo_ThreadAdvert.Size(m)
 }
-func (m *Node) XXX_DiscardUnknown() {
-	xxx_messageInfo_Node.DiscardUnknown(m)
+func (m *ThreadAdvert) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadAdvert.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Node proto.InternalMessageInfo
+var xxx_messageInfo_ThreadAdvert proto.InternalMessageInfo
 
-func (m *Node) GetName() string {
+func (m *ThreadAdvert) GetId() string {
 	if m != nil {
-		return m.Name
+		return m.Id
 	}
 	return ""
 }
 
-func (m *Node) GetPin() bool {
+func (m *ThreadAdvert) GetName() string {
 	if m != nil {
-		return m.Pin
+		return m.Name
 	}
-	return false
+	return ""
 }
 
-func (m *Node) GetPlaintext() bool {
+func (m *ThreadAdvert) GetSchema() string {
 	if m != nil {
-		return m.Plaintext
+		return m.Schema
 	}
-	return false
+	return ""
 }
 
-func (m *Node) GetMill() string {
+func (m *ThreadAdvert) GetInitiator() string {
 	if m != nil {
-		return m.Mill
+		return m.Initiator
 	}
 	return ""
 }
 
-func (m *Node) GetOpts() map[string]string {
+func (m *ThreadAdvert) GetType() Thread_Type {
 	if m != nil {
-		return m.Opts
+		return m.Type
 	}
-	return nil
+	return Thread_PRIVATE
 }
 
-func (m *Node) GetJsonSchema() *_struct.Struct {
+func (m *ThreadAdvert) GetInvite() string {
 	if m != nil {
-		return m.JsonSchema
+		return m.Invite
 	}
-	return nil
+	return ""
 }
 
-func (m *Node) GetLinks() map[string]*Link {
+func (m *ThreadAdvert) GetInviteKey() string {
 	if m != nil {
-		return m.Links
+		return m.InviteKey
 	}
-	return nil
+	return ""
 }
 
-type Link struct {
-	Use                  string            `protobuf:"bytes,1,opt,name=use,proto3" json:"use,omitempty"`
-	Pin                  bool              `protobuf:"varint,2,opt,name=pin,proto3" json:"pin,omitempty"`
-	Plaintext            bool              `protobuf:"varint,3,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
-	Mill                 string            `protobuf:"bytes,4,opt,name=mill,proto3" json:"mill,omitempty"`
-	Opts                 map[string]string `protobuf:"bytes,5,rep,name=opts,proto3" json:"opts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	JsonSchema           *_struct.Struct   `protobuf:"bytes,6,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *ThreadAdvert) GetInviter() string {
+	if m != nil {
+		return m.Inviter
+	}
+	return ""
 }
 
-func (m *Link) Reset()         { *m = Link{} }
-func (m *Link) String() string { return proto.CompactTextString(m) }
-func (*Link) ProtoMessage()    {}
-func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{15}
-}
-func (m *Link) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Link.Unmarshal(m, b)
-}
-func (m *Link) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Link.Marshal(b, m, deterministic)
-}
-func (dst *Link) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Link.Merge(dst, src)
-}
-func (m *Link) XXX_Size() int {
-	return xxx_messageInfo_Link.Size(m)
-}
-func (m *Link) XXX_DiscardUnknown() {
-	xxx_messageInfo_Link.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Link proto.InternalMessageInfo
-
-func (m *Link) GetUse() string {
+func (m *ThreadAdvert) GetDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.Use
+		return m.Date
 	}
-	return ""
+	return nil
 }
 
-func (m *Link) GetPin() bool {
-	if m != nil {
-		return m.Pin
-	}
-	return false
+type ThreadAdvertList struct {
+	Items                []*ThreadAdvert `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *Link) GetPlaintext() bool {
-	if m != nil {
-		return m.Plaintext
-	}
-	return false
+func (m *ThreadAdvertList) Reset()         { *m = ThreadAdvertList{} }
+func (m *ThreadAdvertList) String() string { return proto.CompactTextString(m) }
+func (*ThreadAdvertList) ProtoMessage()    {}
+func (*ThreadAdvertList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{16}
 }
-
-func (m *Link) GetMill() string {
-	if m != nil {
-		return m.Mill
-	}
-	return ""
+func (m *ThreadAdvertList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadAdvertList.Unmarshal(m, b)
 }
-
-func (m *Link) GetOpts() map[string]string {
-	if m != nil {
-		return m.Opts
-	}
-	return nil
+func (m *ThreadAdvertList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadAdvertList.Marshal(b, m, deterministic)
+}
+func (dst *ThreadAdvertList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadAdvertList.Merge(dst, src)
+}
+func (m *ThreadAdvertList) XXX_Size() int {
+	return xxx_messageInfo_ThreadAdvertList.Size(m)
+}
+func (m *ThreadAdvertList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadAdvertList.DiscardUnknown(m)
 }
 
-func (m *Link) GetJsonSchema() *_struct.Struct {
+var xxx_messageInfo_ThreadAdvertList proto.InternalMessageInfo
+
+func (m *ThreadAdvertList) GetItems() []*ThreadAdvert {
 	if m != nil {
-		return m.JsonSchema
+		return m.Items
 	}
 	return nil
 }
 
-type Notification struct {
-	Id          string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Date        *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	Actor       string               `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
-	Subject     string               `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
-	SubjectDesc string               `protobuf:"bytes,5,opt,name=subject_desc,json=subjectDesc,proto3" json:"subject_desc,omitempty"`
-	Block       string               `protobuf:"bytes,6,opt,name=block,proto3" json:"block,omitempty"`
-	Target      string               `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
-	Type        Notification_Type    `protobuf:"varint,8,opt,name=type,proto3,enum=Notification_Type" json:"type,omitempty"`
-	Body        string               `protobuf:"bytes,9,opt,name=body,proto3" json:"body,omitempty"`
-	Read        bool                 `protobuf:"varint,10,opt,name=read,proto3" json:"read,omitempty"`
-	// view info
-	User                 *User    `protobuf:"bytes,101,opt,name=user,proto3" json:"user,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ThreadArchive struct {
+	Version              int32                `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Thread               *Thread              `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Address              string               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Keys                 []byte               `protobuf:"bytes,4,opt,name=keys,proto3" json:"keys,omitempty"`
+	Blocks               []string             `protobuf:"bytes,5,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	Sig                  []byte               `protobuf:"bytes,7,opt,name=sig,proto3" json:"sig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *Notification) Reset()         { *m = Notification{} }
-func (m *Notification) String() string { return proto.CompactTextString(m) }
-func (*Notification) ProtoMessage()    {}
-func (*Notification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{16}
+func (m *ThreadArchive) Reset()         { *m = ThreadArchive{} }
+func (m *ThreadArchive) String() string { return proto.CompactTextString(m) }
+func (*ThreadArchive) ProtoMessage()    {}
+func (*ThreadArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{17}
 }
-func (m *Notification) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Notification.Unmarshal(m, b)
+func (m *ThreadArchive) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadArchive.Unmarshal(m, b)
 }
-func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+func (m *ThreadArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadArchive.Marshal(b, m, deterministic)
 }
-func (dst *Notification) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Notification.Merge(dst, src)
+func (dst *ThreadArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadArchive.Merge(dst, src)
 }
-func (m *Notification) XXX_Size() int {
-	return xxx_messageInfo_Notification.Size(m)
+func (m *ThreadArchive) XXX_Size() int {
+	return xxx_messageInfo_ThreadArchive.Size(m)
 }
-func (m *Notification) XXX_DiscardUnknown() {
-	xxx_messageInfo_Notification.DiscardUnknown(m)
+func (m *ThreadArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadArchive.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Notification proto.InternalMessageInfo
+var xxx_messageInfo_ThreadArchive proto.InternalMessageInfo
 
-func (m *Notification) GetId() string {
+func (m *ThreadArchive) GetVersion() int32 {
 	if m != nil {
-		return m.Id
+		return m.Version
 	}
-	return ""
+	return 0
 }
 
-func (m *Notification) GetDate() *timestamp.Timestamp {
+func (m *ThreadArchive) GetThread() *Thread {
 	if m != nil {
-		return m.Date
+		return m.Thread
 	}
 	return nil
 }
 
-func (m *Notification) GetActor() string {
-	if m != nil {
-		return m.Actor
-	}
-	return ""
-}
-
-func (m *Notification) GetSubject() string {
-	if m != nil {
-		return m.Subject
-	}
-	return ""
-}
-
-func (m *Notification) GetSubjectDesc() string {
-	if m != nil {
-		return m.SubjectDesc
-	}
-	return ""
-}
-
-func (m *Notification) GetBlock() string {
-	if m != nil {
-		return m.Block
-	}
-	return ""
-}
-
-func (m *Notification) GetTarget() string {
+func (m *ThreadArchive) GetAddress() string {
 	if m != nil {
-		return m.Target
+		return m.Address
 	}
 	return ""
 }
 
-func (m *Notification) GetType() Notification_Type {
+func (m *ThreadArchive) GetKeys() []byte {
 	if m != nil {
-		return m.Type
+		return m.Keys
 	}
-	return Notification_INVITE_RECEIVED
+	return nil
 }
 
-func (m *Notification) GetBody() string {
+func (m *ThreadArchive) GetBlocks() []string {
 	if m != nil {
-		return m.Body
+		return m.Blocks
 	}
-	return ""
+	return nil
 }
 
-func (m *Notification) GetRead() bool {
+func (m *ThreadArchive) GetDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.Read
+		return m.Date
 	}
-	return false
+	return nil
 }
 
-func (m *Notification) GetUser() *User {
+func (m *ThreadArchive) GetSig() []byte {
 	if m != nil {
-		return m.User
+		return m.Sig
 	}
 	return nil
 }
 
-type NotificationList struct {
-	Items                []*Notification `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type ThreadArchiveKeys struct {
+	Sk                   []byte       `protobuf:"bytes,1,opt,name=sk,proto3" json:"sk,omitempty"`
+	Keys                 []*ThreadKey `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *NotificationList) Reset()         { *m = NotificationList{} }
-func (m *NotificationList) String() string { return proto.CompactTextString(m) }
-func (*NotificationList) ProtoMessage()    {}
-func (*NotificationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{17}
+func (m *ThreadArchiveKeys) Reset()         { *m = ThreadArchiveKeys{} }
+func (m *ThreadArchiveKeys) String() string { return proto.CompactTextString(m) }
+func (*ThreadArchiveKeys) ProtoMessage()    {}
+func (*ThreadArchiveKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{18}
 }
-func (m *NotificationList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NotificationList.Unmarshal(m, b)
+func (m *ThreadArchiveKeys) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadArchiveKeys.Unmarshal(m, b)
 }
-func (m *NotificationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NotificationList.Marshal(b, m, deterministic)
+func (m *ThreadArchiveKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadArchiveKeys.Marshal(b, m, deterministic)
 }
-func (dst *NotificationList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NotificationList.Merge(dst, src)
+func (dst *ThreadArchiveKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadArchiveKeys.Merge(dst, src)
 }
-func (m *NotificationList) XXX_Size() int {
-	return xxx_messageInfo_NotificationList.Size(m)
+func (m *ThreadArchiveKeys) XXX_Size() int {
+	return xxx_messageInfo_ThreadArchiveKeys.Size(m)
 }
-func (m *NotificationList) XXX_DiscardUnknown() {
-	xxx_messageInfo_NotificationList.DiscardUnknown(m)
+func (m *ThreadArchiveKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadArchiveKeys.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NotificationList proto.InternalMessageInfo
+var xxx_messageInfo_ThreadArchiveKeys proto.InternalMessageInfo
 
-func (m *NotificationList) GetItems() []*Notification {
+func (m *ThreadArchiveKeys) GetSk() []byte {
 	if m != nil {
-		return m.Items
+		return m.Sk
 	}
 	return nil
 }
 
-type Cafe struct {
-	Peer                 string   `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Api                  string   `protobuf:"bytes,3,opt,name=api,proto3" json:"api,omitempty"`
-	Protocol             string   `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Node                 string   `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
-	Url                  string   `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ThreadArchiveKeys) GetKeys() []*ThreadKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
 }
 
-func (m *Cafe) Reset()         { *m = Cafe{} }
-func (m *Cafe) String() string { return proto.CompactTextString(m) }
-func (*Cafe) ProtoMessage()    {}
-func (*Cafe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{18}
+type Invite struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Block                []byte               `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	Name                 string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Inviter              *Peer                `protobuf:"bytes,4,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
-func (m *Cafe) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Cafe.Unmarshal(m, b)
+
+func (m *Invite) Reset()         { *m = Invite{} }
+func (m *Invite) String() string { return proto.CompactTextString(m) }
+func (*Invite) ProtoMessage()    {}
+func (*Invite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{19}
 }
-func (m *Cafe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Cafe.Marshal(b, m, deterministic)
+func (m *Invite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Invite.Unmarshal(m, b)
 }
-func (dst *Cafe) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Cafe.Merge(dst, src)
+func (m *Invite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Invite.Marshal(b, m, deterministic)
 }
-func (m *Cafe) XXX_Size() int {
-	return xxx_messageInfo_Cafe.Size(m)
+func (dst *Invite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Invite.Merge(dst, src)
 }
-func (m *Cafe) XXX_DiscardUnknown() {
-	xxx_messageInfo_Cafe.DiscardUnknown(m)
+func (m *Invite) XXX_Size() int {
+	return xxx_messageInfo_Invite.Size(m)
+}
+func (m *Invite) XXX_DiscardUnknown() {
+	xxx_messageInfo_Invite.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Cafe proto.InternalMessageInfo
+var xxx_messageInfo_Invite proto.InternalMessageInfo
 
-func (m *Cafe) GetPeer() string {
+func (m *Invite) GetId() string {
 	if m != nil {
-		return m.Peer
+		return m.Id
 	}
 	return ""
 }
 
-func (m *Cafe) GetAddress() string {
+func (m *Invite) GetBlock() []byte {
 	if m != nil {
-		return m.Address
+		return m.Block
 	}
-	return ""
+	return nil
 }
 
-func (m *Cafe) GetApi() string {
+func (m *Invite) GetName() string {
 	if m != nil {
-		return m.Api
+		return m.Name
 	}
 	return ""
 }
 
-func (m *Cafe) GetProtocol() string {
+func (m *Invite) GetInviter() *Peer {
 	if m != nil {
-		return m.Protocol
+		return m.Inviter
 	}
-	return ""
+	return nil
 }
 
-func (m *Cafe) GetNode() string {
+func (m *Invite) GetDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.Node
+		return m.Date
 	}
-	return ""
+	return nil
 }
 
-func (m *Cafe) GetUrl() string {
-	if m != nil {
-		return m.Url
-	}
-	return ""
+type InviteList struct {
+	Items                []*Invite `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-type CafeSession struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Access               string               `protobuf:"bytes,2,opt,name=access,proto3" json:"access,omitempty"`
-	Exp                  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=exp,proto3" json:"exp,omitempty"`
-	Refresh              string               `protobuf:"bytes,4,opt,name=refresh,proto3" json:"refresh,omitempty"`
-	Rexp                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=rexp,proto3" json:"rexp,omitempty"`
-	Subject              string               `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
-	Type                 string               `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
-	Cafe                 *Cafe                `protobuf:"bytes,8,opt,name=cafe,proto3" json:"cafe,omitempty"`
+func (m *InviteList) Reset()         { *m = InviteList{} }
+func (m *InviteList) String() string { return proto.CompactTextString(m) }
+func (*InviteList) ProtoMessage()    {}
+func (*InviteList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{20}
+}
+func (m *InviteList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteList.Unmarshal(m, b)
+}
+func (m *InviteList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteList.Marshal(b, m, deterministic)
+}
+func (dst *InviteList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteList.Merge(dst, src)
+}
+func (m *InviteList) XXX_Size() int {
+	return xxx_messageInfo_InviteList.Size(m)
+}
+func (m *InviteList) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteList proto.InternalMessageInfo
+
+func (m *InviteList) GetItems() []*Invite {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type FileIndex struct {
+	Mill                 string               `protobuf:"bytes,1,opt,name=mill,proto3" json:"mill,omitempty"`
+	Checksum             string               `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Source               string               `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Opts                 string               `protobuf:"bytes,4,opt,name=opts,proto3" json:"opts,omitempty"`
+	Hash                 string               `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	Key                  string               `protobuf:"bytes,6,opt,name=key,proto3" json:"key,omitempty"`
+	Media                string               `protobuf:"bytes,7,opt,name=media,proto3" json:"media,omitempty"`
+	Name                 string               `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	Size                 int64                `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
+	Added                *timestamp.Timestamp `protobuf:"bytes,10,opt,name=added,proto3" json:"added,omitempty"`
+	Meta                 *_struct.Struct      `protobuf:"bytes,11,opt,name=meta,proto3" json:"meta,omitempty"`
+	Targets              []string             `protobuf:"bytes,12,rep,name=targets,proto3" json:"targets,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *CafeSession) Reset()         { *m = CafeSession{} }
-func (m *CafeSession) String() string { return proto.CompactTextString(m) }
-func (*CafeSession) ProtoMessage()    {}
-func (*CafeSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{19}
+func (m *FileIndex) Reset()         { *m = FileIndex{} }
+func (m *FileIndex) String() string { return proto.CompactTextString(m) }
+func (*FileIndex) ProtoMessage()    {}
+func (*FileIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{21}
 }
-func (m *CafeSession) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeSession.Unmarshal(m, b)
+func (m *FileIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileIndex.Unmarshal(m, b)
 }
-func (m *CafeSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeSession.Marshal(b, m, deterministic)
+func (m *FileIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileIndex.Marshal(b, m, deterministic)
 }
-func (dst *CafeSession) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeSession.Merge(dst, src)
+func (dst *FileIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileIndex.Merge(dst, src)
 }
-func (m *CafeSession) XXX_Size() int {
-	return xxx_messageInfo_CafeSession.Size(m)
+func (m *FileIndex) XXX_Size() int {
+	return xxx_messageInfo_FileIndex.Size(m)
 }
-func (m *CafeSession) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeSession.DiscardUnknown(m)
+func (m *FileIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileIndex.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CafeSession proto.InternalMessageInfo
+var xxx_messageInfo_FileIndex proto.InternalMessageInfo
 
-func (m *CafeSession) GetId() string {
+func (m *FileIndex) GetMill() string {
 	if m != nil {
-		return m.Id
+		return m.Mill
 	}
 	return ""
 }
 
-func (m *CafeSession) GetAccess() string {
+func (m *FileIndex) GetChecksum() string {
 	if m != nil {
-		return m.Access
+		return m.Checksum
 	}
 	return ""
 }
 
-func (m *CafeSession) GetExp() *timestamp.Timestamp {
+func (m *FileIndex) GetSource() string {
 	if m != nil {
-		return m.Exp
+		return m.Source
 	}
-	return nil
+	return ""
 }
 
-func (m *CafeSession) GetRefresh() string {
+func (m *FileIndex) GetOpts() string {
 	if m != nil {
-		return m.Refresh
+		return m.Opts
 	}
 	return ""
 }
 
-func (m *CafeSession) GetRexp() *timestamp.Timestamp {
+func (m *FileIndex) GetHash() string {
 	if m != nil {
-		return m.Rexp
+		return m.Hash
 	}
-	return nil
+	return ""
 }
 
-func (m *CafeSession) GetSubject() string {
+func (m *FileIndex) GetKey() string {
 	if m != nil {
-		return m.Subject
+		return m.Key
 	}
 	return ""
 }
 
-func (m *CafeSession) GetType() string {
+func (m *FileIndex) GetMedia() string {
 	if m != nil {
-		return m.Type
+		return m.Media
 	}
 	return ""
 }
 
-func (m *CafeSession) GetCafe() *Cafe {
+func (m *FileIndex) GetName() string {
 	if m != nil {
-		return m.Cafe
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type CafeSessionList struct {
-	Items                []*CafeSession `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *FileIndex) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
 }
 
-func (m *CafeSessionList) Reset()         { *m = CafeSessionList{} }
-func (m *CafeSessionList) String() string { return proto.CompactTextString(m) }
-func (*CafeSessionList) ProtoMessage()    {}
-func (*CafeSessionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{20}
-}
-func (m *CafeSessionList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeSessionList.Unmarshal(m, b)
-}
-func (m *CafeSessionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeSessionList.Marshal(b, m, deterministic)
-}
-func (dst *CafeSessionList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeSessionList.Merge(dst, src)
-}
-func (m *CafeSessionList) XXX_Size() int {
-	return xxx_messageInfo_CafeSessionList.Size(m)
-}
-func (m *CafeSessionList) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeSessionList.DiscardUnknown(m)
+func (m *FileIndex) GetAdded() *timestamp.Timestamp {
+	if m != nil {
+		return m.Added
+	}
+	return nil
 }
 
-var xxx_messageInfo_CafeSessionList proto.InternalMessageInfo
+func (m *FileIndex) GetMeta() *_struct.Struct {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
 
-func (m *CafeSessionList) GetItems() []*CafeSession {
+func (m *FileIndex) GetTargets() []string {
 	if m != nil {
-		return m.Items
+		return m.Targets
 	}
 	return nil
 }
 
-type CafeRequest struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
-	Target               string               `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	Cafe                 *Cafe                `protobuf:"bytes,4,opt,name=cafe,proto3" json:"cafe,omitempty"`
-	Type                 CafeRequest_Type     `protobuf:"varint,5,opt,name=type,proto3,enum=CafeRequest_Type" json:"type,omitempty"`
-	Size                 int64                `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
-	Group                string               `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
-	Date                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
-	Status               CafeRequest_Status   `protobuf:"varint,9,opt,name=status,proto3,enum=CafeRequest_Status" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type Node struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pin                  bool              `protobuf:"varint,2,opt,name=pin,proto3" json:"pin,omitempty"`
+	Plaintext            bool              `protobuf:"varint,3,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	Mill                 string            `protobuf:"bytes,4,opt,name=mill,proto3" json:"mill,omitempty"`
+	Opts                 map[string]string `protobuf:"bytes,5,rep,name=opts,proto3" json:"opts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	JsonSchema           *_struct.Struct   `protobuf:"bytes,6,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	Links                map[string]*Link  `protobuf:"bytes,8,rep,name=links,proto3" json:"links,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *CafeRequest) Reset()         { *m = CafeRequest{} }
-func (m *CafeRequest) String() string { return proto.CompactTextString(m) }
-func (*CafeRequest) ProtoMessage()    {}
-func (*CafeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{21}
+func (m *Node) Reset()         { *m = Node{} }
+func (m *Node) String() string { return proto.CompactTextString(m) }
+func (*Node) ProtoMessage()    {}
+func (*Node) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{22}
 }
-func (m *CafeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeRequest.Unmarshal(m, b)
+func (m *Node) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Node.Unmarshal(m, b)
 }
-func (m *CafeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeRequest.Marshal(b, m, deterministic)
+func (m *Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Node.Marshal(b, m, deterministic)
 }
-func (dst *CafeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeRequest.Merge(dst, src)
+func (dst *Node) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Node.Merge(dst, src)
 }
-func (m *CafeRequest) XXX_Size() int {
-	return xxx_messageInfo_CafeRequest.Size(m)
+func (m *Node) XXX_Size() int {
+	return xxx_messageInfo_Node.Size(m)
 }
-func (m *CafeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeRequest.DiscardUnknown(m)
+func (m *Node) XXX_DiscardUnknown() {
+	xxx_messageInfo_Node.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CafeRequest proto.InternalMessageInfo
+var xxx_messageInfo_Node proto.InternalMessageInfo
 
-func (m *CafeRequest) GetId() string {
+func (m *Node) GetName() string {
 	if m != nil {
-		return m.Id
+		return m.Name
 	}
 	return ""
 }
 
-func (m *CafeRequest) GetPeer() string {
+func (m *Node) GetPin() bool {
 	if m != nil {
-		return m.Peer
+		return m.Pin
 	}
-	return ""
+	return false
 }
 
-func (m *CafeRequest) GetTarget() string {
+func (m *Node) GetPlaintext() bool {
 	if m != nil {
-		return m.Target
+		return m.Plaintext
+	}
+	return false
+}
+
+func (m *Node) GetMill() string {
+	if m != nil {
+		return m.Mill
 	}
 	return ""
 }
 
-func (m *CafeRequest) GetCafe() *Cafe {
+func (m *Node) GetOpts() map[string]string {
 	if m != nil {
-		return m.Cafe
+		return m.Opts
 	}
 	return nil
 }
 
-func (m *CafeRequest) GetType() CafeRequest_Type {
+func (m *Node) GetJsonSchema() *_struct.Struct {
+	if m != nil {
+		return m.JsonSchema
+	}
+	return nil
+}
+
+func (m *Node) GetLinks() map[string]*Link {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+type Link struct {
+	Use                  string            `protobuf:"bytes,1,opt,name=use,proto3" json:"use,omitempty"`
+	Pin                  bool              `protobuf:"varint,2,opt,name=pin,proto3" json:"pin,omitempty"`
+	Plaintext            bool              `protobuf:"varint,3,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	Mill                 string            `protobuf:"bytes,4,opt,name=mill,proto3" json:"mill,omitempty"`
+	Opts                 map[string]string `protobuf:"bytes,5,rep,name=opts,proto3" json:"opts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	JsonSchema           *_struct.Struct   `protobuf:"bytes,6,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	Optional             bool              `protobuf:"varint,7,opt,name=optional,proto3" json:"optional,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Link) Reset()         { *m = Link{} }
+func (m *Link) String() string { return proto.CompactTextString(m) }
+func (*Link) ProtoMessage()    {}
+func (*Link) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{23}
+}
+func (m *Link) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Link.Unmarshal(m, b)
+}
+func (m *Link) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Link.Marshal(b, m, deterministic)
+}
+func (dst *Link) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Link.Merge(dst, src)
+}
+func (m *Link) XXX_Size() int {
+	return xxx_messageInfo_Link.Size(m)
+}
+func (m *Link) XXX_DiscardUnknown() {
+	xxx_messageInfo_Link.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Link proto.InternalMessageInfo
+
+func (m *Link) GetUse() string {
+	if m != nil {
+		return m.Use
+	}
+	return ""
+}
+
+func (m *Link) GetPin() bool {
+	if m != nil {
+		return m.Pin
+	}
+	return false
+}
+
+func (m *Link) GetPlaintext() bool {
+	if m != nil {
+		return m.Plaintext
+	}
+	return false
+}
+
+func (m *Link) GetMill() string {
+	if m != nil {
+		return m.Mill
+	}
+	return ""
+}
+
+func (m *Link) GetOpts() map[string]string {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *Link) GetJsonSchema() *_struct.Struct {
+	if m != nil {
+		return m.JsonSchema
+	}
+	return nil
+}
+
+func (m *Link) GetOptional() bool {
+	if m != nil {
+		return m.Optional
+	}
+	return false
+}
+
+type Notification struct {
+	Id          string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date        *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Actor       string               `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Subject     string               `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	SubjectDesc string               `protobuf:"bytes,5,opt,name=subject_desc,json=subjectDesc,proto3" json:"subject_desc,omitempty"`
+	Block       string               `protobuf:"bytes,6,opt,name=block,proto3" json:"block,omitempty"`
+	Target      string               `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
+	Type        Notification_Type    `protobuf:"varint,8,opt,name=type,proto3,enum=Notification_Type" json:"type,omitempty"`
+	Body        string               `protobuf:"bytes,9,opt,name=body,proto3" json:"body,omitempty"`
+	Read        bool                 `protobuf:"varint,10,opt,name=read,proto3" json:"read,omitempty"`
+	// view info
+	User                 *User    `protobuf:"bytes,101,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Notification) Reset()         { *m = Notification{} }
+func (m *Notification) String() string { return proto.CompactTextString(m) }
+func (*Notification) ProtoMessage()    {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{24}
+}
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notification.Unmarshal(m, b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+}
+func (dst *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(dst, src)
+}
+func (m *Notification) XXX_Size() int {
+	return xxx_messageInfo_Notification.Size(m)
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Notification) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *Notification) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *Notification) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *Notification) GetSubjectDesc() string {
+	if m != nil {
+		return m.SubjectDesc
+	}
+	return ""
+}
+
+func (m *Notification) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *Notification) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Notification) GetType() Notification_Type {
+	if m != nil {
+		return m.Type
+	}
+	return Notification_INVITE_RECEIVED
+}
+
+func (m *Notification) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *Notification) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *Notification) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type NotificationList struct {
+	Items                []*Notification `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NotificationList) Reset()         { *m = NotificationList{} }
+func (m *NotificationList) String() string { return proto.CompactTextString(m) }
+func (*NotificationList) ProtoMessage()    {}
+func (*NotificationList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{25}
+}
+func (m *NotificationList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationList.Unmarshal(m, b)
+}
+func (m *NotificationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationList.Marshal(b, m, deterministic)
+}
+func (dst *NotificationList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationList.Merge(dst, src)
+}
+func (m *NotificationList) XXX_Size() int {
+	return xxx_messageInfo_NotificationList.Size(m)
+}
+func (m *NotificationList) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationList proto.InternalMessageInfo
+
+func (m *NotificationList) GetItems() []*Notification {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type NotificationSettings struct {
+	Thread               string               `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Muted                bool                 `protobuf:"varint,2,opt,name=muted,proto3" json:"muted,omitempty"`
+	MentionsOnly         bool                 `protobuf:"varint,3,opt,name=mentions_only,json=mentionsOnly,proto3" json:"mentions_only,omitempty"`
+	Types                []Notification_Type  `protobuf:"varint,4,rep,packed,name=types,proto3,enum=Notification_Type" json:"types,omitempty"`
+	Updated              *timestamp.Timestamp `protobuf:"bytes,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *NotificationSettings) Reset()         { *m = NotificationSettings{} }
+func (m *NotificationSettings) String() string { return proto.CompactTextString(m) }
+func (*NotificationSettings) ProtoMessage()    {}
+func (*NotificationSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{26}
+}
+func (m *NotificationSettings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationSettings.Unmarshal(m, b)
+}
+func (m *NotificationSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationSettings.Marshal(b, m, deterministic)
+}
+func (dst *NotificationSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationSettings.Merge(dst, src)
+}
+func (m *NotificationSettings) XXX_Size() int {
+	return xxx_messageInfo_NotificationSettings.Size(m)
+}
+func (m *NotificationSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationSettings proto.InternalMessageInfo
+
+func (m *NotificationSettings) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *NotificationSettings) GetMuted() bool {
+	if m != nil {
+		return m.Muted
+	}
+	return false
+}
+
+func (m *NotificationSettings) GetMentionsOnly() bool {
+	if m != nil {
+		return m.MentionsOnly
+	}
+	return false
+}
+
+func (m *NotificationSettings) GetTypes() []Notification_Type {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *NotificationSettings) GetUpdated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
+type NotificationSettingsList struct {
+	Items                []*NotificationSettings `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *NotificationSettingsList) Reset()         { *m = NotificationSettingsList{} }
+func (m *NotificationSettingsList) String() string { return proto.CompactTextString(m) }
+func (*NotificationSettingsList) ProtoMessage()    {}
+func (*NotificationSettingsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{27}
+}
+func (m *NotificationSettingsList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationSettingsList.Unmarshal(m, b)
+}
+func (m *NotificationSettingsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationSettingsList.Marshal(b, m, deterministic)
+}
+func (dst *NotificationSettingsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationSettingsList.Merge(dst, src)
+}
+func (m *NotificationSettingsList) XXX_Size() int {
+	return xxx_messageInfo_NotificationSettingsList.Size(m)
+}
+func (m *NotificationSettingsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationSettingsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationSettingsList proto.InternalMessageInfo
+
+func (m *NotificationSettingsList) GetItems() []*NotificationSettings {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type Change struct {
+	Seq                  int64                `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Type                 Change_Type          `protobuf:"varint,2,opt,name=type,proto3,enum=Change_Type" json:"type,omitempty"`
+	Id                   string               `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Thread               string               `protobuf:"bytes,4,opt,name=thread,proto3" json:"thread,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Change) Reset()         { *m = Change{} }
+func (m *Change) String() string { return proto.CompactTextString(m) }
+func (*Change) ProtoMessage()    {}
+func (*Change) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{28}
+}
+func (m *Change) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Change.Unmarshal(m, b)
+}
+func (m *Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Change.Marshal(b, m, deterministic)
+}
+func (dst *Change) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Change.Merge(dst, src)
+}
+func (m *Change) XXX_Size() int {
+	return xxx_messageInfo_Change.Size(m)
+}
+func (m *Change) XXX_DiscardUnknown() {
+	xxx_messageInfo_Change.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Change proto.InternalMessageInfo
+
+func (m *Change) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *Change) GetType() Change_Type {
+	if m != nil {
+		return m.Type
+	}
+	return Change_BLOCK_ADDED
+}
+
+func (m *Change) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Change) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *Change) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type ChangeList struct {
+	Items                []*Change `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next                 int64     `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ChangeList) Reset()         { *m = ChangeList{} }
+func (m *ChangeList) String() string { return proto.CompactTextString(m) }
+func (*ChangeList) ProtoMessage()    {}
+func (*ChangeList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{29}
+}
+func (m *ChangeList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeList.Unmarshal(m, b)
+}
+func (m *ChangeList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeList.Marshal(b, m, deterministic)
+}
+func (dst *ChangeList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeList.Merge(dst, src)
+}
+func (m *ChangeList) XXX_Size() int {
+	return xxx_messageInfo_ChangeList.Size(m)
+}
+func (m *ChangeList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeList proto.InternalMessageInfo
+
+func (m *ChangeList) GetItems() []*Change {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ChangeList) GetNext() int64 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
+type Cafe struct {
+	Peer                 string   `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Api                  string   `protobuf:"bytes,3,opt,name=api,proto3" json:"api,omitempty"`
+	Protocol             string   `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Node                 string   `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
+	Url                  string   `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Cafe) Reset()         { *m = Cafe{} }
+func (m *Cafe) String() string { return proto.CompactTextString(m) }
+func (*Cafe) ProtoMessage()    {}
+func (*Cafe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{30}
+}
+func (m *Cafe) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Cafe.Unmarshal(m, b)
+}
+func (m *Cafe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Cafe.Marshal(b, m, deterministic)
+}
+func (dst *Cafe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cafe.Merge(dst, src)
+}
+func (m *Cafe) XXX_Size() int {
+	return xxx_messageInfo_Cafe.Size(m)
+}
+func (m *Cafe) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cafe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cafe proto.InternalMessageInfo
+
+func (m *Cafe) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *Cafe) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Cafe) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *Cafe) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *Cafe) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *Cafe) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+type CafeSession struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Access               string               `protobuf:"bytes,2,opt,name=access,proto3" json:"access,omitempty"`
+	Exp                  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=exp,proto3" json:"exp,omitempty"`
+	Refresh              string               `protobuf:"bytes,4,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	Rexp                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=rexp,proto3" json:"rexp,omitempty"`
+	Subject              string               `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	Type                 string               `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	Cafe                 *Cafe                `protobuf:"bytes,8,opt,name=cafe,proto3" json:"cafe,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeSession) Reset()         { *m = CafeSession{} }
+func (m *CafeSession) String() string { return proto.CompactTextString(m) }
+func (*CafeSession) ProtoMessage()    {}
+func (*CafeSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{31}
+}
+func (m *CafeSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeSession.Unmarshal(m, b)
+}
+func (m *CafeSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeSession.Marshal(b, m, deterministic)
+}
+func (dst *CafeSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeSession.Merge(dst, src)
+}
+func (m *CafeSession) XXX_Size() int {
+	return xxx_messageInfo_CafeSession.Size(m)
+}
+func (m *CafeSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeSession proto.InternalMessageInfo
+
+func (m *CafeSession) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeSession) GetAccess() string {
+	if m != nil {
+		return m.Access
+	}
+	return ""
+}
+
+func (m *CafeSession) GetExp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Exp
+	}
+	return nil
+}
+
+func (m *CafeSession) GetRefresh() string {
+	if m != nil {
+		return m.Refresh
+	}
+	return ""
+}
+
+func (m *CafeSession) GetRexp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Rexp
+	}
+	return nil
+}
+
+func (m *CafeSession) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *CafeSession) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CafeSession) GetCafe() *Cafe {
+	if m != nil {
+		return m.Cafe
+	}
+	return nil
+}
+
+type CafeSessionList struct {
+	Items                []*CafeSession `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CafeSessionList) Reset()         { *m = CafeSessionList{} }
+func (m *CafeSessionList) String() string { return proto.CompactTextString(m) }
+func (*CafeSessionList) ProtoMessage()    {}
+func (*CafeSessionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{32}
+}
+func (m *CafeSessionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeSessionList.Unmarshal(m, b)
+}
+func (m *CafeSessionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeSessionList.Marshal(b, m, deterministic)
+}
+func (dst *CafeSessionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeSessionList.Merge(dst, src)
+}
+func (m *CafeSessionList) XXX_Size() int {
+	return xxx_messageInfo_CafeSessionList.Size(m)
+}
+func (m *CafeSessionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeSessionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeSessionList proto.InternalMessageInfo
+
+func (m *CafeSessionList) GetItems() []*CafeSession {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CafeRequest struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Target               string               `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Cafe                 *Cafe                `protobuf:"bytes,4,opt,name=cafe,proto3" json:"cafe,omitempty"`
+	Type                 CafeRequest_Type     `protobuf:"varint,5,opt,name=type,proto3,enum=CafeRequest_Type" json:"type,omitempty"`
+	Size                 int64                `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	Group                string               `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	Status               CafeRequest_Status   `protobuf:"varint,9,opt,name=status,proto3,enum=CafeRequest_Status" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeRequest) Reset()         { *m = CafeRequest{} }
+func (m *CafeRequest) String() string { return proto.CompactTextString(m) }
+func (*CafeRequest) ProtoMessage()    {}
+func (*CafeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{33}
+}
+func (m *CafeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeRequest.Unmarshal(m, b)
+}
+func (m *CafeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeRequest.Marshal(b, m, deterministic)
+}
+func (dst *CafeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeRequest.Merge(dst, src)
+}
+func (m *CafeRequest) XXX_Size() int {
+	return xxx_messageInfo_CafeRequest.Size(m)
+}
+func (m *CafeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeRequest proto.InternalMessageInfo
+
+func (m *CafeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeRequest) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *CafeRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *CafeRequest) GetCafe() *Cafe {
+	if m != nil {
+		return m.Cafe
+	}
+	return nil
+}
+
+func (m *CafeRequest) GetType() CafeRequest_Type {
+	if m != nil {
+		return m.Type
+	}
+	return CafeRequest_STORE
+}
+
+func (m *CafeRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CafeRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *CafeRequest) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *CafeRequest) GetStatus() CafeRequest_Status {
+	if m != nil {
+		return m.Status
+	}
+	return CafeRequest_NEW
+}
+
+type CafeRequestList struct {
+	Items                []*CafeRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CafeRequestList) Reset()         { *m = CafeRequestList{} }
+func (m *CafeRequestList) String() string { return proto.CompactTextString(m) }
+func (*CafeRequestList) ProtoMessage()    {}
+func (*CafeRequestList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{34}
+}
+func (m *CafeRequestList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeRequestList.Unmarshal(m, b)
+}
+func (m *CafeRequestList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeRequestList.Marshal(b, m, deterministic)
+}
+func (dst *CafeRequestList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeRequestList.Merge(dst, src)
+}
+func (m *CafeRequestList) XXX_Size() int {
+	return xxx_messageInfo_CafeRequestList.Size(m)
+}
+func (m *CafeRequestList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeRequestList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeRequestList proto.InternalMessageInfo
+
+func (m *CafeRequestList) GetItems() []*CafeRequest {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CafeRequestGroupStatus struct {
+	NumTotal             int32    `protobuf:"varint,1,opt,name=num_total,json=numTotal,proto3" json:"num_total,omitempty"`
+	NumPending           int32    `protobuf:"varint,2,opt,name=num_pending,json=numPending,proto3" json:"num_pending,omitempty"`
+	NumComplete          int32    `protobuf:"varint,3,opt,name=num_complete,json=numComplete,proto3" json:"num_complete,omitempty"`
+	SizeTotal            int64    `protobuf:"varint,4,opt,name=size_total,json=sizeTotal,proto3" json:"size_total,omitempty"`
+	SizePending          int64    `protobuf:"varint,5,opt,name=size_pending,json=sizePending,proto3" json:"size_pending,omitempty"`
+	SizeComplete         int64    `protobuf:"varint,6,opt,name=size_complete,json=sizeComplete,proto3" json:"size_complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeRequestGroupStatus) Reset()         { *m = CafeRequestGroupStatus{} }
+func (m *CafeRequestGroupStatus) String() string { return proto.CompactTextString(m) }
+func (*CafeRequestGroupStatus) ProtoMessage()    {}
+func (*CafeRequestGroupStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{35}
+}
+func (m *CafeRequestGroupStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeRequestGroupStatus.Unmarshal(m, b)
+}
+func (m *CafeRequestGroupStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeRequestGroupStatus.Marshal(b, m, deterministic)
+}
+func (dst *CafeRequestGroupStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeRequestGroupStatus.Merge(dst, src)
+}
+func (m *CafeRequestGroupStatus) XXX_Size() int {
+	return xxx_messageInfo_CafeRequestGroupStatus.Size(m)
+}
+func (m *CafeRequestGroupStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeRequestGroupStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeRequestGroupStatus proto.InternalMessageInfo
+
+func (m *CafeRequestGroupStatus) GetNumTotal() int32 {
+	if m != nil {
+		return m.NumTotal
+	}
+	return 0
+}
+
+func (m *CafeRequestGroupStatus) GetNumPending() int32 {
+	if m != nil {
+		return m.NumPending
+	}
+	return 0
+}
+
+func (m *CafeRequestGroupStatus) GetNumComplete() int32 {
+	if m != nil {
+		return m.NumComplete
+	}
+	return 0
+}
+
+func (m *CafeRequestGroupStatus) GetSizeTotal() int64 {
+	if m != nil {
+		return m.SizeTotal
+	}
+	return 0
+}
+
+func (m *CafeRequestGroupStatus) GetSizePending() int64 {
+	if m != nil {
+		return m.SizePending
+	}
+	return 0
+}
+
+func (m *CafeRequestGroupStatus) GetSizeComplete() int64 {
+	if m != nil {
+		return m.SizeComplete
+	}
+	return 0
+}
+
+type CafeHTTPRequest struct {
+	Type                 CafeHTTPRequest_Type `protobuf:"varint,1,opt,name=type,proto3,enum=CafeHTTPRequest_Type" json:"type,omitempty"`
+	Url                  string               `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers              map[string]string    `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body                 []byte               `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeHTTPRequest) Reset()         { *m = CafeHTTPRequest{} }
+func (m *CafeHTTPRequest) String() string { return proto.CompactTextString(m) }
+func (*CafeHTTPRequest) ProtoMessage()    {}
+func (*CafeHTTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{36}
+}
+func (m *CafeHTTPRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeHTTPRequest.Unmarshal(m, b)
+}
+func (m *CafeHTTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeHTTPRequest.Marshal(b, m, deterministic)
+}
+func (dst *CafeHTTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeHTTPRequest.Merge(dst, src)
+}
+func (m *CafeHTTPRequest) XXX_Size() int {
+	return xxx_messageInfo_CafeHTTPRequest.Size(m)
+}
+func (m *CafeHTTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeHTTPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeHTTPRequest proto.InternalMessageInfo
+
+func (m *CafeHTTPRequest) GetType() CafeHTTPRequest_Type {
+	if m != nil {
+		return m.Type
+	}
+	return CafeHTTPRequest_PUT
+}
+
+func (m *CafeHTTPRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CafeHTTPRequest) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *CafeHTTPRequest) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+type CafeMessage struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Attempts             int32                `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeMessage) Reset()         { *m = CafeMessage{} }
+func (m *CafeMessage) String() string { return proto.CompactTextString(m) }
+func (*CafeMessage) ProtoMessage()    {}
+func (*CafeMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{37}
+}
+func (m *CafeMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeMessage.Unmarshal(m, b)
+}
+func (m *CafeMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeMessage.Marshal(b, m, deterministic)
+}
+func (dst *CafeMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeMessage.Merge(dst, src)
+}
+func (m *CafeMessage) XXX_Size() int {
+	return xxx_messageInfo_CafeMessage.Size(m)
+}
+func (m *CafeMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeMessage proto.InternalMessageInfo
+
+func (m *CafeMessage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeMessage) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *CafeMessage) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *CafeMessage) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+type CafeClientNonce struct {
+	Value                string               `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeClientNonce) Reset()         { *m = CafeClientNonce{} }
+func (m *CafeClientNonce) String() string { return proto.CompactTextString(m) }
+func (*CafeClientNonce) ProtoMessage()    {}
+func (*CafeClientNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{38}
+}
+func (m *CafeClientNonce) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientNonce.Unmarshal(m, b)
+}
+func (m *CafeClientNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientNonce.Marshal(b, m, deterministic)
+}
+func (dst *CafeClientNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientNonce.Merge(dst, src)
+}
+func (m *CafeClientNonce) XXX_Size() int {
+	return xxx_messageInfo_CafeClientNonce.Size(m)
+}
+func (m *CafeClientNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientNonce proto.InternalMessageInfo
+
+func (m *CafeClientNonce) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *CafeClientNonce) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CafeClientNonce) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type CafeClient struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Created              *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	Seen                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=seen,proto3" json:"seen,omitempty"`
+	Token                string               `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	Webhook              string               `protobuf:"bytes,6,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeClient) Reset()         { *m = CafeClient{} }
+func (m *CafeClient) String() string { return proto.CompactTextString(m) }
+func (*CafeClient) ProtoMessage()    {}
+func (*CafeClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{39}
+}
+func (m *CafeClient) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClient.Unmarshal(m, b)
+}
+func (m *CafeClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClient.Marshal(b, m, deterministic)
+}
+func (dst *CafeClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClient.Merge(dst, src)
+}
+func (m *CafeClient) XXX_Size() int {
+	return xxx_messageInfo_CafeClient.Size(m)
+}
+func (m *CafeClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClient proto.InternalMessageInfo
+
+func (m *CafeClient) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeClient) GetAddress() string {
 	if m != nil {
-		return m.Type
+		return m.Address
 	}
-	return CafeRequest_STORE
+	return ""
 }
 
-func (m *CafeRequest) GetSize() int64 {
+func (m *CafeClient) GetCreated() *timestamp.Timestamp {
 	if m != nil {
-		return m.Size
+		return m.Created
 	}
-	return 0
+	return nil
 }
 
-func (m *CafeRequest) GetGroup() string {
+func (m *CafeClient) GetSeen() *timestamp.Timestamp {
 	if m != nil {
-		return m.Group
+		return m.Seen
 	}
-	return ""
+	return nil
 }
 
-func (m *CafeRequest) GetDate() *timestamp.Timestamp {
+func (m *CafeClient) GetToken() string {
 	if m != nil {
-		return m.Date
+		return m.Token
 	}
-	return nil
+	return ""
 }
 
-func (m *CafeRequest) GetStatus() CafeRequest_Status {
+func (m *CafeClient) GetWebhook() string {
 	if m != nil {
-		return m.Status
+		return m.Webhook
 	}
-	return CafeRequest_NEW
+	return ""
 }
 
-type CafeRequestList struct {
-	Items                []*CafeRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type CafeClientList struct {
+	Items                []*CafeClient `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *CafeRequestList) Reset()         { *m = CafeRequestList{} }
-func (m *CafeRequestList) String() string { return proto.CompactTextString(m) }
-func (*CafeRequestList) ProtoMessage()    {}
-func (*CafeRequestList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{22}
+func (m *CafeClientList) Reset()         { *m = CafeClientList{} }
+func (m *CafeClientList) String() string { return proto.CompactTextString(m) }
+func (*CafeClientList) ProtoMessage()    {}
+func (*CafeClientList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{40}
 }
-func (m *CafeRequestList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeRequestList.Unmarshal(m, b)
+func (m *CafeClientList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientList.Unmarshal(m, b)
 }
-func (m *CafeRequestList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeRequestList.Marshal(b, m, deterministic)
+func (m *CafeClientList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientList.Marshal(b, m, deterministic)
 }
-func (dst *CafeRequestList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeRequestList.Merge(dst, src)
+func (dst *CafeClientList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientList.Merge(dst, src)
 }
-func (m *CafeRequestList) XXX_Size() int {
-	return xxx_messageInfo_CafeRequestList.Size(m)
+func (m *CafeClientList) XXX_Size() int {
+	return xxx_messageInfo_CafeClientList.Size(m)
 }
-func (m *CafeRequestList) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeRequestList.DiscardUnknown(m)
+func (m *CafeClientList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CafeRequestList proto.InternalMessageInfo
+var xxx_messageInfo_CafeClientList proto.InternalMessageInfo
 
-func (m *CafeRequestList) GetItems() []*CafeRequest {
+func (m *CafeClientList) GetItems() []*CafeClient {
 	if m != nil {
 		return m.Items
 	}
 	return nil
 }
 
-type CafeRequestGroupStatus struct {
-	NumTotal             int32    `protobuf:"varint,1,opt,name=num_total,json=numTotal,proto3" json:"num_total,omitempty"`
-	NumPending           int32    `protobuf:"varint,2,opt,name=num_pending,json=numPending,proto3" json:"num_pending,omitempty"`
-	NumComplete          int32    `protobuf:"varint,3,opt,name=num_complete,json=numComplete,proto3" json:"num_complete,omitempty"`
-	SizeTotal            int64    `protobuf:"varint,4,opt,name=size_total,json=sizeTotal,proto3" json:"size_total,omitempty"`
-	SizePending          int64    `protobuf:"varint,5,opt,name=size_pending,json=sizePending,proto3" json:"size_pending,omitempty"`
-	SizeComplete         int64    `protobuf:"varint,6,opt,name=size_complete,json=sizeComplete,proto3" json:"size_complete,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CafeToken struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value                []byte               `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *CafeRequestGroupStatus) Reset()         { *m = CafeRequestGroupStatus{} }
-func (m *CafeRequestGroupStatus) String() string { return proto.CompactTextString(m) }
-func (*CafeRequestGroupStatus) ProtoMessage()    {}
-func (*CafeRequestGroupStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{23}
-}
-func (m *CafeRequestGroupStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeRequestGroupStatus.Unmarshal(m, b)
-}
-func (m *CafeRequestGroupStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeRequestGroupStatus.Marshal(b, m, deterministic)
+func (m *CafeToken) Reset()         { *m = CafeToken{} }
+func (m *CafeToken) String() string { return proto.CompactTextString(m) }
+func (*CafeToken) ProtoMessage()    {}
+func (*CafeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{41}
 }
-func (dst *CafeRequestGroupStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeRequestGroupStatus.Merge(dst, src)
+func (m *CafeToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeToken.Unmarshal(m, b)
 }
-func (m *CafeRequestGroupStatus) XXX_Size() int {
-	return xxx_messageInfo_CafeRequestGroupStatus.Size(m)
+func (m *CafeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeToken.Marshal(b, m, deterministic)
 }
-func (m *CafeRequestGroupStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeRequestGroupStatus.DiscardUnknown(m)
+func (dst *CafeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeToken.Merge(dst, src)
 }
-
-var xxx_messageInfo_CafeRequestGroupStatus proto.InternalMessageInfo
-
-func (m *CafeRequestGroupStatus) GetNumTotal() int32 {
-	if m != nil {
-		return m.NumTotal
-	}
-	return 0
+func (m *CafeToken) XXX_Size() int {
+	return xxx_messageInfo_CafeToken.Size(m)
 }
-
-func (m *CafeRequestGroupStatus) GetNumPending() int32 {
-	if m != nil {
-		return m.NumPending
-	}
-	return 0
+func (m *CafeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeToken.DiscardUnknown(m)
 }
 
-func (m *CafeRequestGroupStatus) GetNumComplete() int32 {
-	if m != nil {
-		return m.NumComplete
-	}
-	return 0
-}
+var xxx_messageInfo_CafeToken proto.InternalMessageInfo
 
-func (m *CafeRequestGroupStatus) GetSizeTotal() int64 {
+func (m *CafeToken) GetId() string {
 	if m != nil {
-		return m.SizeTotal
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *CafeRequestGroupStatus) GetSizePending() int64 {
+func (m *CafeToken) GetValue() []byte {
 	if m != nil {
-		return m.SizePending
+		return m.Value
 	}
-	return 0
+	return nil
 }
 
-func (m *CafeRequestGroupStatus) GetSizeComplete() int64 {
+func (m *CafeToken) GetDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.SizeComplete
+		return m.Date
 	}
-	return 0
+	return nil
 }
 
-type CafeHTTPRequest struct {
-	Type                 CafeHTTPRequest_Type `protobuf:"varint,1,opt,name=type,proto3,enum=CafeHTTPRequest_Type" json:"type,omitempty"`
-	Url                  string               `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Headers              map[string]string    `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Body                 []byte               `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+type CafeClientThread struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Client               string               `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Ciphertext           []byte               `protobuf:"bytes,3,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *CafeHTTPRequest) Reset()         { *m = CafeHTTPRequest{} }
-func (m *CafeHTTPRequest) String() string { return proto.CompactTextString(m) }
-func (*CafeHTTPRequest) ProtoMessage()    {}
-func (*CafeHTTPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{24}
+func (m *CafeClientThread) Reset()         { *m = CafeClientThread{} }
+func (m *CafeClientThread) String() string { return proto.CompactTextString(m) }
+func (*CafeClientThread) ProtoMessage()    {}
+func (*CafeClientThread) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{42}
 }
-func (m *CafeHTTPRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeHTTPRequest.Unmarshal(m, b)
+func (m *CafeClientThread) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientThread.Unmarshal(m, b)
 }
-func (m *CafeHTTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeHTTPRequest.Marshal(b, m, deterministic)
+func (m *CafeClientThread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientThread.Marshal(b, m, deterministic)
 }
-func (dst *CafeHTTPRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeHTTPRequest.Merge(dst, src)
+func (dst *CafeClientThread) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientThread.Merge(dst, src)
 }
-func (m *CafeHTTPRequest) XXX_Size() int {
-	return xxx_messageInfo_CafeHTTPRequest.Size(m)
+func (m *CafeClientThread) XXX_Size() int {
+	return xxx_messageInfo_CafeClientThread.Size(m)
 }
-func (m *CafeHTTPRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeHTTPRequest.DiscardUnknown(m)
+func (m *CafeClientThread) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientThread.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CafeHTTPRequest proto.InternalMessageInfo
+var xxx_messageInfo_CafeClientThread proto.InternalMessageInfo
 
-func (m *CafeHTTPRequest) GetType() CafeHTTPRequest_Type {
+func (m *CafeClientThread) GetId() string {
 	if m != nil {
-		return m.Type
+		return m.Id
 	}
-	return CafeHTTPRequest_PUT
+	return ""
 }
 
-func (m *CafeHTTPRequest) GetUrl() string {
+func (m *CafeClientThread) GetClient() string {
 	if m != nil {
-		return m.Url
+		return m.Client
 	}
 	return ""
 }
 
-func (m *CafeHTTPRequest) GetHeaders() map[string]string {
+func (m *CafeClientThread) GetCiphertext() []byte {
 	if m != nil {
-		return m.Headers
+		return m.Ciphertext
 	}
 	return nil
 }
 
-func (m *CafeHTTPRequest) GetBody() []byte {
+func (m *CafeClientThread) GetDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.Body
+		return m.Date
 	}
 	return nil
 }
 
-type CafeMessage struct {
+type CafeClientMessage struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
-	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	Attempts             int32                `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Client               string               `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *CafeMessage) Reset()         { *m = CafeMessage{} }
-func (m *CafeMessage) String() string { return proto.CompactTextString(m) }
-func (*CafeMessage) ProtoMessage()    {}
-func (*CafeMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{25}
+func (m *CafeClientMessage) Reset()         { *m = CafeClientMessage{} }
+func (m *CafeClientMessage) String() string { return proto.CompactTextString(m) }
+func (*CafeClientMessage) ProtoMessage()    {}
+func (*CafeClientMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{43}
 }
-func (m *CafeMessage) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeMessage.Unmarshal(m, b)
+func (m *CafeClientMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientMessage.Unmarshal(m, b)
 }
-func (m *CafeMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeMessage.Marshal(b, m, deterministic)
+func (m *CafeClientMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientMessage.Marshal(b, m, deterministic)
 }
-func (dst *CafeMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeMessage.Merge(dst, src)
+func (dst *CafeClientMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientMessage.Merge(dst, src)
 }
-func (m *CafeMessage) XXX_Size() int {
-	return xxx_messageInfo_CafeMessage.Size(m)
+func (m *CafeClientMessage) XXX_Size() int {
+	return xxx_messageInfo_CafeClientMessage.Size(m)
 }
-func (m *CafeMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeMessage.DiscardUnknown(m)
+func (m *CafeClientMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientMessage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CafeMessage proto.InternalMessageInfo
+var xxx_messageInfo_CafeClientMessage proto.InternalMessageInfo
 
-func (m *CafeMessage) GetId() string {
+func (m *CafeClientMessage) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *CafeMessage) GetPeer() string {
+func (m *CafeClientMessage) GetPeer() string {
 	if m != nil {
 		return m.Peer
 	}
 	return ""
 }
 
-func (m *CafeMessage) GetDate() *timestamp.Timestamp {
+func (m *CafeClientMessage) GetClient() string {
 	if m != nil {
-		return m.Date
+		return m.Client
 	}
-	return nil
+	return ""
 }
 
-func (m *CafeMessage) GetAttempts() int32 {
+func (m *CafeClientMessage) GetDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.Attempts
+		return m.Date
 	}
-	return 0
+	return nil
 }
 
-type CafeClientNonce struct {
-	Value                string               `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+type CafeClientPin struct {
+	Cid                  string               `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Client               string               `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Size                 int64                `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *CafeClientNonce) Reset()         { *m = CafeClientNonce{} }
-func (m *CafeClientNonce) String() string { return proto.CompactTextString(m) }
-func (*CafeClientNonce) ProtoMessage()    {}
-func (*CafeClientNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{26}
+func (m *CafeClientPin) Reset()         { *m = CafeClientPin{} }
+func (m *CafeClientPin) String() string { return proto.CompactTextString(m) }
+func (*CafeClientPin) ProtoMessage()    {}
+func (*CafeClientPin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{44}
 }
-func (m *CafeClientNonce) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeClientNonce.Unmarshal(m, b)
+func (m *CafeClientPin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientPin.Unmarshal(m, b)
 }
-func (m *CafeClientNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeClientNonce.Marshal(b, m, deterministic)
+func (m *CafeClientPin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientPin.Marshal(b, m, deterministic)
 }
-func (dst *CafeClientNonce) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeClientNonce.Merge(dst, src)
+func (dst *CafeClientPin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientPin.Merge(dst, src)
 }
-func (m *CafeClientNonce) XXX_Size() int {
-	return xxx_messageInfo_CafeClientNonce.Size(m)
+func (m *CafeClientPin) XXX_Size() int {
+	return xxx_messageInfo_CafeClientPin.Size(m)
 }
-func (m *CafeClientNonce) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeClientNonce.DiscardUnknown(m)
+func (m *CafeClientPin) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientPin.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CafeClientNonce proto.InternalMessageInfo
+var xxx_messageInfo_CafeClientPin proto.InternalMessageInfo
 
-func (m *CafeClientNonce) GetValue() string {
+func (m *CafeClientPin) GetCid() string {
 	if m != nil {
-		return m.Value
+		return m.Cid
 	}
 	return ""
 }
 
-func (m *CafeClientNonce) GetAddress() string {
+func (m *CafeClientPin) GetClient() string {
 	if m != nil {
-		return m.Address
+		return m.Client
 	}
 	return ""
 }
 
-func (m *CafeClientNonce) GetDate() *timestamp.Timestamp {
+func (m *CafeClientPin) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CafeClientPin) GetDate() *timestamp.Timestamp {
 	if m != nil {
 		return m.Date
 	}
 	return nil
 }
 
-type CafeClient struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Created              *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
-	Seen                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=seen,proto3" json:"seen,omitempty"`
-	Token                string               `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type CafeClientUsage struct {
+	Client               *CafeClient `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Pinned               int64       `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Threads              int64       `protobuf:"varint,3,opt,name=threads,proto3" json:"threads,omitempty"`
+	Messages             int32       `protobuf:"varint,4,opt,name=messages,proto3" json:"messages,omitempty"`
+	StorageLimit         int64       `protobuf:"varint,5,opt,name=storage_limit,json=storageLimit,proto3" json:"storage_limit,omitempty"`
+	InboxLimit           int32       `protobuf:"varint,6,opt,name=inbox_limit,json=inboxLimit,proto3" json:"inbox_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *CafeClient) Reset()         { *m = CafeClient{} }
-func (m *CafeClient) String() string { return proto.CompactTextString(m) }
-func (*CafeClient) ProtoMessage()    {}
-func (*CafeClient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{27}
+func (m *CafeClientUsage) Reset()         { *m = CafeClientUsage{} }
+func (m *CafeClientUsage) String() string { return proto.CompactTextString(m) }
+func (*CafeClientUsage) ProtoMessage()    {}
+func (*CafeClientUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{45}
 }
-func (m *CafeClient) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeClient.Unmarshal(m, b)
+func (m *CafeClientUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientUsage.Unmarshal(m, b)
 }
-func (m *CafeClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeClient.Marshal(b, m, deterministic)
+func (m *CafeClientUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientUsage.Marshal(b, m, deterministic)
 }
-func (dst *CafeClient) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeClient.Merge(dst, src)
+func (dst *CafeClientUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientUsage.Merge(dst, src)
 }
-func (m *CafeClient) XXX_Size() int {
-	return xxx_messageInfo_CafeClient.Size(m)
+func (m *CafeClientUsage) XXX_Size() int {
+	return xxx_messageInfo_CafeClientUsage.Size(m)
 }
-func (m *CafeClient) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeClient.DiscardUnknown(m)
+func (m *CafeClientUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientUsage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CafeClient proto.InternalMessageInfo
+var xxx_messageInfo_CafeClientUsage proto.InternalMessageInfo
 
-func (m *CafeClient) GetId() string {
+func (m *CafeClientUsage) GetClient() *CafeClient {
 	if m != nil {
-		return m.Id
+		return m.Client
 	}
-	return ""
+	return nil
 }
 
-func (m *CafeClient) GetAddress() string {
+func (m *CafeClientUsage) GetPinned() int64 {
 	if m != nil {
-		return m.Address
+		return m.Pinned
 	}
-	return ""
+	return 0
 }
 
-func (m *CafeClient) GetCreated() *timestamp.Timestamp {
+func (m *CafeClientUsage) GetThreads() int64 {
 	if m != nil {
-		return m.Created
+		return m.Threads
 	}
-	return nil
+	return 0
 }
 
-func (m *CafeClient) GetSeen() *timestamp.Timestamp {
+func (m *CafeClientUsage) GetMessages() int32 {
 	if m != nil {
-		return m.Seen
+		return m.Messages
 	}
-	return nil
+	return 0
 }
 
-func (m *CafeClient) GetToken() string {
+func (m *CafeClientUsage) GetStorageLimit() int64 {
 	if m != nil {
-		return m.Token
+		return m.StorageLimit
 	}
-	return ""
+	return 0
 }
 
-type CafeClientList struct {
-	Items                []*CafeClient `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *CafeClientUsage) GetInboxLimit() int32 {
+	if m != nil {
+		return m.InboxLimit
+	}
+	return 0
 }
 
-func (m *CafeClientList) Reset()         { *m = CafeClientList{} }
-func (m *CafeClientList) String() string { return proto.CompactTextString(m) }
-func (*CafeClientList) ProtoMessage()    {}
-func (*CafeClientList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{28}
+type CafeClientUsageList struct {
+	Items                []*CafeClientUsage `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
-func (m *CafeClientList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeClientList.Unmarshal(m, b)
+
+func (m *CafeClientUsageList) Reset()         { *m = CafeClientUsageList{} }
+func (m *CafeClientUsageList) String() string { return proto.CompactTextString(m) }
+func (*CafeClientUsageList) ProtoMessage()    {}
+func (*CafeClientUsageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{46}
 }
-func (m *CafeClientList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeClientList.Marshal(b, m, deterministic)
+func (m *CafeClientUsageList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientUsageList.Unmarshal(m, b)
 }
-func (dst *CafeClientList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeClientList.Merge(dst, src)
+func (m *CafeClientUsageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientUsageList.Marshal(b, m, deterministic)
 }
-func (m *CafeClientList) XXX_Size() int {
-	return xxx_messageInfo_CafeClientList.Size(m)
+func (dst *CafeClientUsageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientUsageList.Merge(dst, src)
 }
-func (m *CafeClientList) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeClientList.DiscardUnknown(m)
+func (m *CafeClientUsageList) XXX_Size() int {
+	return xxx_messageInfo_CafeClientUsageList.Size(m)
+}
+func (m *CafeClientUsageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientUsageList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CafeClientList proto.InternalMessageInfo
+var xxx_messageInfo_CafeClientUsageList proto.InternalMessageInfo
 
-func (m *CafeClientList) GetItems() []*CafeClient {
+func (m *CafeClientUsageList) GetItems() []*CafeClientUsage {
 	if m != nil {
 		return m.Items
 	}
 	return nil
 }
 
-type CafeToken struct {
+type CafeClientInfo struct {
+	Usage                *CafeClientUsage     `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	Threads              []string             `protobuf:"bytes,2,rep,name=threads,proto3" json:"threads,omitempty"`
+	Messages             []*CafeClientMessage `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeClientInfo) Reset()         { *m = CafeClientInfo{} }
+func (m *CafeClientInfo) String() string { return proto.CompactTextString(m) }
+func (*CafeClientInfo) ProtoMessage()    {}
+func (*CafeClientInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{47}
+}
+func (m *CafeClientInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientInfo.Unmarshal(m, b)
+}
+func (m *CafeClientInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientInfo.Marshal(b, m, deterministic)
+}
+func (dst *CafeClientInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientInfo.Merge(dst, src)
+}
+func (m *CafeClientInfo) XXX_Size() int {
+	return xxx_messageInfo_CafeClientInfo.Size(m)
+}
+func (m *CafeClientInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientInfo proto.InternalMessageInfo
+
+func (m *CafeClientInfo) GetUsage() *CafeClientUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+func (m *CafeClientInfo) GetThreads() []string {
+	if m != nil {
+		return m.Threads
+	}
+	return nil
+}
+
+func (m *CafeClientInfo) GetMessages() []*CafeClientMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type CafeClientBan struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Value                []byte               `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *CafeToken) Reset()         { *m = CafeToken{} }
-func (m *CafeToken) String() string { return proto.CompactTextString(m) }
-func (*CafeToken) ProtoMessage()    {}
-func (*CafeToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{29}
+func (m *CafeClientBan) Reset()         { *m = CafeClientBan{} }
+func (m *CafeClientBan) String() string { return proto.CompactTextString(m) }
+func (*CafeClientBan) ProtoMessage()    {}
+func (*CafeClientBan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{48}
 }
-func (m *CafeToken) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeToken.Unmarshal(m, b)
+func (m *CafeClientBan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientBan.Unmarshal(m, b)
 }
-func (m *CafeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeToken.Marshal(b, m, deterministic)
+func (m *CafeClientBan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientBan.Marshal(b, m, deterministic)
 }
-func (dst *CafeToken) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeToken.Merge(dst, src)
+func (dst *CafeClientBan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientBan.Merge(dst, src)
 }
-func (m *CafeToken) XXX_Size() int {
-	return xxx_messageInfo_CafeToken.Size(m)
+func (m *CafeClientBan) XXX_Size() int {
+	return xxx_messageInfo_CafeClientBan.Size(m)
 }
-func (m *CafeToken) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeToken.DiscardUnknown(m)
+func (m *CafeClientBan) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientBan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CafeToken proto.InternalMessageInfo
+var xxx_messageInfo_CafeClientBan proto.InternalMessageInfo
 
-func (m *CafeToken) GetId() string {
+func (m *CafeClientBan) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *CafeToken) GetValue() []byte {
+func (m *CafeClientBan) GetAddress() string {
 	if m != nil {
-		return m.Value
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func (m *CafeToken) GetDate() *timestamp.Timestamp {
+func (m *CafeClientBan) GetDate() *timestamp.Timestamp {
 	if m != nil {
 		return m.Date
 	}
 	return nil
 }
 
-type CafeClientThread struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Client               string   `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
-	Ciphertext           []byte   `protobuf:"bytes,3,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CafeClientBanList struct {
+	Items                []*CafeClientBan `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *CafeClientThread) Reset()         { *m = CafeClientThread{} }
-func (m *CafeClientThread) String() string { return proto.CompactTextString(m) }
-func (*CafeClientThread) ProtoMessage()    {}
-func (*CafeClientThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{30}
+func (m *CafeClientBanList) Reset()         { *m = CafeClientBanList{} }
+func (m *CafeClientBanList) String() string { return proto.CompactTextString(m) }
+func (*CafeClientBanList) ProtoMessage()    {}
+func (*CafeClientBanList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{49}
 }
-func (m *CafeClientThread) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeClientThread.Unmarshal(m, b)
+func (m *CafeClientBanList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientBanList.Unmarshal(m, b)
 }
-func (m *CafeClientThread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeClientThread.Marshal(b, m, deterministic)
+func (m *CafeClientBanList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientBanList.Marshal(b, m, deterministic)
 }
-func (dst *CafeClientThread) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeClientThread.Merge(dst, src)
+func (dst *CafeClientBanList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientBanList.Merge(dst, src)
 }
-func (m *CafeClientThread) XXX_Size() int {
-	return xxx_messageInfo_CafeClientThread.Size(m)
+func (m *CafeClientBanList) XXX_Size() int {
+	return xxx_messageInfo_CafeClientBanList.Size(m)
 }
-func (m *CafeClientThread) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeClientThread.DiscardUnknown(m)
+func (m *CafeClientBanList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientBanList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CafeClientThread proto.InternalMessageInfo
+var xxx_messageInfo_CafeClientBanList proto.InternalMessageInfo
 
-func (m *CafeClientThread) GetId() string {
+func (m *CafeClientBanList) GetItems() []*CafeClientBan {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// records data removed for a cafe client, so that replica cafes don't restore it
+type CafeClientTombstone struct {
+	Id                   string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Client               string                   `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Type                 CafeClientTombstone_Type `protobuf:"varint,3,opt,name=type,proto3,enum=CafeClientTombstone_Type" json:"type,omitempty"`
+	Date                 *timestamp.Timestamp     `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CafeClientTombstone) Reset()         { *m = CafeClientTombstone{} }
+func (m *CafeClientTombstone) String() string { return proto.CompactTextString(m) }
+func (*CafeClientTombstone) ProtoMessage()    {}
+func (*CafeClientTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{50}
+}
+func (m *CafeClientTombstone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientTombstone.Unmarshal(m, b)
+}
+func (m *CafeClientTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientTombstone.Marshal(b, m, deterministic)
+}
+func (dst *CafeClientTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientTombstone.Merge(dst, src)
+}
+func (m *CafeClientTombstone) XXX_Size() int {
+	return xxx_messageInfo_CafeClientTombstone.Size(m)
+}
+func (m *CafeClientTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientTombstone proto.InternalMessageInfo
+
+func (m *CafeClientTombstone) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *CafeClientThread) GetClient() string {
+func (m *CafeClientTombstone) GetClient() string {
 	if m != nil {
 		return m.Client
 	}
 	return ""
 }
 
-func (m *CafeClientThread) GetCiphertext() []byte {
+func (m *CafeClientTombstone) GetType() CafeClientTombstone_Type {
 	if m != nil {
-		return m.Ciphertext
+		return m.Type
+	}
+	return CafeClientTombstone_PIN
+}
+
+func (m *CafeClientTombstone) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
 	}
 	return nil
 }
 
-type CafeClientMessage struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
-	Client               string               `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
-	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+type CafeGCReport struct {
+	Clients              []string             `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+	Threads              int32                `protobuf:"varint,2,opt,name=threads,proto3" json:"threads,omitempty"`
+	Messages             int32                `protobuf:"varint,3,opt,name=messages,proto3" json:"messages,omitempty"`
+	Unpinned             int32                `protobuf:"varint,4,opt,name=unpinned,proto3" json:"unpinned,omitempty"`
+	Reclaimed            int64                `protobuf:"varint,5,opt,name=reclaimed,proto3" json:"reclaimed,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *CafeClientMessage) Reset()         { *m = CafeClientMessage{} }
-func (m *CafeClientMessage) String() string { return proto.CompactTextString(m) }
-func (*CafeClientMessage) ProtoMessage()    {}
-func (*CafeClientMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_fe102913065d6e40, []int{31}
+func (m *CafeGCReport) Reset()         { *m = CafeGCReport{} }
+func (m *CafeGCReport) String() string { return proto.CompactTextString(m) }
+func (*CafeGCReport) ProtoMessage()    {}
+func (*CafeGCReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_c52c3115150ad454, []int{51}
 }
-func (m *CafeClientMessage) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CafeClientMessage.Unmarshal(m, b)
+func (m *CafeGCReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeGCReport.Unmarshal(m, b)
 }
-func (m *CafeClientMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CafeClientMessage.Marshal(b, m, deterministic)
+func (m *CafeGCReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeGCReport.Marshal(b, m, deterministic)
 }
-func (dst *CafeClientMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CafeClientMessage.Merge(dst, src)
+func (dst *CafeGCReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeGCReport.Merge(dst, src)
 }
-func (m *CafeClientMessage) XXX_Size() int {
-	return xxx_messageInfo_CafeClientMessage.Size(m)
+func (m *CafeGCReport) XXX_Size() int {
+	return xxx_messageInfo_CafeGCReport.Size(m)
 }
-func (m *CafeClientMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_CafeClientMessage.DiscardUnknown(m)
+func (m *CafeGCReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeGCReport.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CafeClientMessage proto.InternalMessageInfo
+var xxx_messageInfo_CafeGCReport proto.InternalMessageInfo
 
-func (m *CafeClientMessage) GetId() string {
+func (m *CafeGCReport) GetClients() []string {
 	if m != nil {
-		return m.Id
+		return m.Clients
 	}
-	return ""
+	return nil
 }
 
-func (m *CafeClientMessage) GetPeer() string {
+func (m *CafeGCReport) GetThreads() int32 {
 	if m != nil {
-		return m.Peer
+		return m.Threads
 	}
-	return ""
+	return 0
 }
 
-func (m *CafeClientMessage) GetClient() string {
+func (m *CafeGCReport) GetMessages() int32 {
 	if m != nil {
-		return m.Client
+		return m.Messages
 	}
-	return ""
+	return 0
 }
 
-func (m *CafeClientMessage) GetDate() *timestamp.Timestamp {
+func (m *CafeGCReport) GetUnpinned() int32 {
+	if m != nil {
+		return m.Unpinned
+	}
+	return 0
+}
+
+func (m *CafeGCReport) GetReclaimed() int64 {
+	if m != nil {
+		return m.Reclaimed
+	}
+	return 0
+}
+
+func (m *CafeGCReport) GetDate() *timestamp.Timestamp {
 	if m != nil {
 		return m.Date
 	}
@@ -2462,6 +3821,14 @@ func init() {
 	proto.RegisterType((*Block)(nil), "Block")
 	proto.RegisterType((*BlockList)(nil), "BlockList")
 	proto.RegisterType((*BlockMessage)(nil), "BlockMessage")
+	proto.RegisterType((*RejectedBlock)(nil), "RejectedBlock")
+	proto.RegisterType((*RejectedBlockList)(nil), "RejectedBlockList")
+	proto.RegisterType((*ThreadKey)(nil), "ThreadKey")
+	proto.RegisterType((*ThreadKeyList)(nil), "ThreadKeyList")
+	proto.RegisterType((*ThreadAdvert)(nil), "ThreadAdvert")
+	proto.RegisterType((*ThreadAdvertList)(nil), "ThreadAdvertList")
+	proto.RegisterType((*ThreadArchive)(nil), "ThreadArchive")
+	proto.RegisterType((*ThreadArchiveKeys)(nil), "ThreadArchiveKeys")
 	proto.RegisterType((*Invite)(nil), "Invite")
 	proto.RegisterType((*InviteList)(nil), "InviteList")
 	proto.RegisterType((*FileIndex)(nil), "FileIndex")
@@ -2472,6 +3839,10 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "Link.OptsEntry")
 	proto.RegisterType((*Notification)(nil), "Notification")
 	proto.RegisterType((*NotificationList)(nil), "NotificationList")
+	proto.RegisterType((*NotificationSettings)(nil), "NotificationSettings")
+	proto.RegisterType((*NotificationSettingsList)(nil), "NotificationSettingsList")
+	proto.RegisterType((*Change)(nil), "Change")
+	proto.RegisterType((*ChangeList)(nil), "ChangeList")
 	proto.RegisterType((*Cafe)(nil), "Cafe")
 	proto.RegisterType((*CafeSession)(nil), "CafeSession")
 	proto.RegisterType((*CafeSessionList)(nil), "CafeSessionList")
@@ -2487,154 +3858,226 @@ func init() {
 	proto.RegisterType((*CafeToken)(nil), "CafeToken")
 	proto.RegisterType((*CafeClientThread)(nil), "CafeClientThread")
 	proto.RegisterType((*CafeClientMessage)(nil), "CafeClientMessage")
+	proto.RegisterType((*CafeClientPin)(nil), "CafeClientPin")
+	proto.RegisterType((*CafeClientUsage)(nil), "CafeClientUsage")
+	proto.RegisterType((*CafeClientUsageList)(nil), "CafeClientUsageList")
+	proto.RegisterType((*CafeClientInfo)(nil), "CafeClientInfo")
+	proto.RegisterType((*CafeClientBan)(nil), "CafeClientBan")
+	proto.RegisterType((*CafeClientBanList)(nil), "CafeClientBanList")
+	proto.RegisterType((*CafeClientTombstone)(nil), "CafeClientTombstone")
+	proto.RegisterType((*CafeGCReport)(nil), "CafeGCReport")
 	proto.RegisterEnum("Thread_Type", Thread_Type_name, Thread_Type_value)
 	proto.RegisterEnum("Thread_Sharing", Thread_Sharing_name, Thread_Sharing_value)
 	proto.RegisterEnum("Thread_State", Thread_State_name, Thread_State_value)
+	proto.RegisterEnum("Thread_Role", Thread_Role_name, Thread_Role_value)
 	proto.RegisterEnum("Block_BlockType", Block_BlockType_name, Block_BlockType_value)
 	proto.RegisterEnum("Notification_Type", Notification_Type_name, Notification_Type_value)
+	proto.RegisterEnum("Change_Type", Change_Type_name, Change_Type_value)
 	proto.RegisterEnum("CafeRequest_Type", CafeRequest_Type_name, CafeRequest_Type_value)
 	proto.RegisterEnum("CafeRequest_Status", CafeRequest_Status_name, CafeRequest_Status_value)
 	proto.RegisterEnum("CafeHTTPRequest_Type", CafeHTTPRequest_Type_name, CafeHTTPRequest_Type_value)
-}
-
-func init() { proto.RegisterFile("model.proto", fileDescriptor_model_fe102913065d6e40) }
-
-var fileDescriptor_model_fe102913065d6e40 = []byte{
-	// 2174 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x18, 0x4d, 0x93, 0xdb, 0x48,
-	0x35, 0xb2, 0x24, 0xdb, 0x7a, 0xf6, 0xcc, 0x68, 0x3b, 0x21, 0xab, 0x9d, 0x24, 0x9b, 0x59, 0x85,
-	0x84, 0xa4, 0xb2, 0x78, 0xa9, 0x59, 0x20, 0xa9, 0xbd, 0x50, 0x8e, 0x47, 0x99, 0x18, 0x3c, 0xb6,
-	0x4b, 0xf6, 0x84, 0x65, 0x2f, 0x2e, 0x8d, 0xdc, 0x33, 0xd6, 0x8e, 0x2d, 0x79, 0x25, 0x39, 0x9b,
-	0x50, 0x45, 0xed, 0x05, 0x28, 0xae, 0x14, 0x47, 0xfe, 0x05, 0xbf, 0x81, 0x1f, 0xc1, 0x0d, 0x8a,
-	0x1b, 0x77, 0x8a, 0x23, 0x45, 0xbd, 0xd7, 0xdd, 0xb6, 0x9c, 0x99, 0x0c, 0x33, 0xd4, 0x72, 0xb1,
-	0xfb, 0x7d, 0x74, 0xbf, 0xd7, 0xef, 0xbb, 0x05, 0xb5, 0x59, 0x32, 0xe6, 0xd3, 0xc6, 0x3c, 0x4d,
-	0xf2, 0x64, 0xfb, 0xee, 0x49, 0x92, 0x9c, 0x4c, 0xf9, 0x27, 0x04, 0x1d, 0x2d, 0x8e, 0x3f, 0xc9,
-	0xa3, 0x19, 0xcf, 0xf2, 0x60, 0x36, 0x97, 0x0c, 0xb7, 0xdf, 0x66, 0xc8, 0xf2, 0x74, 0x11, 0xe6,
-	0x92, 0xba, 0x31, 0xe3, 0x59, 0x16, 0x9c, 0x70, 0x01, 0xba, 0xff, 0xd0, 0xc0, 0xe8, 0x73, 0x9e,
-	0xb2, 0x4d, 0x28, 0x45, 0x63, 0x47, 0xdb, 0xd1, 0x1e, 0x5a, 0x7e, 0x29, 0x1a, 0x33, 0x07, 0x2a,
-	0xc1, 0x78, 0x9c, 0xf2, 0x2c, 0x73, 0x4a, 0x84, 0x54, 0x20, 0x63, 0x60, 0xc4, 0xc1, 0x8c, 0x3b,
-	0x3a, 0xa1, 0x69, 0xcd, 0x6e, 0x42, 0x39, 0x78, 0x15, 0xe4, 0x41, 0xea, 0x18, 0x84, 0x95, 0x10,
-	0xbb, 0x0b, 0x95, 0x28, 0x3e, 0x4a, 0x5e, 0xf3, 0xcc, 0x31, 0x77, 0xf4, 0x87, 0xb5, 0x5d, 0xb3,
-	0xd1, 0x0a, 0x8e, 0xb9, 0xaf, 0xb0, 0xec, 0x87, 0x50, 0x09, 0x53, 0x1e, 0xe4, 0x7c, 0xec, 0x94,
-	0x77, 0xb4, 0x87, 0xb5, 0xdd, 0xed, 0x86, 0x50, 0xbf, 0xa1, 0xd4, 0x6f, 0x0c, 0xd5, 0xfd, 0x7c,
-	0xc5, 0x8a, 0xbb, 0x16, 0xf3, 0x31, 0xed, 0xaa, 0xfc, 0xf7, 0x5d, 0x92, 0xd5, 0xfd, 0x1e, 0x54,
-	0xf1, 0xaa, 0x9d, 0x28, 0xcb, 0xd9, 0x2d, 0x30, 0xa3, 0x9c, 0xcf, 0x32, 0x47, 0x93, 0x6a, 0x21,
-	0xc5, 0x17, 0x38, 0xb7, 0x03, 0xc6, 0x61, 0xc6, 0xd3, 0xa2, 0x0d, 0xb4, 0xf3, 0x6d, 0x50, 0x3a,
-	0xd7, 0x06, 0x7a, 0xd1, 0x06, 0xee, 0x6f, 0x35, 0xa8, 0xb4, 0x92, 0x38, 0x0f, 0xc2, 0xfc, 0xdb,
-	0x39, 0x11, 0x95, 0x9f, 0x73, 0x9e, 0x66, 0x8e, 0xb1, 0xa6, 0x3c, 0xe1, 0x50, 0x44, 0x3e, 0x49,
-	0x79, 0x30, 0x16, 0x26, 0xb7, 0x7c, 0x05, 0xba, 0xdf, 0x87, 0x9a, 0xd4, 0x83, 0x4c, 0xf0, 0xe1,
-	0xba, 0x09, 0xaa, 0x0d, 0x49, 0x54, 0x56, 0xf8, 0xbb, 0x01, 0xe5, 0x21, 0x6d, 0x3d, 0x13, 0x1c,
-	0x36, 0xe8, 0xa7, 0xfc, 0x8d, 0xd4, 0x15, 0x97, 0xc8, 0x91, 0x9d, 0x92, 0x9a, 0x75, 0xbf, 0x94,
-	0x9d, 0x2e, 0xaf, 0x63, 0xac, 0x5f, 0x27, 0x0b, 0x27, 0x7c, 0x16, 0x38, 0xa6, 0xb8, 0x8e, 0x80,
-	0xd8, 0x6d, 0xb0, 0xa2, 0x38, 0xca, 0xa3, 0x20, 0x4f, 0x52, 0x8a, 0x02, 0xcb, 0x5f, 0x21, 0xd8,
-	0x0e, 0x18, 0xf9, 0x9b, 0x39, 0x27, 0x47, 0x6f, 0xee, 0xd6, 0x1b, 0x42, 0xa5, 0xc6, 0xf0, 0xcd,
-	0x9c, 0xfb, 0x44, 0x61, 0x8f, 0xa0, 0x92, 0x4d, 0x82, 0x34, 0x8a, 0x4f, 0x9c, 0x2a, 0x31, 0x6d,
-	0x29, 0xa6, 0x81, 0x40, 0xfb, 0x8a, 0x8e, 0xa2, 0xbe, 0x9e, 0x44, 0x39, 0x9f, 0x46, 0x59, 0xee,
-	0x58, 0x64, 0x9e, 0x15, 0x82, 0xdd, 0x03, 0x33, 0xcb, 0x83, 0x9c, 0x3b, 0x40, 0xc7, 0x6c, 0x2c,
-	0x8f, 0x41, 0xa4, 0x2f, 0x68, 0x78, 0xb3, 0x09, 0x0f, 0xc6, 0x4e, 0x4d, 0xdc, 0x0c, 0xd7, 0xec,
-	0x3e, 0x00, 0xfe, 0x8f, 0x8e, 0xa6, 0x49, 0x78, 0xea, 0x70, 0x0a, 0xc9, 0x72, 0xe3, 0x19, 0x42,
-	0xbe, 0x85, 0x14, 0x5a, 0xb2, 0x07, 0x50, 0x13, 0x57, 0x1e, 0xc5, 0xc9, 0x98, 0x3b, 0xc7, 0xc4,
-	0x67, 0x36, 0xba, 0xc9, 0x98, 0xfb, 0x20, 0x28, 0xb8, 0x66, 0x77, 0xa1, 0x46, 0x27, 0x8d, 0xc2,
-	0x64, 0x11, 0xe7, 0xce, 0xc9, 0x8e, 0xf6, 0xd0, 0xf4, 0x81, 0x50, 0x2d, 0xc4, 0xb0, 0x3b, 0x00,
-	0xe8, 0x6c, 0x49, 0x9f, 0x10, 0xdd, 0x42, 0x0c, 0x91, 0xdd, 0xa7, 0x60, 0xa0, 0x79, 0x58, 0x0d,
-	0x2a, 0x7d, 0xbf, 0xfd, 0xb2, 0x39, 0xf4, 0xec, 0x6b, 0x6c, 0x03, 0x2c, 0xdf, 0x6b, 0xee, 0x8d,
-	0x7a, 0xdd, 0xce, 0x2f, 0x6c, 0x8d, 0x01, 0x94, 0xfb, 0x87, 0xcf, 0x3a, 0xed, 0x96, 0x5d, 0x62,
-	0x55, 0x30, 0x7a, 0x7d, 0xaf, 0x6b, 0xeb, 0xee, 0x8f, 0xa1, 0x22, 0x6d, 0xc6, 0x36, 0x01, 0xba,
-	0xbd, 0xe1, 0x68, 0xf0, 0xa2, 0xe9, 0x7b, 0x7b, 0xf6, 0x35, 0xb6, 0x05, 0xb5, 0x76, 0xf7, 0x65,
-	0x7b, 0xe8, 0x15, 0x4e, 0x90, 0xc4, 0x92, 0xfb, 0x04, 0x4c, 0x32, 0x12, 0xb3, 0xa1, 0xde, 0xe9,
-	0x35, 0xf7, 0xda, 0xdd, 0xfd, 0xd1, 0xb0, 0xd9, 0xee, 0xd8, 0xd7, 0x90, 0x0d, 0x31, 0xde, 0x9e,
-	0xad, 0x15, 0xa9, 0x2f, 0xbc, 0x26, 0x6e, 0x7c, 0x0c, 0x20, 0x8c, 0x4c, 0x21, 0x79, 0x67, 0x3d,
-	0x24, 0x2b, 0xd2, 0x01, 0x2a, 0x22, 0xfb, 0x8a, 0xf9, 0xdc, 0x8a, 0x75, 0x13, 0xca, 0x22, 0xd2,
-	0x65, 0x5c, 0x4a, 0x88, 0x6d, 0x43, 0xf5, 0x6b, 0x3e, 0x0d, 0x93, 0x19, 0x1f, 0x53, 0x80, 0x56,
-	0xfd, 0x25, 0xec, 0xfe, 0x46, 0x07, 0x53, 0xf8, 0xe6, 0xb2, 0xa7, 0x61, 0x4e, 0x2e, 0xf2, 0x49,
-	0xb2, 0xca, 0x49, 0x82, 0xd8, 0x77, 0x65, 0x98, 0x1a, 0x14, 0x3a, 0xb6, 0x70, 0xbe, 0xf8, 0x2d,
-	0x84, 0x6a, 0x03, 0x0c, 0xac, 0x45, 0x94, 0x00, 0x17, 0x57, 0x2d, 0xe2, 0xc3, 0x64, 0x9e, 0x07,
-	0x29, 0x8f, 0xf3, 0xcc, 0x29, 0x8b, 0x64, 0x96, 0x20, 0xe9, 0x17, 0xa4, 0x27, 0x3c, 0xa7, 0xc4,
-	0x40, 0xfd, 0x08, 0xc2, 0xf0, 0x3c, 0x4a, 0xc6, 0x6f, 0x28, 0x13, 0x2c, 0x9f, 0xd6, 0xec, 0x03,
-	0x30, 0x16, 0x19, 0x4f, 0x65, 0x60, 0x9a, 0x0d, 0x2c, 0x6e, 0x3e, 0xa1, 0xdc, 0x5f, 0x6b, 0x60,
-	0x2d, 0x95, 0x64, 0x16, 0x98, 0x07, 0x9e, 0xbf, 0xef, 0x09, 0xb7, 0xb5, 0xf7, 0xbb, 0x3d, 0xdf,
-	0xb3, 0x35, 0x8c, 0x8f, 0xe7, 0x9d, 0xe6, 0xbe, 0x88, 0x94, 0x9f, 0xf6, 0xda, 0x5d, 0x5b, 0x67,
-	0x75, 0xa8, 0x36, 0xbb, 0xdd, 0xde, 0x61, 0xb7, 0xe5, 0xd9, 0x06, 0x6e, 0xec, 0x78, 0xcd, 0x97,
-	0x9e, 0x6d, 0x22, 0xcb, 0xd0, 0xfb, 0x7c, 0x68, 0x97, 0x11, 0xf9, 0xbc, 0xdd, 0xf1, 0x06, 0x76,
-	0x05, 0x23, 0xb1, 0xd5, 0x3b, 0x38, 0xf0, 0xba, 0x43, 0xbb, 0x8a, 0x1c, 0x9d, 0xf6, 0xcf, 0x3c,
-	0xdb, 0x62, 0x15, 0xd0, 0x9b, 0x7b, 0x7b, 0xf6, 0xae, 0xfb, 0x48, 0x6a, 0x41, 0x51, 0x70, 0x7b,
-	0x3d, 0x0a, 0x54, 0x22, 0xc9, 0x20, 0xf8, 0x06, 0xea, 0x04, 0x1f, 0x88, 0x3e, 0x76, 0xc6, 0x71,
-	0x0c, 0x0c, 0xcc, 0x04, 0x55, 0x48, 0x71, 0xcd, 0x6e, 0x81, 0xce, 0xe3, 0x57, 0xe4, 0xb1, 0xda,
-	0xae, 0xd5, 0xf0, 0xe2, 0x57, 0x7c, 0x9a, 0xcc, 0xb9, 0x8f, 0xd8, 0xa5, 0x4f, 0x8c, 0xcb, 0xf9,
-	0xc4, 0xfd, 0x83, 0x06, 0xe5, 0x76, 0xfc, 0x2a, 0xca, 0xcf, 0xca, 0xbe, 0x01, 0xa6, 0x28, 0x01,
-	0x25, 0x2a, 0x84, 0x02, 0x38, 0xb7, 0x61, 0x52, 0x63, 0xc4, 0x33, 0x52, 0x29, 0x57, 0x16, 0x71,
-	0x85, 0xbd, 0x6a, 0xa4, 0x60, 0x22, 0x09, 0xa5, 0xce, 0x4f, 0x24, 0x41, 0x53, 0x36, 0xfc, 0x73,
-	0x09, 0xac, 0xe7, 0xd1, 0x94, 0xb7, 0xe3, 0x31, 0x7f, 0x8d, 0xfa, 0xcd, 0xa2, 0xe9, 0x54, 0xde,
-	0x83, 0xd6, 0x98, 0x34, 0xe1, 0x84, 0x87, 0xa7, 0xd9, 0x62, 0x26, 0x2d, 0xb9, 0x84, 0xa9, 0x8e,
-	0x27, 0x8b, 0x34, 0x54, 0x37, 0x92, 0x10, 0x9e, 0x93, 0xcc, 0xf3, 0x4c, 0xd5, 0x7c, 0x5c, 0x53,
-	0xb5, 0x0c, 0xb2, 0x89, 0xac, 0xf8, 0xb4, 0x56, 0xdd, 0xa3, 0xbc, 0xea, 0x1e, 0x37, 0xc0, 0x9c,
-	0xf1, 0x71, 0x14, 0xc8, 0x58, 0x16, 0xc0, 0xd2, 0x6e, 0xd5, 0x82, 0xdd, 0x18, 0x18, 0x59, 0xf4,
-	0x4b, 0xee, 0x58, 0x3b, 0xda, 0x43, 0xdd, 0xa7, 0x35, 0xfb, 0x01, 0x98, 0xc1, 0x78, 0xcc, 0xc7,
-	0x54, 0xb6, 0x2f, 0xb6, 0x95, 0x60, 0x64, 0x8f, 0xc1, 0x98, 0xf1, 0x3c, 0xa0, 0x1a, 0x5e, 0xdb,
-	0x7d, 0xff, 0xcc, 0x86, 0x01, 0x4d, 0x4c, 0x3e, 0x31, 0x51, 0x43, 0xa5, 0xdc, 0xca, 0x9c, 0xba,
-	0x6c, 0xa8, 0x02, 0x74, 0xff, 0x5a, 0x02, 0x83, 0x0a, 0xb6, 0xd2, 0x54, 0x2b, 0x68, 0x6a, 0x83,
-	0x3e, 0x8f, 0x62, 0x32, 0x5e, 0xd5, 0xc7, 0x25, 0x36, 0x9f, 0xf9, 0x34, 0x88, 0xe2, 0x9c, 0xbf,
-	0xce, 0x65, 0x25, 0x5a, 0x21, 0x96, 0x5e, 0x30, 0x0a, 0x5e, 0xb8, 0x27, 0x2d, 0x2a, 0x66, 0xa7,
-	0x2d, 0xea, 0x14, 0x8d, 0xde, 0x3c, 0xcf, 0xbc, 0x38, 0x4f, 0xdf, 0x48, 0x13, 0x3f, 0x85, 0xda,
-	0x97, 0x59, 0x12, 0x8f, 0x64, 0x6f, 0x2d, 0x5f, 0x7c, 0x27, 0x40, 0xde, 0x81, 0x68, 0xbc, 0x0f,
-	0xc0, 0x9c, 0x46, 0xf1, 0x69, 0xe6, 0x54, 0xe9, 0x7c, 0x5b, 0x9c, 0xdf, 0x41, 0x94, 0x10, 0x20,
-	0xc8, 0xdb, 0x4f, 0xc0, 0x5a, 0x0a, 0x55, 0xde, 0xd3, 0xd6, 0xbc, 0xf7, 0x2a, 0x98, 0x2e, 0xd4,
-	0xec, 0x22, 0x80, 0xcf, 0x4a, 0x4f, 0xb5, 0xed, 0x9f, 0x00, 0xac, 0x4e, 0x3b, 0x67, 0xe7, 0xad,
-	0xe2, 0x4e, 0xcc, 0x01, 0xe4, 0x2e, 0x1c, 0xe0, 0xfe, 0x53, 0x03, 0x03, 0x71, 0xb8, 0x77, 0x91,
-	0x29, 0x03, 0xe3, 0xf2, 0xff, 0x62, 0x5f, 0x14, 0xf5, 0xed, 0xd9, 0xf7, 0x7f, 0xb6, 0x9b, 0xfb,
-	0x17, 0x1d, 0xea, 0xdd, 0x24, 0x8f, 0x8e, 0xa3, 0x30, 0xc8, 0xa3, 0x24, 0x3e, 0x53, 0x68, 0x54,
-	0x75, 0x28, 0x5d, 0xb2, 0x8f, 0xdc, 0x00, 0x33, 0x08, 0xf3, 0x65, 0xd3, 0x12, 0x00, 0x46, 0x76,
-	0xb6, 0x38, 0xfa, 0x92, 0x87, 0xb9, 0xb4, 0x8a, 0x02, 0xd9, 0x47, 0x50, 0x97, 0xcb, 0xd1, 0x98,
-	0x67, 0xa1, 0x4c, 0xdf, 0x9a, 0xc4, 0xed, 0xf1, 0x2c, 0x5c, 0xd5, 0x3a, 0x91, 0xc7, 0xb2, 0xd6,
-	0xbd, 0xab, 0x2d, 0x3d, 0x90, 0xed, 0x51, 0x0c, 0x68, 0xac, 0x51, 0xbc, 0x5d, 0x71, 0x96, 0x53,
-	0xed, 0xcb, 0x2a, 0xb4, 0x2f, 0x06, 0x06, 0x35, 0x62, 0x20, 0x97, 0xd2, 0xfa, 0xa2, 0x96, 0xf6,
-	0x47, 0x4d, 0x8e, 0x3f, 0xd7, 0x61, 0x4b, 0x4e, 0x2c, 0xbe, 0xd7, 0xf2, 0xda, 0x2f, 0x69, 0x8c,
-	0x79, 0x1f, 0xae, 0x37, 0x5b, 0xad, 0xde, 0x61, 0x77, 0x38, 0xea, 0x7b, 0x9e, 0x3f, 0xc2, 0x76,
-	0x46, 0xb3, 0xc9, 0x16, 0xd4, 0x8a, 0x88, 0x12, 0x0e, 0x4c, 0x84, 0xe8, 0x78, 0xcf, 0x87, 0xb6,
-	0xce, 0xde, 0x83, 0x8d, 0x03, 0x6f, 0x30, 0x68, 0xee, 0x7b, 0xa3, 0xe6, 0x1e, 0x8e, 0x33, 0x06,
-	0x6e, 0xa1, 0x06, 0x27, 0x11, 0x26, 0xf2, 0xc8, 0x36, 0x27, 0x51, 0x65, 0x1c, 0xa3, 0xb0, 0xd9,
-	0x49, 0xb8, 0xe2, 0x3e, 0x01, 0xbb, 0x78, 0xf7, 0x8e, 0x9c, 0x3b, 0x8b, 0xd5, 0x7a, 0x63, 0xcd,
-	0x3a, 0xaa, 0x66, 0xff, 0x4e, 0x03, 0x03, 0xdf, 0x4e, 0xcb, 0x06, 0xa7, 0x15, 0x1a, 0xdc, 0xbb,
-	0x5f, 0x6b, 0x36, 0xe8, 0xc1, 0x3c, 0x92, 0x7e, 0xc7, 0x25, 0x96, 0x76, 0x8a, 0x93, 0x30, 0x51,
-	0xc9, 0xb0, 0x84, 0xa9, 0x90, 0xe1, 0x68, 0x2a, 0xcb, 0x35, 0xae, 0x29, 0xf5, 0xd2, 0xa9, 0x2a,
-	0xd7, 0x8b, 0x74, 0xea, 0xfe, 0x4b, 0x83, 0x1a, 0xaa, 0x32, 0xe0, 0x59, 0x76, 0x5e, 0x74, 0xe2,
-	0x8c, 0x14, 0x86, 0x2b, 0x65, 0x24, 0xc4, 0x3e, 0x06, 0x9d, 0xbf, 0x9e, 0xcb, 0x36, 0x7c, 0x51,
-	0xd0, 0x22, 0x1b, 0xde, 0x29, 0xe5, 0xc7, 0x29, 0xcf, 0x26, 0x2a, 0x3a, 0x25, 0x88, 0xd1, 0x9f,
-	0xe2, 0x41, 0x97, 0xe8, 0x8d, 0xa9, 0x3c, 0x49, 0xc5, 0x79, 0x79, 0x3d, 0xce, 0x59, 0xe1, 0x71,
-	0x61, 0xc9, 0x10, 0xfc, 0x00, 0x8c, 0x30, 0x38, 0x16, 0xa1, 0xba, 0x7c, 0xb0, 0x12, 0xca, 0xfd,
-	0x11, 0x6c, 0x15, 0xee, 0x4d, 0xbe, 0x73, 0xd7, 0x7d, 0x57, 0x6f, 0x14, 0x18, 0x94, 0xeb, 0x7e,
-	0xaf, 0x0b, 0x7b, 0xf9, 0xfc, 0xab, 0x05, 0xcf, 0xf2, 0x4b, 0x8d, 0x2c, 0xab, 0x44, 0xd2, 0xd7,
-	0x12, 0x49, 0x69, 0x67, 0x9c, 0xd1, 0x8e, 0xdd, 0x97, 0x97, 0x31, 0x29, 0xc7, 0xde, 0x6b, 0x14,
-	0x44, 0xbe, 0x95, 0x62, 0xd4, 0x42, 0x2b, 0x85, 0x16, 0x7a, 0x03, 0xcc, 0x93, 0x34, 0x59, 0xcc,
-	0x65, 0xaf, 0x15, 0xc0, 0xb2, 0xca, 0x94, 0x2f, 0x59, 0x65, 0x1e, 0x43, 0x19, 0xdf, 0x48, 0x8b,
-	0x8c, 0xd2, 0x77, 0x73, 0xf7, 0xfa, 0x9a, 0x0a, 0x03, 0x22, 0xf9, 0x92, 0xc5, 0xed, 0xc9, 0x2c,
-	0xb5, 0xc0, 0x1c, 0x0c, 0x71, 0xce, 0xbc, 0x86, 0x53, 0xe2, 0x61, 0x57, 0x00, 0x3a, 0xbe, 0x15,
-	0x68, 0x39, 0x1a, 0xbe, 0xc0, 0x77, 0x8b, 0xad, 0x31, 0x06, 0x9b, 0x92, 0xac, 0x70, 0x34, 0x78,
-	0xb6, 0xbb, 0xcf, 0x7a, 0x9f, 0xdb, 0x25, 0xf7, 0x63, 0x28, 0x0b, 0x11, 0x38, 0x56, 0x76, 0xbd,
-	0x9f, 0x8b, 0x03, 0xfb, 0x5e, 0x17, 0xdf, 0x1b, 0xb6, 0x86, 0x13, 0x6b, 0xab, 0x77, 0xd0, 0xef,
-	0x78, 0x43, 0xcf, 0x2e, 0x29, 0x57, 0x4a, 0xe5, 0xde, 0xed, 0x4a, 0xc9, 0xa0, 0x5c, 0xf9, 0x37,
-	0x0d, 0x6e, 0x16, 0xd0, 0xfb, 0x68, 0x27, 0x29, 0xf5, 0x16, 0x58, 0xf1, 0x62, 0x36, 0xca, 0x93,
-	0x3c, 0x10, 0xb3, 0x94, 0xe9, 0x57, 0xe3, 0xc5, 0x6c, 0x88, 0x30, 0x3e, 0xe9, 0x90, 0x38, 0xe7,
-	0xf1, 0x18, 0xdf, 0xa9, 0x25, 0xf1, 0xa4, 0x8b, 0x17, 0xb3, 0xbe, 0xc0, 0x60, 0xc5, 0x45, 0x86,
-	0x30, 0x99, 0xcd, 0xa7, 0x3c, 0x17, 0xa3, 0x95, 0xe9, 0xe3, 0xa6, 0x96, 0x44, 0xe1, 0xab, 0x0f,
-	0x9d, 0x25, 0x25, 0x18, 0xe4, 0x3e, 0x0b, 0x31, 0x42, 0x04, 0xd6, 0x6c, 0x24, 0x2b, 0x19, 0x26,
-	0x31, 0xd4, 0x10, 0xa7, 0x84, 0xdc, 0x83, 0x0d, 0x62, 0x59, 0x4a, 0x29, 0x13, 0x0f, 0xed, 0x53,
-	0x62, 0xdc, 0x7f, 0x6b, 0xc2, 0x34, 0x2f, 0x86, 0xc3, 0xbe, 0x8a, 0xd8, 0x47, 0x32, 0xb4, 0x34,
-	0xf2, 0xeb, 0x77, 0x1a, 0x6f, 0xd1, 0x8b, 0xe1, 0x25, 0xcb, 0x45, 0x69, 0x59, 0x2e, 0xd8, 0x13,
-	0xa8, 0xe0, 0x1b, 0x98, 0xa7, 0x99, 0xa3, 0x93, 0x65, 0xef, 0x9c, 0xd9, 0xff, 0x42, 0xd0, 0x45,
-	0xdb, 0x55, 0xdc, 0xcb, 0x66, 0x60, 0xd0, 0x34, 0x4d, 0xeb, 0xed, 0xcf, 0xa0, 0x5e, 0x64, 0xbe,
-	0x52, 0x5b, 0xbd, 0x2f, 0x43, 0xae, 0x02, 0x7a, 0xff, 0x70, 0x68, 0x5f, 0xc3, 0x97, 0x48, 0xbf,
-	0x37, 0x18, 0x8a, 0xc7, 0xec, 0x9e, 0x27, 0x43, 0xe3, 0x57, 0x22, 0x5b, 0xaf, 0xf2, 0xc0, 0x50,
-	0x99, 0xa2, 0x5f, 0x32, 0x53, 0xb6, 0xa1, 0x1a, 0xe4, 0x39, 0x9f, 0xa9, 0x71, 0xd9, 0xf4, 0x97,
-	0xb0, 0xfb, 0x95, 0x30, 0x7f, 0x6b, 0x1a, 0xf1, 0x38, 0xef, 0x26, 0x71, 0xc8, 0x57, 0x57, 0xd2,
-	0x0a, 0x57, 0xba, 0xa0, 0xe8, 0x5f, 0x51, 0x1d, 0xf7, 0x4f, 0x1a, 0xc0, 0x4a, 0xe6, 0x15, 0xbe,
-	0x05, 0x16, 0x3e, 0xdf, 0xe9, 0x97, 0xff, 0x7c, 0xd7, 0x00, 0x23, 0xe3, 0x3c, 0xbe, 0xcc, 0x8b,
-	0x0b, 0xf9, 0xf0, 0xfa, 0x79, 0x72, 0xca, 0x63, 0xd9, 0x96, 0x04, 0xe0, 0x7e, 0x0a, 0x9b, 0x2b,
-	0x9d, 0x29, 0x81, 0x3f, 0x5a, 0x4f, 0xe0, 0x5a, 0x63, 0x45, 0x57, 0xf9, 0x1b, 0x80, 0x85, 0xc8,
-	0x21, 0x9e, 0x70, 0xde, 0xf3, 0x6d, 0x15, 0x39, 0x75, 0x65, 0xe6, 0xab, 0x1a, 0xf3, 0x0b, 0xb0,
-	0x57, 0x72, 0xdf, 0xf1, 0x01, 0xed, 0x26, 0x94, 0x43, 0xa2, 0xab, 0x0e, 0x29, 0x20, 0xf6, 0x21,
-	0x40, 0x18, 0xcd, 0x27, 0x3c, 0x5d, 0xce, 0xb0, 0x75, 0xbf, 0x80, 0x71, 0xbf, 0x81, 0xf7, 0x56,
-	0x67, 0x5f, 0x25, 0x40, 0x57, 0x02, 0xf5, 0x35, 0x81, 0x57, 0x7c, 0xfc, 0x3e, 0xbb, 0x0e, 0x1b,
-	0x51, 0xd2, 0x40, 0x5d, 0x22, 0x64, 0x3b, 0xfa, 0xa2, 0x34, 0x3f, 0x3a, 0x2a, 0x13, 0xfb, 0xa7,
-	0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x45, 0xa3, 0x56, 0x83, 0xa8, 0x16, 0x00, 0x00,
+	proto.RegisterEnum("CafeClientTombstone_Type", CafeClientTombstone_Type_name, CafeClientTombstone_Type_value)
+}
+
+func init() { proto.RegisterFile("model.proto", fileDescriptor_model_c52c3115150ad454) }
+
+var fileDescriptor_model_c52c3115150ad454 = []byte{
+	// 3142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x4f, 0x93, 0xdb, 0xc6,
+	0xb1, 0x17, 0x08, 0x80, 0x7f, 0x9a, 0xdc, 0x15, 0x04, 0xc9, 0x6b, 0x7a, 0x65, 0x5b, 0x32, 0x64,
+	0xeb, 0xc9, 0x25, 0x1b, 0x7e, 0x4f, 0x7e, 0x7e, 0xb2, 0x5d, 0xf5, 0x9e, 0x8b, 0x22, 0xa1, 0x15,
+	0x2d, 0x2e, 0xb9, 0x85, 0xc5, 0xca, 0xf6, 0xbb, 0xb0, 0xb0, 0xe4, 0x68, 0x09, 0x2f, 0x09, 0xd0,
+	0x00, 0xb8, 0xd6, 0xa6, 0xca, 0xe5, 0xaa, 0x1c, 0x52, 0xf9, 0x53, 0xa9, 0x4a, 0x2a, 0x9f, 0x20,
+	0xf9, 0x2c, 0x39, 0x24, 0xa9, 0x24, 0x87, 0xe4, 0x90, 0x63, 0x72, 0xc8, 0x25, 0x5f, 0x20, 0xc7,
+	0x54, 0xaa, 0x7b, 0x66, 0x40, 0x70, 0x97, 0x6b, 0x91, 0x29, 0xe7, 0xb2, 0x3b, 0xdd, 0xd3, 0x33,
+	0xd3, 0xd3, 0xfd, 0xeb, 0xe9, 0x9e, 0x01, 0xa1, 0x3a, 0x89, 0x86, 0x6c, 0x6c, 0x4f, 0xe3, 0x28,
+	0x8d, 0xb6, 0x6f, 0x1c, 0x45, 0xd1, 0xd1, 0x98, 0xbd, 0x43, 0xd4, 0xe1, 0xec, 0xe9, 0x3b, 0x69,
+	0x30, 0x61, 0x49, 0xea, 0x4f, 0xa6, 0x42, 0xe0, 0xe5, 0xb3, 0x02, 0x49, 0x1a, 0xcf, 0x06, 0xa9,
+	0xe8, 0xdd, 0x98, 0xb0, 0x24, 0xf1, 0x8f, 0x18, 0x27, 0xad, 0xbf, 0x29, 0xa0, 0xed, 0x31, 0x16,
+	0x9b, 0x9b, 0x50, 0x08, 0x86, 0x75, 0xe5, 0xa6, 0x72, 0xa7, 0xe2, 0x16, 0x82, 0xa1, 0x59, 0x87,
+	0x92, 0x3f, 0x1c, 0xc6, 0x2c, 0x49, 0xea, 0x05, 0x62, 0x4a, 0xd2, 0x34, 0x41, 0x0b, 0xfd, 0x09,
+	0xab, 0xab, 0xc4, 0xa6, 0xb6, 0xb9, 0x05, 0x45, 0xff, 0xc4, 0x4f, 0xfd, 0xb8, 0xae, 0x11, 0x57,
+	0x50, 0xe6, 0x0d, 0x28, 0x05, 0xe1, 0x61, 0xf4, 0x8c, 0x25, 0x75, 0xfd, 0xa6, 0x7a, 0xa7, 0x7a,
+	0x4f, 0xb7, 0x9b, 0xfe, 0x53, 0xe6, 0x4a, 0xae, 0xf9, 0xdf, 0x50, 0x1a, 0xc4, 0xcc, 0x4f, 0xd9,
+	0xb0, 0x5e, 0xbc, 0xa9, 0xdc, 0xa9, 0xde, 0xdb, 0xb6, 0xb9, 0xfa, 0xb6, 0x54, 0xdf, 0xf6, 0xe4,
+	0xfe, 0x5c, 0x29, 0x8a, 0xa3, 0x66, 0xd3, 0x21, 0x8d, 0x2a, 0x3d, 0x7f, 0x94, 0x10, 0xb5, 0xfe,
+	0x03, 0xca, 0xb8, 0xd5, 0x4e, 0x90, 0xa4, 0xe6, 0x75, 0xd0, 0x83, 0x94, 0x4d, 0x92, 0xba, 0x22,
+	0xd4, 0xc2, 0x1e, 0x97, 0xf3, 0xac, 0x0e, 0x68, 0x07, 0x09, 0x8b, 0xf3, 0x36, 0x50, 0x96, 0xdb,
+	0xa0, 0xb0, 0xd4, 0x06, 0x6a, 0xde, 0x06, 0xd6, 0xf7, 0x14, 0x28, 0x35, 0xa3, 0x30, 0xf5, 0x07,
+	0xe9, 0xb7, 0x33, 0x23, 0x2a, 0x3f, 0x65, 0x2c, 0x4e, 0xea, 0xda, 0x82, 0xf2, 0xc4, 0xc3, 0x25,
+	0xd2, 0x51, 0xcc, 0xfc, 0x21, 0x37, 0x79, 0xc5, 0x95, 0xa4, 0xf5, 0x36, 0x54, 0x85, 0x1e, 0x64,
+	0x82, 0x57, 0x17, 0x4d, 0x50, 0xb6, 0x45, 0xa7, 0xb4, 0xc2, 0x2f, 0x74, 0x28, 0x7a, 0x34, 0xf4,
+	0x1c, 0x38, 0x0c, 0x50, 0x8f, 0xd9, 0xa9, 0xd0, 0x15, 0x9b, 0x28, 0x91, 0x1c, 0x93, 0x9a, 0x35,
+	0xb7, 0x90, 0x1c, 0x67, 0xdb, 0xd1, 0x16, 0xb7, 0x93, 0x0c, 0x46, 0x6c, 0xe2, 0xd7, 0x75, 0xbe,
+	0x1d, 0x4e, 0x99, 0x2f, 0x43, 0x25, 0x08, 0x83, 0x34, 0xf0, 0xd3, 0x28, 0x26, 0x14, 0x54, 0xdc,
+	0x39, 0xc3, 0xbc, 0x09, 0x5a, 0x7a, 0x3a, 0x65, 0xe4, 0xe8, 0xcd, 0x7b, 0x35, 0x9b, 0xab, 0x64,
+	0x7b, 0xa7, 0x53, 0xe6, 0x52, 0x8f, 0xf9, 0x26, 0x94, 0x92, 0x91, 0x1f, 0x07, 0xe1, 0x51, 0xbd,
+	0x4c, 0x42, 0x97, 0xa5, 0xd0, 0x3e, 0x67, 0xbb, 0xb2, 0x1f, 0x97, 0xfa, 0x72, 0x14, 0xa4, 0x6c,
+	0x1c, 0x24, 0x69, 0xbd, 0x42, 0xe6, 0x99, 0x33, 0xcc, 0x5b, 0xa0, 0x27, 0xa9, 0x9f, 0xb2, 0x3a,
+	0xd0, 0x34, 0x1b, 0xd9, 0x34, 0xc8, 0x74, 0x79, 0x1f, 0xee, 0x6c, 0xc4, 0xfc, 0x61, 0xbd, 0xca,
+	0x77, 0x86, 0x6d, 0xf3, 0x0d, 0x00, 0xfc, 0xdf, 0x3f, 0x1c, 0x47, 0x83, 0xe3, 0x3a, 0x23, 0x48,
+	0x16, 0xed, 0x07, 0x48, 0xb9, 0x15, 0xec, 0xa1, 0xa6, 0x79, 0x1b, 0xaa, 0x7c, 0xcb, 0xfd, 0x30,
+	0x1a, 0xb2, 0xfa, 0x53, 0x92, 0xd3, 0xed, 0x6e, 0x34, 0x64, 0x2e, 0xf0, 0x1e, 0x6c, 0x9b, 0x37,
+	0xa0, 0x4a, 0x33, 0xf5, 0x07, 0xd1, 0x2c, 0x4c, 0xeb, 0x47, 0x37, 0x95, 0x3b, 0xba, 0x0b, 0xc4,
+	0x6a, 0x22, 0xc7, 0x7c, 0x05, 0x00, 0x9d, 0x2d, 0xfa, 0x47, 0xd4, 0x5f, 0x41, 0x0e, 0x75, 0x5b,
+	0xef, 0x83, 0x86, 0xe6, 0x31, 0xab, 0x50, 0xda, 0x73, 0xdb, 0x4f, 0x1a, 0x9e, 0x63, 0x5c, 0x32,
+	0x37, 0xa0, 0xe2, 0x3a, 0x8d, 0x56, 0xbf, 0xd7, 0xed, 0x7c, 0x66, 0x28, 0x26, 0x40, 0x71, 0xef,
+	0xe0, 0x41, 0xa7, 0xdd, 0x34, 0x0a, 0x66, 0x19, 0xb4, 0xde, 0x9e, 0xd3, 0x35, 0x54, 0xeb, 0x7f,
+	0xa0, 0x24, 0x6c, 0x66, 0x6e, 0x02, 0x74, 0x7b, 0x5e, 0x7f, 0xff, 0x51, 0xc3, 0x75, 0x5a, 0xc6,
+	0x25, 0xf3, 0x32, 0x54, 0xdb, 0xdd, 0x27, 0x6d, 0xcf, 0xc9, 0xcd, 0x20, 0x3a, 0x0b, 0xd6, 0x7d,
+	0xd0, 0xc9, 0x48, 0xa6, 0x01, 0xb5, 0x4e, 0xaf, 0xd1, 0x6a, 0x77, 0x77, 0xfa, 0x5e, 0xa3, 0xdd,
+	0x31, 0x2e, 0xa1, 0x18, 0x72, 0x9c, 0x96, 0xa1, 0xe4, 0x7b, 0x1f, 0x39, 0x0d, 0x1c, 0xf8, 0x7f,
+	0xa0, 0xb9, 0xd1, 0x98, 0xa1, 0x0a, 0xdd, 0x5e, 0x17, 0xf5, 0x2c, 0x83, 0x86, 0x7a, 0x1a, 0x8a,
+	0x59, 0x83, 0x72, 0xa3, 0xdb, 0xed, 0x79, 0xa8, 0x7f, 0xc1, 0xac, 0x80, 0xfe, 0x89, 0xdb, 0xf6,
+	0x1c, 0x43, 0xc5, 0x66, 0xa3, 0xb5, 0xdb, 0xee, 0x1a, 0x9a, 0x75, 0x17, 0x80, 0x3b, 0x89, 0x20,
+	0xfd, 0xca, 0x22, 0xa4, 0x4b, 0xc2, 0x81, 0x12, 0xd1, 0x7b, 0x52, 0x78, 0xe9, 0x89, 0xb7, 0x05,
+	0x45, 0x1e, 0x29, 0x02, 0xd7, 0x82, 0x32, 0xb7, 0xa1, 0xfc, 0x25, 0x1b, 0x0f, 0xa2, 0x09, 0x1b,
+	0x12, 0xc0, 0xcb, 0x6e, 0x46, 0x5b, 0xbf, 0x57, 0x41, 0xe7, 0xbe, 0x5d, 0x75, 0x36, 0x8c, 0xe9,
+	0x59, 0x3a, 0x8a, 0xe6, 0x31, 0x4d, 0x94, 0xf9, 0xba, 0x80, 0xb9, 0x46, 0xd0, 0x33, 0x38, 0x78,
+	0xf8, 0xdf, 0x1c, 0xd4, 0x6d, 0xd0, 0xf0, 0x2c, 0xab, 0xeb, 0xcf, 0x3d, 0xf5, 0x48, 0x0e, 0x0f,
+	0x83, 0xa9, 0x1f, 0xb3, 0x30, 0x4d, 0xea, 0x45, 0x7e, 0x18, 0x08, 0x92, 0xf4, 0xf3, 0xe3, 0x23,
+	0x96, 0xd6, 0x4b, 0x42, 0x3f, 0xa2, 0x10, 0xde, 0x87, 0xd1, 0xf0, 0x94, 0x22, 0xa9, 0xe2, 0x52,
+	0xdb, 0x7c, 0x09, 0xb4, 0x59, 0xc2, 0x62, 0x01, 0x6c, 0xdd, 0xc6, 0xc3, 0xd1, 0x25, 0x96, 0xf5,
+	0x3b, 0x05, 0x2a, 0x99, 0x92, 0xe8, 0x98, 0x5d, 0xc7, 0xdd, 0x71, 0xb8, 0xdb, 0xdb, 0x3b, 0xdd,
+	0x9e, 0xeb, 0x18, 0x0a, 0xba, 0xf4, 0x61, 0xa7, 0xb1, 0xc3, 0x91, 0xf6, 0x71, 0xaf, 0xdd, 0x35,
+	0x54, 0xe9, 0xdc, 0x83, 0x6e, 0xd3, 0x31, 0x34, 0x1c, 0xd8, 0x71, 0x1a, 0x4f, 0x1c, 0x43, 0x47,
+	0x11, 0xcf, 0xf9, 0xd4, 0x33, 0x8a, 0xc8, 0x7c, 0xd8, 0xee, 0x38, 0xfb, 0x46, 0x09, 0x91, 0xdc,
+	0xec, 0xed, 0xee, 0x3a, 0x5d, 0xcf, 0x28, 0xa3, 0x44, 0xa7, 0xfd, 0xd8, 0x31, 0x2a, 0x88, 0x51,
+	0xb7, 0xd7, 0x71, 0xfa, 0x3b, 0x6e, 0xa3, 0xeb, 0x19, 0x80, 0x18, 0x25, 0xda, 0x75, 0x9e, 0xf4,
+	0x1e, 0x3b, 0x46, 0x15, 0x05, 0x1e, 0x3b, 0x9f, 0xf5, 0x5d, 0x0e, 0xa2, 0x1a, 0x0e, 0x75, 0x5a,
+	0x6d, 0xcf, 0xd8, 0xc0, 0xf5, 0x5d, 0xa7, 0xd1, 0xf4, 0xda, 0xbd, 0xae, 0xb1, 0x69, 0x96, 0x40,
+	0x6d, 0xb4, 0x5a, 0xc6, 0x3d, 0xeb, 0x4d, 0xb1, 0x1d, 0x82, 0xd3, 0xcb, 0x8b, 0x70, 0x92, 0x11,
+	0x2d, 0xd0, 0xf4, 0x35, 0xd4, 0x88, 0xde, 0xe5, 0x09, 0xf5, 0x1c, 0x02, 0x4c, 0xd0, 0x30, 0x24,
+	0xe5, 0x89, 0x8e, 0x6d, 0xf3, 0x3a, 0xa8, 0x2c, 0x3c, 0x21, 0xd7, 0x57, 0xef, 0x55, 0x6c, 0x27,
+	0x3c, 0x61, 0xe3, 0x68, 0xca, 0x5c, 0xe4, 0x66, 0xce, 0xd5, 0x56, 0x73, 0xae, 0xf5, 0x27, 0x05,
+	0x36, 0x5c, 0xf6, 0x39, 0x1b, 0xa4, 0x6c, 0xf8, 0xed, 0x80, 0x30, 0x97, 0x9e, 0xb4, 0xc5, 0xf4,
+	0x24, 0xe1, 0xa9, 0xaf, 0x04, 0xcf, 0xe2, 0x8a, 0xf0, 0xdc, 0x82, 0x62, 0xcc, 0xfc, 0x24, 0x0a,
+	0x25, 0x08, 0x39, 0x65, 0x7d, 0x00, 0x57, 0x16, 0x36, 0x46, 0xde, 0x78, 0x7d, 0xd1, 0x1b, 0x9b,
+	0xf6, 0x82, 0x88, 0xf4, 0xca, 0x0f, 0x14, 0xa8, 0xf0, 0x20, 0x7f, 0xcc, 0x4e, 0x57, 0x36, 0xc8,
+	0x35, 0xd0, 0xd9, 0x34, 0x1a, 0x8c, 0xc8, 0x1e, 0xba, 0xcb, 0x09, 0x91, 0xd4, 0xb4, 0x2c, 0xa9,
+	0xad, 0x19, 0x7d, 0xd6, 0x7f, 0xc1, 0x46, 0xa6, 0x0a, 0x6d, 0xe1, 0xe6, 0xe2, 0x16, 0xc0, 0xce,
+	0xba, 0xa5, 0xfa, 0x3f, 0x2a, 0x40, 0x8d, 0x33, 0x1b, 0xc3, 0x13, 0x16, 0xa7, 0xcb, 0x50, 0xb5,
+	0xac, 0x4e, 0x10, 0x89, 0x55, 0xbd, 0x38, 0xb1, 0x6a, 0x17, 0x25, 0x56, 0xfd, 0xc2, 0xc4, 0xba,
+	0x05, 0xc5, 0x20, 0x3c, 0x09, 0x84, 0x43, 0x2b, 0xae, 0xa0, 0x30, 0xfd, 0xf0, 0x56, 0x1f, 0xab,
+	0x80, 0x92, 0x9c, 0x18, 0x39, 0x68, 0xf4, 0x3a, 0x94, 0x38, 0x11, 0x8b, 0x53, 0x44, 0x92, 0x99,
+	0x01, 0x2b, 0x2b, 0x1a, 0xf0, 0x3e, 0x18, 0x79, 0x63, 0x74, 0x44, 0x92, 0xce, 0xdb, 0x70, 0xc3,
+	0xce, 0x4b, 0x48, 0x33, 0xfe, 0x41, 0x91, 0xa6, 0x6f, 0xc4, 0x83, 0x51, 0x70, 0x42, 0x27, 0xe1,
+	0x09, 0x8b, 0x93, 0x20, 0x0a, 0xc9, 0x98, 0xba, 0x2b, 0x49, 0xf3, 0xc6, 0x02, 0x26, 0x72, 0x59,
+	0x43, 0xb0, 0xf3, 0x51, 0xa1, 0x9e, 0x2b, 0xda, 0x8e, 0xd9, 0x69, 0x22, 0x20, 0x42, 0x6d, 0x34,
+	0x1a, 0x65, 0x6a, 0x59, 0x7e, 0x09, 0x6a, 0xed, 0xd8, 0x30, 0x40, 0x4d, 0x82, 0x23, 0xb2, 0x6e,
+	0xcd, 0xc5, 0xa6, 0xd5, 0x84, 0x2b, 0x0b, 0x7b, 0x7a, 0x8c, 0xcb, 0x71, 0x8c, 0x2a, 0x19, 0x46,
+	0x5f, 0x15, 0x2a, 0x15, 0xce, 0x21, 0x8c, 0xf8, 0xd6, 0xcf, 0x14, 0x28, 0xb6, 0xb9, 0x1b, 0xcf,
+	0x42, 0xeb, 0x1a, 0xe8, 0xbc, 0x80, 0x29, 0xd0, 0x6c, 0x9c, 0x58, 0x5a, 0xee, 0xdf, 0x98, 0x7b,
+	0x58, 0x13, 0x39, 0x81, 0x4a, 0xd0, 0x73, 0x8e, 0x5e, 0x35, 0x52, 0xee, 0x02, 0x70, 0xa5, 0x96,
+	0xa7, 0x71, 0xde, 0x27, 0x9d, 0xfb, 0xcb, 0x02, 0x54, 0x1e, 0x06, 0x63, 0xd6, 0x0e, 0x87, 0xec,
+	0x19, 0xea, 0x37, 0x09, 0xc6, 0x63, 0xb1, 0x0f, 0x6a, 0x63, 0xca, 0x1e, 0x8c, 0xd8, 0xe0, 0x38,
+	0x99, 0x4d, 0x44, 0xa0, 0x64, 0x34, 0x05, 0x4b, 0x34, 0x8b, 0x07, 0x2c, 0x0b, 0x16, 0xa2, 0x70,
+	0x9e, 0x68, 0x9a, 0xca, 0x83, 0x8f, 0xda, 0xc8, 0x1b, 0xf9, 0xc9, 0x48, 0xd4, 0xab, 0xd4, 0x96,
+	0xb5, 0x6f, 0x71, 0x5e, 0xfb, 0x5e, 0x03, 0x7d, 0xc2, 0x86, 0x81, 0x2f, 0x22, 0x81, 0x13, 0x99,
+	0xdd, 0xca, 0x39, 0xbb, 0x99, 0xa0, 0x25, 0xc1, 0x77, 0x38, 0xfe, 0x55, 0x97, 0xda, 0xe6, 0x7f,
+	0x82, 0xee, 0x0f, 0x87, 0x6c, 0x58, 0x87, 0xe7, 0xda, 0x8a, 0x0b, 0x9a, 0x77, 0x41, 0x9b, 0xb0,
+	0xd4, 0xa7, 0x0a, 0xb4, 0x7a, 0xef, 0xc5, 0x73, 0x03, 0xf6, 0xe9, 0xbe, 0xe7, 0x92, 0x10, 0x5d,
+	0x07, 0x28, 0xb3, 0x27, 0xf5, 0x9a, 0xb8, 0x0e, 0x70, 0xd2, 0xfa, 0x73, 0x01, 0x34, 0x2a, 0x37,
+	0xa5, 0xa6, 0x4a, 0x4e, 0x53, 0x03, 0xd4, 0x69, 0x10, 0x92, 0xf1, 0xca, 0x2e, 0x36, 0xf1, 0x30,
+	0x99, 0x8e, 0xfd, 0x20, 0x4c, 0xd9, 0xb3, 0x54, 0xd4, 0x41, 0x73, 0x46, 0xe6, 0x05, 0x2d, 0xe7,
+	0x85, 0x5b, 0xc2, 0xa2, 0xfc, 0xe6, 0x77, 0x99, 0xea, 0x5c, 0xbb, 0x37, 0x4d, 0x13, 0x27, 0x4c,
+	0xe3, 0x53, 0x61, 0xe2, 0xf7, 0xa1, 0xfa, 0x79, 0x12, 0x85, 0x7d, 0x71, 0x80, 0x15, 0xbf, 0x79,
+	0x4f, 0x80, 0xb2, 0xfb, 0x24, 0x6a, 0xde, 0x06, 0x7d, 0x1c, 0x84, 0xc7, 0x49, 0xbd, 0x4c, 0xf3,
+	0x1b, 0x7c, 0xfe, 0x0e, 0xb2, 0xf8, 0x02, 0xbc, 0x7b, 0xfb, 0x3e, 0x54, 0xb2, 0x45, 0xa5, 0xf7,
+	0x94, 0x05, 0xef, 0x9d, 0xf8, 0xe3, 0x99, 0x3c, 0x51, 0x39, 0xf1, 0x61, 0xe1, 0x7d, 0x65, 0xfb,
+	0x23, 0x80, 0xf9, 0x6c, 0x4b, 0x46, 0x5e, 0xcf, 0x8f, 0xc4, 0x18, 0x40, 0xe9, 0xdc, 0x04, 0xd6,
+	0x4f, 0x0a, 0xa0, 0x21, 0x0f, 0xc7, 0xce, 0x12, 0x69, 0x60, 0x6c, 0xfe, 0x5b, 0xec, 0x8b, 0x4b,
+	0x7d, 0x8b, 0xf6, 0xdd, 0x86, 0x72, 0x34, 0x4d, 0x83, 0x28, 0xf4, 0xc7, 0x84, 0xec, 0xb2, 0x9b,
+	0xd1, 0xff, 0xb2, 0x4d, 0xad, 0x9f, 0x6b, 0x50, 0xeb, 0x46, 0x69, 0xf0, 0x34, 0x18, 0xf8, 0x38,
+	0xd7, 0xb9, 0x43, 0x48, 0x9e, 0x1c, 0x85, 0x15, 0x8f, 0xc9, 0x6b, 0xa0, 0xfb, 0x83, 0x34, 0xab,
+	0x64, 0x38, 0x81, 0xa8, 0x4f, 0x66, 0x87, 0x58, 0x1e, 0xc8, 0x42, 0x46, 0x90, 0xe6, 0x6b, 0x50,
+	0x13, 0xcd, 0xfe, 0x90, 0x25, 0x03, 0x11, 0xda, 0x55, 0xc1, 0x6b, 0xb1, 0x64, 0x30, 0x3f, 0x07,
+	0x79, 0x8c, 0x73, 0xe2, 0xc2, 0x82, 0xf9, 0xb6, 0x48, 0xa3, 0xfc, 0xea, 0x69, 0xda, 0xf9, 0xdd,
+	0xe5, 0x93, 0xa9, 0x2c, 0xac, 0x2b, 0xb9, 0xc2, 0xda, 0x04, 0x8d, 0x12, 0x0f, 0x90, 0x79, 0xa9,
+	0xfd, 0x4d, 0xc5, 0xf6, 0x5f, 0x15, 0x71, 0xb1, 0xbb, 0x0a, 0x97, 0xc5, 0x5d, 0xcc, 0x75, 0x9a,
+	0x4e, 0xfb, 0x09, 0x5d, 0xd0, 0x5e, 0x84, 0xab, 0x8d, 0x66, 0xb3, 0x77, 0xd0, 0xf5, 0xfa, 0x7b,
+	0x8e, 0xe3, 0xf6, 0xb1, 0xd0, 0xa6, 0x5b, 0xd7, 0x65, 0xa8, 0xe6, 0x19, 0x05, 0xbc, 0x0a, 0x12,
+	0xa3, 0xe3, 0x3c, 0xf4, 0x0c, 0xd5, 0xbc, 0x02, 0x1b, 0xbb, 0xce, 0xfe, 0x7e, 0x63, 0xc7, 0xe9,
+	0x37, 0x5a, 0x78, 0x51, 0xd3, 0x70, 0x08, 0x95, 0xde, 0x82, 0xa1, 0xa3, 0x8c, 0x28, 0xc0, 0x05,
+	0xab, 0x88, 0xb5, 0x35, 0x96, 0xe1, 0x82, 0x2e, 0x99, 0x26, 0x6c, 0x3e, 0xe8, 0xf4, 0x9a, 0x8f,
+	0xfb, 0xae, 0xf3, 0xb1, 0xd3, 0xf4, 0x9c, 0x96, 0x51, 0xc6, 0x0b, 0x1f, 0x15, 0xe4, 0xcd, 0x47,
+	0x8d, 0xee, 0x8e, 0xd3, 0x32, 0x2a, 0xb8, 0x36, 0xce, 0xd2, 0xee, 0xa1, 0x2a, 0x80, 0x83, 0x64,
+	0x19, 0x2e, 0x26, 0xaa, 0x62, 0xd6, 0xcf, 0x1b, 0x71, 0x79, 0xd6, 0xcf, 0x4b, 0xc8, 0xc4, 0xf0,
+	0x6b, 0x05, 0xae, 0xe5, 0xf9, 0xfb, 0x2c, 0x4d, 0x83, 0xf0, 0x28, 0xc9, 0x95, 0x7d, 0xca, 0xd9,
+	0xb2, 0x6f, 0x32, 0x4b, 0xd9, 0x50, 0xc4, 0x21, 0x27, 0xcc, 0x5b, 0xb0, 0x31, 0x61, 0x21, 0x4e,
+	0x90, 0xf4, 0xa3, 0x70, 0x7c, 0x2a, 0xa2, 0xb1, 0x26, 0x99, 0xbd, 0x70, 0x7c, 0x6a, 0xde, 0x01,
+	0x1d, 0xdd, 0xca, 0xdf, 0x60, 0x96, 0xfb, 0x9d, 0x0b, 0xe4, 0x1f, 0xab, 0xf4, 0xd5, 0x1f, 0xab,
+	0x76, 0xa0, 0xbe, 0x6c, 0x2b, 0x64, 0x8c, 0xbb, 0x8b, 0xc6, 0x78, 0xc1, 0x5e, 0x26, 0x29, 0x8d,
+	0xf2, 0x63, 0x15, 0x8a, 0xcd, 0x91, 0x1f, 0x1e, 0xf1, 0x92, 0x82, 0x7d, 0x41, 0x36, 0x50, 0x5d,
+	0x6c, 0x66, 0x35, 0x60, 0x41, 0xd4, 0x80, 0x5c, 0x30, 0x0f, 0x5b, 0x1e, 0x9f, 0xea, 0x92, 0x0a,
+	0x5a, 0x5b, 0x30, 0xe5, 0xba, 0x19, 0xff, 0xef, 0x12, 0xcb, 0x97, 0xa1, 0xca, 0x61, 0xc3, 0xdd,
+	0x7f, 0x09, 0xa1, 0x26, 0x71, 0xb4, 0xdb, 0x7b, 0x42, 0x08, 0xce, 0x58, 0xfc, 0x4a, 0x89, 0x18,
+	0x36, 0xa0, 0x86, 0x08, 0xed, 0xb7, 0xbb, 0x2d, 0xe7, 0x53, 0xa7, 0x65, 0xa8, 0x19, 0x47, 0x0e,
+	0xd3, 0xcc, 0x2d, 0x30, 0xbb, 0x3d, 0xaf, 0xfd, 0xb0, 0xdd, 0x6c, 0xe4, 0x00, 0xa6, 0x9b, 0x2f,
+	0xc0, 0x95, 0x05, 0x3e, 0xbd, 0x37, 0x14, 0xcd, 0x3a, 0x5c, 0x3b, 0xc3, 0xe6, 0x13, 0x95, 0x70,
+	0x6a, 0xef, 0x11, 0xbd, 0x9e, 0xf0, 0x29, 0xca, 0x88, 0x5b, 0xc1, 0x39, 0xd8, 0x6b, 0x35, 0x3c,
+	0x82, 0xf6, 0x9c, 0x27, 0x47, 0x02, 0x8e, 0xe4, 0x9a, 0xe3, 0xb5, 0x93, 0xd0, 0xfd, 0x11, 0x00,
+	0xb7, 0xf2, 0xf2, 0x52, 0x87, 0xf7, 0x09, 0xe7, 0x51, 0x6a, 0xc6, 0x7c, 0x50, 0xe0, 0x05, 0x03,
+	0xb6, 0xad, 0xef, 0x2b, 0xa0, 0xe1, 0x23, 0x6a, 0x76, 0xc1, 0x54, 0x72, 0x17, 0xcc, 0x8b, 0x9f,
+	0x6d, 0x0d, 0x50, 0xfd, 0x69, 0x20, 0x3c, 0x89, 0x4d, 0x3c, 0xe0, 0xc9, 0x3d, 0x83, 0x48, 0xe6,
+	0x95, 0x8c, 0xa6, 0x85, 0xa3, 0x21, 0x77, 0x27, 0xd6, 0x04, 0x58, 0x27, 0x60, 0x16, 0x8b, 0xc7,
+	0xb2, 0xf2, 0x99, 0xc5, 0x63, 0x74, 0x62, 0x15, 0x55, 0xd9, 0x67, 0x49, 0xb2, 0xec, 0x30, 0xc7,
+	0x7b, 0xe6, 0x60, 0x30, 0x57, 0x46, 0x50, 0xe6, 0x5b, 0xa0, 0xb2, 0x67, 0xd3, 0xba, 0xfa, 0x5c,
+	0xac, 0xa0, 0x18, 0xee, 0x29, 0x66, 0x4f, 0x63, 0x96, 0x8c, 0xe4, 0x61, 0x2e, 0x48, 0x04, 0x5d,
+	0x8c, 0x13, 0xad, 0x00, 0xba, 0x58, 0xcc, 0x24, 0xd3, 0x42, 0x71, 0x31, 0x2d, 0x98, 0xb9, 0x57,
+	0xc6, 0x8a, 0x80, 0xfe, 0x4b, 0xa0, 0x0d, 0xfc, 0xa7, 0xfc, 0x64, 0xcf, 0x5e, 0xae, 0x89, 0x65,
+	0xbd, 0x07, 0x97, 0x73, 0xfb, 0x26, 0x4f, 0x5a, 0x8b, 0x9e, 0xac, 0xd9, 0x39, 0x01, 0x19, 0x8b,
+	0x3f, 0x55, 0xb9, 0xbd, 0x5c, 0xf6, 0xc5, 0x8c, 0x25, 0xe9, 0x4a, 0x4f, 0x06, 0xf3, 0xbc, 0xa3,
+	0x2e, 0xe4, 0x1d, 0xa9, 0x9d, 0x76, 0x4e, 0x3b, 0xf3, 0x8d, 0x85, 0x9b, 0xdd, 0x15, 0x3b, 0xb7,
+	0xe4, 0x99, 0x8c, 0x44, 0xd5, 0x68, 0x29, 0x57, 0x8d, 0x5e, 0x03, 0xfd, 0x28, 0x8e, 0x66, 0x53,
+	0x51, 0xb6, 0x72, 0x62, 0xed, 0xbb, 0xcb, 0x5d, 0x28, 0x26, 0xa9, 0x9f, 0xce, 0x12, 0xca, 0x76,
+	0x9b, 0xf7, 0xae, 0x2e, 0xa8, 0xb0, 0x4f, 0x5d, 0xae, 0x10, 0xb1, 0x7a, 0xe2, 0x20, 0xa8, 0x80,
+	0xbe, 0xef, 0xe1, 0x83, 0xd1, 0x25, 0x7c, 0xee, 0x39, 0xe8, 0x72, 0x82, 0xe2, 0x9a, 0x9a, 0x7d,
+	0x1e, 0x5c, 0x86, 0x82, 0x81, 0x76, 0xd0, 0x5d, 0xe0, 0xd1, 0x0b, 0x52, 0xbb, 0xfb, 0xa0, 0xf7,
+	0xa9, 0x51, 0xb0, 0xde, 0x82, 0x22, 0x5f, 0x02, 0x9f, 0x75, 0xba, 0xce, 0x27, 0x7c, 0xc2, 0x3d,
+	0xa7, 0x8b, 0x0f, 0x8f, 0xfc, 0x5d, 0xb1, 0xd9, 0xdb, 0xdd, 0xeb, 0x38, 0xf8, 0xae, 0x28, 0x5d,
+	0x29, 0x94, 0xbb, 0xd8, 0x95, 0x42, 0x40, 0xba, 0xf2, 0x2f, 0x0a, 0x6c, 0xe5, 0xd8, 0x3b, 0x68,
+	0x27, 0xb1, 0xea, 0x75, 0xa8, 0x84, 0xb3, 0x49, 0x3f, 0x8d, 0x52, 0x7f, 0x2c, 0x2e, 0x9b, 0xe5,
+	0x70, 0x36, 0xf1, 0x90, 0xc6, 0xb7, 0x5d, 0xec, 0x9c, 0xb2, 0x70, 0x88, 0x0f, 0xd6, 0x05, 0xea,
+	0x86, 0x70, 0x36, 0xd9, 0xe3, 0x1c, 0x2c, 0x50, 0x50, 0x60, 0x10, 0x4d, 0xa6, 0x63, 0x96, 0x32,
+	0xf1, 0x22, 0x81, 0x83, 0x9a, 0x82, 0x85, 0xf7, 0x6f, 0x74, 0x96, 0x58, 0x41, 0x23, 0xf7, 0x55,
+	0x90, 0xc3, 0x97, 0xc0, 0x12, 0x07, 0xbb, 0xe5, 0x1a, 0x3a, 0x09, 0x54, 0x91, 0x27, 0x17, 0xb9,
+	0x05, 0x1b, 0x24, 0x92, 0xad, 0x52, 0x24, 0x19, 0x1a, 0x27, 0x97, 0xb1, 0xfe, 0xa1, 0x70, 0xd3,
+	0x3c, 0xf2, 0xbc, 0x3d, 0x89, 0xd8, 0x37, 0x05, 0xb4, 0x14, 0xf2, 0xeb, 0x0b, 0xf6, 0x99, 0xfe,
+	0x3c, 0xbc, 0xc4, 0x71, 0x51, 0xc8, 0x8e, 0x0b, 0xf3, 0x3e, 0x94, 0xf0, 0x31, 0x1c, 0xbf, 0x5c,
+	0xa8, 0x64, 0xd9, 0x57, 0xce, 0x8d, 0x7f, 0xc4, 0xfb, 0x79, 0x05, 0x2b, 0xa5, 0xb3, 0xda, 0x49,
+	0xdc, 0xb3, 0xb1, 0xbd, 0xfd, 0x21, 0xd4, 0xf2, 0xc2, 0x6b, 0x55, 0xa1, 0x6f, 0x08, 0xc8, 0x95,
+	0x40, 0xdd, 0x3b, 0xf0, 0xf8, 0xa3, 0xf3, 0x5e, 0x6f, 0xdf, 0xe3, 0xaf, 0xda, 0x2d, 0x47, 0x40,
+	0xe3, 0x2b, 0x1e, 0xad, 0xeb, 0x3c, 0xf0, 0xc9, 0x48, 0x51, 0x57, 0x8c, 0x94, 0x6d, 0x28, 0xfb,
+	0x69, 0xca, 0x26, 0xf2, 0xe6, 0xa9, 0xbb, 0x19, 0x6d, 0x7d, 0xc1, 0xcd, 0xdf, 0x1c, 0x07, 0x2c,
+	0x4c, 0xbb, 0x51, 0x38, 0x60, 0xf3, 0x2d, 0x29, 0xb9, 0x2d, 0x7d, 0xc3, 0xa1, 0xbf, 0xa6, 0x3a,
+	0xd6, 0x6f, 0x15, 0x80, 0xf9, 0x9a, 0x6b, 0x7c, 0x14, 0xcc, 0x7d, 0xc7, 0x53, 0x57, 0xff, 0x8e,
+	0x67, 0x83, 0x96, 0x30, 0x16, 0xae, 0xf2, 0xe2, 0x89, 0x72, 0xb8, 0xfd, 0x34, 0x3a, 0x66, 0xa1,
+	0x48, 0x4b, 0x9c, 0x40, 0xad, 0xbe, 0x64, 0x87, 0xa3, 0x28, 0x92, 0x15, 0xbb, 0x24, 0xad, 0x77,
+	0x61, 0x73, 0xbe, 0x1b, 0x0a, 0xed, 0xd7, 0x16, 0x43, 0xbb, 0x6a, 0xcf, 0xfb, 0x65, 0x64, 0xfb,
+	0x50, 0x41, 0xa6, 0x47, 0x73, 0x2f, 0x79, 0x23, 0x99, 0x63, 0xaa, 0x26, 0x1d, 0xb0, 0xae, 0x99,
+	0x7f, 0xa8, 0x80, 0x31, 0x5f, 0xf8, 0x82, 0x8f, 0x6c, 0x5b, 0x50, 0x1c, 0x50, 0xbf, 0x4c, 0x9e,
+	0x9c, 0x32, 0x5f, 0x05, 0x18, 0x04, 0xd3, 0x11, 0x8b, 0xb3, 0x9b, 0x62, 0xcd, 0xcd, 0x71, 0xd6,
+	0x7e, 0x46, 0xfe, 0x1a, 0xae, 0xcc, 0x75, 0x59, 0x07, 0xeb, 0x73, 0x05, 0xd5, 0x05, 0x05, 0xd7,
+	0x55, 0xe0, 0x2b, 0xd8, 0x98, 0x2b, 0xb0, 0x17, 0x84, 0x18, 0xca, 0x83, 0x6c, 0x75, 0x6c, 0x5e,
+	0x68, 0x0b, 0x99, 0xc2, 0xd4, 0x5c, 0x0a, 0x5b, 0x77, 0xf9, 0xdf, 0x28, 0xf9, 0x38, 0x3b, 0xa0,
+	0xed, 0xdf, 0xca, 0xd6, 0x53, 0x6e, 0x2a, 0x67, 0x71, 0x22, 0x17, 0xdf, 0x82, 0xe2, 0x34, 0x08,
+	0x43, 0x71, 0x7d, 0x50, 0x5d, 0x41, 0xe5, 0xbf, 0xc0, 0x72, 0xbd, 0x24, 0x89, 0xd1, 0x2e, 0x3e,
+	0xbf, 0x67, 0xd1, 0x2e, 0x69, 0x3a, 0x92, 0xd3, 0x28, 0xf6, 0x8f, 0x58, 0x7f, 0x1c, 0x4c, 0x82,
+	0x54, 0x1c, 0xdb, 0x35, 0xc1, 0xec, 0x20, 0x0f, 0xb3, 0x07, 0x7d, 0x39, 0x17, 0x22, 0x45, 0x9a,
+	0x03, 0x88, 0x45, 0x02, 0xd6, 0xff, 0xc2, 0xd5, 0x33, 0x7b, 0x21, 0xd8, 0xdf, 0x5e, 0x84, 0xbd,
+	0x61, 0x9f, 0x11, 0x92, 0xd8, 0xff, 0xae, 0x92, 0x8f, 0x98, 0x76, 0xf8, 0x34, 0xc2, 0xa1, 0x33,
+	0x14, 0x11, 0x96, 0x58, 0x32, 0x94, 0xba, 0xf3, 0xbb, 0x2e, 0x2c, 0x7c, 0x77, 0x36, 0xed, 0xdc,
+	0xae, 0xf9, 0xb9, 0x6f, 0xda, 0xe7, 0x10, 0x37, 0xb7, 0x84, 0x15, 0xe4, 0xf1, 0xf0, 0xc0, 0x0f,
+	0xd7, 0x38, 0x86, 0xd6, 0x0d, 0xc4, 0x0f, 0xf2, 0xd8, 0x7f, 0xe0, 0x87, 0xcb, 0x3f, 0x34, 0x2c,
+	0x88, 0x48, 0x53, 0xfd, 0x51, 0xc9, 0x9b, 0xda, 0x8b, 0x26, 0x87, 0x49, 0x1a, 0x85, 0x6c, 0xe5,
+	0x30, 0x7e, 0x5b, 0x64, 0x52, 0x95, 0x32, 0xe9, 0x4b, 0xf6, 0x92, 0xb9, 0xec, 0x25, 0x9f, 0x56,
+	0x56, 0x45, 0xf5, 0x7b, 0xb9, 0x14, 0xd7, 0xee, 0xf2, 0x0f, 0x72, 0x59, 0x01, 0x55, 0x83, 0xb2,
+	0xb8, 0xf1, 0xef, 0x1b, 0x05, 0xec, 0x69, 0x76, 0xda, 0xf8, 0x6d, 0x4d, 0xb5, 0x7e, 0xa5, 0x40,
+	0x0d, 0x35, 0xd9, 0x69, 0xba, 0x6c, 0x1a, 0xc5, 0xf4, 0x8b, 0x05, 0xae, 0x30, 0x37, 0x47, 0xc5,
+	0x95, 0xe4, 0xa2, 0xc3, 0xe9, 0x45, 0x7d, 0x19, 0xcc, 0xd5, 0x33, 0x30, 0xdf, 0x86, 0xf2, 0x2c,
+	0x14, 0x61, 0x23, 0x42, 0x40, 0xd2, 0xf8, 0x04, 0x16, 0xb3, 0xc1, 0xd8, 0x0f, 0x26, 0xe2, 0xae,
+	0xac, 0xba, 0x73, 0xc6, 0xba, 0x45, 0xe8, 0x83, 0xab, 0xb0, 0x11, 0x44, 0x36, 0x1e, 0x89, 0x01,
+	0x8a, 0x1d, 0xfe, 0x7f, 0x61, 0x7a, 0x78, 0x58, 0x24, 0xf1, 0x77, 0xff, 0x39, 0x00, 0xa3, 0xb9,
+	0xe7, 0xd6, 0x53, 0x23, 0x00, 0x00,
 }
@@ -40,7 +40,7 @@ func (x QueryOptions_FilterType) String() string {
 	return proto.EnumName(QueryOptions_FilterType_name, int32(x))
 }
 func (QueryOptions_FilterType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{0, 0}
+	return fileDescriptor_query_add7fc62f57e145f, []int{0, 0}
 }
 
 type Query_Type int32
@@ -48,22 +48,25 @@ type Query_Type int32
 const (
 	Query_THREAD_SNAPSHOTS Query_Type = 0
 	Query_CONTACTS         Query_Type = 1
+	Query_PUBLIC_THREADS   Query_Type = 2
 )
 
 var Query_Type_name = map[int32]string{
 	0: "THREAD_SNAPSHOTS",
 	1: "CONTACTS",
+	2: "PUBLIC_THREADS",
 }
 var Query_Type_value = map[string]int32{
 	"THREAD_SNAPSHOTS": 0,
 	"CONTACTS":         1,
+	"PUBLIC_THREADS":   2,
 }
 
 func (x Query_Type) String() string {
 	return proto.EnumName(Query_Type_name, int32(x))
 }
 func (Query_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{1, 0}
+	return fileDescriptor_query_add7fc62f57e145f, []int{1, 0}
 }
 
 type PubSubQuery_ResponseType int32
@@ -86,7 +89,7 @@ func (x PubSubQuery_ResponseType) String() string {
 	return proto.EnumName(PubSubQuery_ResponseType_name, int32(x))
 }
 func (PubSubQuery_ResponseType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{2, 0}
+	return fileDescriptor_query_add7fc62f57e145f, []int{2, 0}
 }
 
 type QueryOptions struct {
@@ -105,7 +108,7 @@ func (m *QueryOptions) Reset()         { *m = QueryOptions{} }
 func (m *QueryOptions) String() string { return proto.CompactTextString(m) }
 func (*QueryOptions) ProtoMessage()    {}
 func (*QueryOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{0}
+	return fileDescriptor_query_add7fc62f57e145f, []int{0}
 }
 func (m *QueryOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryOptions.Unmarshal(m, b)
@@ -182,7 +185,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{1}
+	return fileDescriptor_query_add7fc62f57e145f, []int{1}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Query.Unmarshal(m, b)
@@ -254,7 +257,7 @@ func (m *PubSubQuery) Reset()         { *m = PubSubQuery{} }
 func (m *PubSubQuery) String() string { return proto.CompactTextString(m) }
 func (*PubSubQuery) ProtoMessage()    {}
 func (*PubSubQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{2}
+	return fileDescriptor_query_add7fc62f57e145f, []int{2}
 }
 func (m *PubSubQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PubSubQuery.Unmarshal(m, b)
@@ -337,7 +340,7 @@ func (m *QueryResult) Reset()         { *m = QueryResult{} }
 func (m *QueryResult) String() string { return proto.CompactTextString(m) }
 func (*QueryResult) ProtoMessage()    {}
 func (*QueryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{3}
+	return fileDescriptor_query_add7fc62f57e145f, []int{3}
 }
 func (m *QueryResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResult.Unmarshal(m, b)
@@ -397,7 +400,7 @@ func (m *QueryResults) Reset()         { *m = QueryResults{} }
 func (m *QueryResults) String() string { return proto.CompactTextString(m) }
 func (*QueryResults) ProtoMessage()    {}
 func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{4}
+	return fileDescriptor_query_add7fc62f57e145f, []int{4}
 }
 func (m *QueryResults) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
@@ -443,7 +446,7 @@ func (m *PubSubQueryResults) Reset()         { *m = PubSubQueryResults{} }
 func (m *PubSubQueryResults) String() string { return proto.CompactTextString(m) }
 func (*PubSubQueryResults) ProtoMessage()    {}
 func (*PubSubQueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{5}
+	return fileDescriptor_query_add7fc62f57e145f, []int{5}
 }
 func (m *PubSubQueryResults) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PubSubQueryResults.Unmarshal(m, b)
@@ -489,7 +492,7 @@ func (m *ContactQuery) Reset()         { *m = ContactQuery{} }
 func (m *ContactQuery) String() string { return proto.CompactTextString(m) }
 func (*ContactQuery) ProtoMessage()    {}
 func (*ContactQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{6}
+	return fileDescriptor_query_add7fc62f57e145f, []int{6}
 }
 func (m *ContactQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContactQuery.Unmarshal(m, b)
@@ -534,7 +537,7 @@ func (m *ThreadSnapshotQuery) Reset()         { *m = ThreadSnapshotQuery{} }
 func (m *ThreadSnapshotQuery) String() string { return proto.CompactTextString(m) }
 func (*ThreadSnapshotQuery) ProtoMessage()    {}
 func (*ThreadSnapshotQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_e051b7f44626bdcd, []int{7}
+	return fileDescriptor_query_add7fc62f57e145f, []int{7}
 }
 func (m *ThreadSnapshotQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadSnapshotQuery.Unmarshal(m, b)
@@ -561,6 +564,60 @@ func (m *ThreadSnapshotQuery) GetAddress() string {
 	return ""
 }
 
+type PublicThreadQuery struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Schema               string   `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Initiator            string   `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublicThreadQuery) Reset()         { *m = PublicThreadQuery{} }
+func (m *PublicThreadQuery) String() string { return proto.CompactTextString(m) }
+func (*PublicThreadQuery) ProtoMessage()    {}
+func (*PublicThreadQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_query_add7fc62f57e145f, []int{8}
+}
+func (m *PublicThreadQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublicThreadQuery.Unmarshal(m, b)
+}
+func (m *PublicThreadQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublicThreadQuery.Marshal(b, m, deterministic)
+}
+func (dst *PublicThreadQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublicThreadQuery.Merge(dst, src)
+}
+func (m *PublicThreadQuery) XXX_Size() int {
+	return xxx_messageInfo_PublicThreadQuery.Size(m)
+}
+func (m *PublicThreadQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublicThreadQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublicThreadQuery proto.InternalMessageInfo
+
+func (m *PublicThreadQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PublicThreadQuery) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+func (m *PublicThreadQuery) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryOptions)(nil), "QueryOptions")
 	proto.RegisterType((*Query)(nil), "Query")
@@ -570,53 +627,57 @@ func init() {
 	proto.RegisterType((*PubSubQueryResults)(nil), "PubSubQueryResults")
 	proto.RegisterType((*ContactQuery)(nil), "ContactQuery")
 	proto.RegisterType((*ThreadSnapshotQuery)(nil), "ThreadSnapshotQuery")
+	proto.RegisterType((*PublicThreadQuery)(nil), "PublicThreadQuery")
 	proto.RegisterEnum("QueryOptions_FilterType", QueryOptions_FilterType_name, QueryOptions_FilterType_value)
 	proto.RegisterEnum("Query_Type", Query_Type_name, Query_Type_value)
 	proto.RegisterEnum("PubSubQuery_ResponseType", PubSubQuery_ResponseType_name, PubSubQuery_ResponseType_value)
 }
 
-func init() { proto.RegisterFile("query.proto", fileDescriptor_query_e051b7f44626bdcd) }
-
-var fileDescriptor_query_e051b7f44626bdcd = []byte{
-	// 638 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc6, 0x4e, 0x1c, 0x93, 0xe3, 0x24, 0xb2, 0x06, 0x16, 0x06, 0x5d, 0x5d, 0x22, 0xdf, 0x05,
-	0x11, 0x57, 0x1a, 0xae, 0x72, 0xb7, 0xed, 0x22, 0x40, 0x10, 0x48, 0x94, 0xa4, 0x63, 0xb3, 0xe9,
-	0x06, 0x4d, 0xe2, 0x01, 0x46, 0x75, 0x3c, 0xae, 0x3d, 0x6e, 0xc9, 0x53, 0xf4, 0x05, 0xfa, 0x56,
-	0x7d, 0x90, 0xbe, 0x42, 0xe5, 0x19, 0xbb, 0x98, 0x3f, 0xb5, 0xbb, 0x9c, 0xf9, 0xbe, 0x1c, 0x7f,
-	0xdf, 0xf9, 0xce, 0x01, 0xe7, 0x53, 0xc1, 0xb2, 0x35, 0x4e, 0x33, 0x21, 0xc5, 0xee, 0xce, 0xad,
-	0x10, 0xb7, 0x31, 0x3b, 0x54, 0xd5, 0xa2, 0xb8, 0x39, 0xa4, 0x49, 0x0d, 0xed, 0x3d, 0x85, 0x24,
-	0x5f, 0xb1, 0x5c, 0xd2, 0x55, 0xaa, 0x09, 0xfe, 0x0f, 0x03, 0x7a, 0xef, 0xcb, 0x5e, 0xb3, 0x54,
-	0x72, 0x91, 0xe4, 0xe8, 0x2f, 0xe8, 0xc6, 0x62, 0x49, 0xe3, 0x59, 0x12, 0xaf, 0x3d, 0x63, 0x68,
-	0x8c, 0x36, 0xc9, 0xc3, 0x03, 0xfa, 0x1b, 0x20, 0x63, 0x2b, 0x21, 0x99, 0x82, 0x3b, 0x0a, 0x6e,
-	0xbc, 0xa0, 0x6d, 0xb0, 0x62, 0xbe, 0xe2, 0xd2, 0x33, 0x87, 0xc6, 0xc8, 0x22, 0xba, 0x40, 0x08,
-	0xda, 0x5f, 0x28, 0x97, 0x5e, 0x4b, 0x3d, 0xaa, 0xdf, 0xe8, 0x3f, 0xe8, 0xdc, 0xf0, 0x58, 0xb2,
-	0xcc, 0x6b, 0x0f, 0x8d, 0xd1, 0x60, 0xec, 0xe1, 0xa6, 0x0c, 0x7c, 0xaa, 0xb0, 0x70, 0x9d, 0x32,
-	0x52, 0xf1, 0x90, 0x07, 0x36, 0xbb, 0x5f, 0xc6, 0x45, 0xc4, 0x3c, 0x6b, 0xd8, 0x1a, 0x75, 0x49,
-	0x5d, 0xfa, 0xff, 0x02, 0x3c, 0xf0, 0x51, 0x1f, 0xba, 0x97, 0xb3, 0xeb, 0xd3, 0xf3, 0x8b, 0x70,
-	0x4a, 0xdc, 0x0d, 0x34, 0x00, 0x38, 0x3b, 0x3f, 0x99, 0x5e, 0xcf, 0x2e, 0x4e, 0xa6, 0xc4, 0x35,
-	0xfc, 0xef, 0x06, 0x58, 0xea, 0x53, 0x68, 0x00, 0x26, 0x8f, 0x94, 0xc7, 0x2e, 0x31, 0x79, 0x54,
-	0x8a, 0x97, 0xe2, 0x23, 0x4b, 0x94, 0xf8, 0x2e, 0xd1, 0x05, 0xda, 0x83, 0xb6, 0x5c, 0xa7, 0x4c,
-	0x89, 0x1f, 0x8c, 0x1d, 0x2d, 0x13, 0x2b, 0x65, 0x0a, 0x40, 0xfb, 0x60, 0x0b, 0xad, 0x5a, 0x59,
-	0x71, 0xc6, 0xfd, 0x47, 0x56, 0x48, 0x8d, 0x22, 0x0c, 0x76, 0x4a, 0xd7, 0xb1, 0xa0, 0x91, 0x67,
-	0x29, 0xe2, 0x36, 0xd6, 0xf1, 0xe0, 0x3a, 0x1e, 0x3c, 0x49, 0xd6, 0xa4, 0x26, 0xf9, 0x07, 0xd0,
-	0x56, 0x86, 0xb6, 0xc1, 0x0d, 0xcf, 0xc8, 0x74, 0x72, 0x72, 0x1d, 0x5c, 0x4e, 0xe6, 0xc1, 0xd9,
-	0x2c, 0x0c, 0xdc, 0x0d, 0xd4, 0x83, 0xcd, 0xe3, 0xd9, 0x65, 0x38, 0x39, 0x0e, 0x03, 0xd7, 0xf0,
-	0xbf, 0x99, 0xe0, 0xcc, 0x8b, 0x45, 0x50, 0x2c, 0x5e, 0xf6, 0x56, 0xbb, 0x30, 0x5f, 0x73, 0xd1,
-	0x10, 0xd7, 0xfa, 0x03, 0x71, 0xe8, 0x2d, 0xf4, 0x32, 0x96, 0xa7, 0x22, 0xc9, 0x59, 0xd9, 0xa5,
-	0x4a, 0x71, 0x07, 0x37, 0x44, 0x60, 0xd2, 0x20, 0x90, 0x47, 0xf4, 0xd7, 0xc3, 0xd4, 0x29, 0xa4,
-	0x7c, 0xa9, 0xb6, 0x4b, 0xa5, 0x90, 0xf2, 0x65, 0xc9, 0x2f, 0x57, 0x57, 0x14, 0xd2, 0xb3, 0xd5,
-	0x16, 0xd5, 0xa5, 0xff, 0x0f, 0xf4, 0x9a, 0xdf, 0x41, 0x36, 0xb4, 0xe6, 0xe3, 0xb9, 0xbb, 0x81,
-	0x00, 0x3a, 0xf3, 0xab, 0xa3, 0xe0, 0xea, 0xc8, 0x35, 0xfc, 0xaf, 0x06, 0x38, 0x4a, 0x13, 0x61,
-	0x79, 0x11, 0xcb, 0x67, 0xe3, 0xc1, 0xd0, 0x8e, 0xa8, 0xd4, 0xe3, 0x71, 0xc6, 0xbb, 0xcf, 0xac,
-	0x87, 0xf5, 0xd9, 0x10, 0xc5, 0x53, 0x7b, 0x5e, 0x1e, 0x85, 0x9a, 0xd5, 0x26, 0xd1, 0x05, 0x3a,
-	0x00, 0xeb, 0x33, 0x8d, 0x0b, 0x56, 0xed, 0xc1, 0xcb, 0x13, 0xd4, 0x14, 0x3f, 0xa8, 0xee, 0x4e,
-	0x0b, 0xca, 0x7f, 0x05, 0x64, 0xbc, 0x16, 0x90, 0x0f, 0x16, 0x97, 0x6c, 0x95, 0x7b, 0xe6, 0xb0,
-	0x35, 0x72, 0xc6, 0x3d, 0xdc, 0xf8, 0x3b, 0xd1, 0x90, 0xff, 0x0e, 0x50, 0x63, 0xfe, 0x75, 0xeb,
-	0xa7, 0x66, 0xf7, 0xc1, 0xce, 0x34, 0x54, 0xf9, 0xed, 0x37, 0x7b, 0xe5, 0xa4, 0x46, 0xfd, 0x37,
-	0xd0, 0x3b, 0x16, 0x89, 0xa4, 0x4b, 0xa9, 0x97, 0xca, 0x03, 0x9b, 0x46, 0x51, 0xc6, 0xf2, 0xbc,
-	0xea, 0x56, 0x97, 0xe5, 0x85, 0x27, 0x74, 0xc5, 0xaa, 0xcb, 0x51, 0xbf, 0xfd, 0x43, 0xd8, 0x0a,
-	0xef, 0x32, 0x46, 0xa3, 0x20, 0xa1, 0x69, 0x7e, 0x27, 0x7e, 0xd7, 0xe4, 0x68, 0x0b, 0xfa, 0x5c,
-	0x60, 0xc9, 0xee, 0x25, 0x2f, 0x87, 0xb6, 0xf8, 0x60, 0xa6, 0x8b, 0x45, 0x47, 0x0d, 0xef, 0xff,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xc5, 0xc0, 0x82, 0xf2, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("query.proto", fileDescriptor_query_add7fc62f57e145f) }
+
+var fileDescriptor_query_add7fc62f57e145f = []byte{
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0x5d, 0xd2, 0xaf, 0xf5, 0xb6, 0xab, 0x82, 0x37, 0x21, 0x6f, 0x42, 0xac, 0x0a, 0x0f, 0xab,
+	0x40, 0xca, 0x50, 0x79, 0x05, 0xa4, 0xb6, 0xeb, 0xb4, 0x49, 0x63, 0x2d, 0x4e, 0xf6, 0x82, 0x84,
+	0x2a, 0xb7, 0xf1, 0x36, 0x8b, 0x34, 0x0e, 0x89, 0x03, 0xeb, 0xaf, 0xe0, 0x0f, 0xf0, 0xff, 0x78,
+	0xe4, 0x2f, 0xa0, 0xd8, 0x09, 0xcd, 0x36, 0x06, 0xbc, 0xf5, 0xfa, 0x9c, 0x5c, 0x9f, 0x73, 0xcf,
+	0x75, 0xa1, 0xf5, 0x39, 0x65, 0xf1, 0xca, 0x89, 0x62, 0x21, 0xc5, 0xde, 0xee, 0x95, 0x10, 0x57,
+	0x01, 0x3b, 0x54, 0xd5, 0x3c, 0xbd, 0x3c, 0xa4, 0x61, 0x01, 0xed, 0xdf, 0x85, 0x24, 0x5f, 0xb2,
+	0x44, 0xd2, 0x65, 0xa4, 0x09, 0xf6, 0x4f, 0x03, 0xda, 0xef, 0xb3, 0x5e, 0x93, 0x48, 0x72, 0x11,
+	0x26, 0xe8, 0x09, 0x34, 0x03, 0xb1, 0xa0, 0xc1, 0x24, 0x0c, 0x56, 0xd8, 0xe8, 0x1a, 0xbd, 0x4d,
+	0xb2, 0x3e, 0x40, 0x4f, 0x01, 0x62, 0xb6, 0x14, 0x92, 0x29, 0xb8, 0xae, 0xe0, 0xd2, 0x09, 0xda,
+	0x81, 0x5a, 0xc0, 0x97, 0x5c, 0x62, 0xb3, 0x6b, 0xf4, 0x6a, 0x44, 0x17, 0x08, 0x41, 0xf5, 0x2b,
+	0xe5, 0x12, 0x57, 0xd4, 0xa1, 0xfa, 0x8d, 0x5e, 0x42, 0xfd, 0x92, 0x07, 0x92, 0xc5, 0xb8, 0xda,
+	0x35, 0x7a, 0x9d, 0x3e, 0x76, 0xca, 0x32, 0x9c, 0x63, 0x85, 0x79, 0xab, 0x88, 0x91, 0x9c, 0x87,
+	0x30, 0x34, 0xd8, 0xcd, 0x22, 0x48, 0x7d, 0x86, 0x6b, 0xdd, 0x4a, 0xaf, 0x49, 0x8a, 0xd2, 0x7e,
+	0x01, 0xb0, 0xe6, 0xa3, 0x2d, 0x68, 0x9e, 0x4f, 0x66, 0xc7, 0xa7, 0x67, 0xde, 0x98, 0x58, 0x1b,
+	0xa8, 0x03, 0x70, 0x72, 0x7a, 0x34, 0x9e, 0x4d, 0xce, 0x8e, 0xc6, 0xc4, 0x32, 0xec, 0x1f, 0x06,
+	0xd4, 0xd4, 0x55, 0xa8, 0x03, 0x26, 0xf7, 0x95, 0xc7, 0x26, 0x31, 0xb9, 0x9f, 0x89, 0x97, 0xe2,
+	0x13, 0x0b, 0x95, 0xf8, 0x26, 0xd1, 0x05, 0xda, 0x87, 0xaa, 0x5c, 0x45, 0x4c, 0x89, 0xef, 0xf4,
+	0x5b, 0x5a, 0xa6, 0xa3, 0x94, 0x29, 0x00, 0x1d, 0x40, 0x43, 0x68, 0xd5, 0xca, 0x4a, 0xab, 0xbf,
+	0x75, 0xcb, 0x0a, 0x29, 0x50, 0xe4, 0x40, 0x23, 0xa2, 0xab, 0x40, 0x50, 0x1f, 0xd7, 0x14, 0x71,
+	0xc7, 0xd1, 0xf1, 0x38, 0x45, 0x3c, 0xce, 0x20, 0x5c, 0x91, 0x82, 0x64, 0xbf, 0x85, 0xaa, 0x32,
+	0xb4, 0x03, 0x96, 0x77, 0x42, 0xc6, 0x83, 0xa3, 0x99, 0x7b, 0x3e, 0x98, 0xba, 0x27, 0x13, 0xcf,
+	0xb5, 0x36, 0x50, 0x1b, 0x36, 0x47, 0x93, 0x73, 0x6f, 0x30, 0xf2, 0x5c, 0xcb, 0x40, 0x08, 0x3a,
+	0xd3, 0x8b, 0xe1, 0xd9, 0xe9, 0x68, 0xa6, 0xa9, 0xae, 0x65, 0xda, 0xdf, 0x4d, 0x68, 0x4d, 0xd3,
+	0xb9, 0x9b, 0xce, 0xff, 0xec, 0xb7, 0x70, 0x66, 0x3e, 0xe4, 0xac, 0x24, 0xb8, 0xf2, 0x1f, 0x82,
+	0xd1, 0x1b, 0x68, 0xc7, 0x2c, 0x89, 0x44, 0x98, 0xb0, 0xac, 0x4b, 0x9e, 0xec, 0xae, 0x53, 0x12,
+	0xe1, 0x90, 0x12, 0x81, 0xdc, 0xa2, 0x3f, 0x1c, 0xb0, 0x4e, 0x26, 0xe2, 0x0b, 0x5c, 0x2f, 0x92,
+	0x89, 0xf8, 0x22, 0xe3, 0x67, 0xeb, 0x2c, 0x52, 0x89, 0x1b, 0x6a, 0xb3, 0x8a, 0xd2, 0x7e, 0x06,
+	0xed, 0xf2, 0x3d, 0xa8, 0x01, 0x95, 0x69, 0x7f, 0x6a, 0x6d, 0x20, 0x80, 0xfa, 0xf4, 0x62, 0xe8,
+	0x5e, 0x0c, 0x2d, 0xc3, 0xfe, 0x66, 0x40, 0x4b, 0x69, 0x22, 0x2c, 0x49, 0x03, 0x79, 0x6f, 0x3c,
+	0x0e, 0x54, 0x7d, 0x2a, 0xf5, 0x78, 0x5a, 0xfd, 0xbd, 0x7b, 0xd6, 0xbd, 0xe2, 0x29, 0x11, 0xc5,
+	0x53, 0xbb, 0x9f, 0x3d, 0x14, 0x35, 0xab, 0x4d, 0xa2, 0x0b, 0xf4, 0x1c, 0x6a, 0x5f, 0x68, 0x90,
+	0x32, 0x5c, 0xfd, 0xcb, 0x04, 0x35, 0xc5, 0x76, 0xa1, 0x5d, 0x12, 0x94, 0xfc, 0x0e, 0xc8, 0x78,
+	0x28, 0x20, 0x1b, 0x6a, 0x5c, 0xb2, 0x65, 0x82, 0xcd, 0x6e, 0xa5, 0xd7, 0xea, 0xb7, 0x9d, 0xd2,
+	0xe7, 0x44, 0x43, 0xf6, 0x3b, 0x40, 0xa5, 0xf9, 0x17, 0xad, 0xef, 0x9a, 0x3d, 0x80, 0x46, 0xac,
+	0x21, 0x6c, 0x96, 0x97, 0x38, 0xe7, 0x93, 0x02, 0xb5, 0x5f, 0x43, 0x7b, 0x24, 0x42, 0x49, 0x17,
+	0x52, 0x2f, 0x15, 0x86, 0x06, 0xf5, 0xfd, 0x98, 0x25, 0x49, 0xde, 0xad, 0x28, 0xb3, 0x57, 0x1f,
+	0xd2, 0x25, 0xcb, 0x5f, 0x93, 0xfa, 0x6d, 0x1f, 0xc2, 0xb6, 0x77, 0x1d, 0x33, 0xea, 0xbb, 0x21,
+	0x8d, 0x92, 0x6b, 0xf1, 0xaf, 0x26, 0xf6, 0x47, 0x78, 0x34, 0x4d, 0xe7, 0x01, 0x5f, 0xe8, 0xcf,
+	0x34, 0xbd, 0xe8, 0x6c, 0xac, 0x3b, 0xa3, 0xc7, 0x50, 0x4f, 0x16, 0xd7, 0x6c, 0x49, 0xf3, 0xfb,
+	0xf2, 0x2a, 0xfb, 0x3f, 0xe3, 0x21, 0x97, 0x9c, 0x4a, 0x11, 0xab, 0x64, 0x9a, 0x64, 0x7d, 0x30,
+	0xdc, 0x86, 0x2d, 0x2e, 0x1c, 0xc9, 0x6e, 0x24, 0xcf, 0x32, 0x99, 0x7f, 0x30, 0xa3, 0xf9, 0xbc,
+	0xae, 0xb2, 0x79, 0xf5, 0x6b, 0x00, 0x5f, 0x2b, 0x95, 0x1f, 0x65, 0x05, 0x00, 0x00,
 }
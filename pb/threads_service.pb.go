@@ -35,7 +35,7 @@ func (m *ThreadEnvelope) Reset()         { *m = ThreadEnvelope{} }
 func (m *ThreadEnvelope) String() string { return proto.CompactTextString(m) }
 func (*ThreadEnvelope) ProtoMessage()    {}
 func (*ThreadEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{0}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{0}
 }
 func (m *ThreadEnvelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadEnvelope.Unmarshal(m, b)
@@ -96,7 +96,7 @@ func (m *ThreadBlock) Reset()         { *m = ThreadBlock{} }
 func (m *ThreadBlock) String() string { return proto.CompactTextString(m) }
 func (*ThreadBlock) ProtoMessage()    {}
 func (*ThreadBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{1}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{1}
 }
 func (m *ThreadBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadBlock.Unmarshal(m, b)
@@ -151,7 +151,7 @@ func (m *ThreadBlockHeader) Reset()         { *m = ThreadBlockHeader{} }
 func (m *ThreadBlockHeader) String() string { return proto.CompactTextString(m) }
 func (*ThreadBlockHeader) ProtoMessage()    {}
 func (*ThreadBlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{2}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{2}
 }
 func (m *ThreadBlockHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadBlockHeader.Unmarshal(m, b)
@@ -200,18 +200,20 @@ func (m *ThreadBlockHeader) GetAddress() string {
 }
 
 type ThreadAdd struct {
-	Inviter              *Peer    `protobuf:"bytes,1,opt,name=inviter,proto3" json:"inviter,omitempty"`
-	Thread               *Thread  `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Inviter              *Peer         `protobuf:"bytes,1,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	Thread               *Thread       `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Roles                []*ThreadRole `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	Keys                 []*ThreadKey  `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ThreadAdd) Reset()         { *m = ThreadAdd{} }
 func (m *ThreadAdd) String() string { return proto.CompactTextString(m) }
 func (*ThreadAdd) ProtoMessage()    {}
 func (*ThreadAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{3}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{3}
 }
 func (m *ThreadAdd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadAdd.Unmarshal(m, b)
@@ -245,6 +247,20 @@ func (m *ThreadAdd) GetThread() *Thread {
 	return nil
 }
 
+func (m *ThreadAdd) GetRoles() []*ThreadRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *ThreadAdd) GetKeys() []*ThreadKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
 type ThreadIgnore struct {
 	Target               string   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -256,7 +272,7 @@ func (m *ThreadIgnore) Reset()         { *m = ThreadIgnore{} }
 func (m *ThreadIgnore) String() string { return proto.CompactTextString(m) }
 func (*ThreadIgnore) ProtoMessage()    {}
 func (*ThreadIgnore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{4}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{4}
 }
 func (m *ThreadIgnore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadIgnore.Unmarshal(m, b)
@@ -294,7 +310,7 @@ func (m *ThreadFlag) Reset()         { *m = ThreadFlag{} }
 func (m *ThreadFlag) String() string { return proto.CompactTextString(m) }
 func (*ThreadFlag) ProtoMessage()    {}
 func (*ThreadFlag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{5}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{5}
 }
 func (m *ThreadFlag) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadFlag.Unmarshal(m, b)
@@ -333,7 +349,7 @@ func (m *ThreadJoin) Reset()         { *m = ThreadJoin{} }
 func (m *ThreadJoin) String() string { return proto.CompactTextString(m) }
 func (*ThreadJoin) ProtoMessage()    {}
 func (*ThreadJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{6}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{6}
 }
 func (m *ThreadJoin) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadJoin.Unmarshal(m, b)
@@ -379,7 +395,7 @@ func (m *ThreadAnnounce) Reset()         { *m = ThreadAnnounce{} }
 func (m *ThreadAnnounce) String() string { return proto.CompactTextString(m) }
 func (*ThreadAnnounce) ProtoMessage()    {}
 func (*ThreadAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{7}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{7}
 }
 func (m *ThreadAnnounce) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadAnnounce.Unmarshal(m, b)
@@ -415,6 +431,8 @@ func (m *ThreadAnnounce) GetName() string {
 
 type ThreadMessage struct {
 	Body                 string   `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	Mentions             []string `protobuf:"bytes,2,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	ReplyTo              string   `protobuf:"bytes,3,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -424,7 +442,7 @@ func (m *ThreadMessage) Reset()         { *m = ThreadMessage{} }
 func (m *ThreadMessage) String() string { return proto.CompactTextString(m) }
 func (*ThreadMessage) ProtoMessage()    {}
 func (*ThreadMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{8}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{8}
 }
 func (m *ThreadMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadMessage.Unmarshal(m, b)
@@ -451,6 +469,20 @@ func (m *ThreadMessage) GetBody() string {
 	return ""
 }
 
+func (m *ThreadMessage) GetMentions() []string {
+	if m != nil {
+		return m.Mentions
+	}
+	return nil
+}
+
+func (m *ThreadMessage) GetReplyTo() string {
+	if m != nil {
+		return m.ReplyTo
+	}
+	return ""
+}
+
 type ThreadFiles struct {
 	Target               string            `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	Body                 string            `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
@@ -464,7 +496,7 @@ func (m *ThreadFiles) Reset()         { *m = ThreadFiles{} }
 func (m *ThreadFiles) String() string { return proto.CompactTextString(m) }
 func (*ThreadFiles) ProtoMessage()    {}
 func (*ThreadFiles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{9}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{9}
 }
 func (m *ThreadFiles) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadFiles.Unmarshal(m, b)
@@ -508,6 +540,7 @@ func (m *ThreadFiles) GetKeys() map[string]string {
 type ThreadComment struct {
 	Target               string   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	Body                 string   `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Mentions             []string `protobuf:"bytes,3,rep,name=mentions,proto3" json:"mentions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -517,7 +550,7 @@ func (m *ThreadComment) Reset()         { *m = ThreadComment{} }
 func (m *ThreadComment) String() string { return proto.CompactTextString(m) }
 func (*ThreadComment) ProtoMessage()    {}
 func (*ThreadComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{10}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{10}
 }
 func (m *ThreadComment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadComment.Unmarshal(m, b)
@@ -551,6 +584,13 @@ func (m *ThreadComment) GetBody() string {
 	return ""
 }
 
+func (m *ThreadComment) GetMentions() []string {
+	if m != nil {
+		return m.Mentions
+	}
+	return nil
+}
+
 type ThreadLike struct {
 	Target               string   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -562,7 +602,7 @@ func (m *ThreadLike) Reset()         { *m = ThreadLike{} }
 func (m *ThreadLike) String() string { return proto.CompactTextString(m) }
 func (*ThreadLike) ProtoMessage()    {}
 func (*ThreadLike) Descriptor() ([]byte, []int) {
-	return fileDescriptor_threads_service_e19f45888f58022d, []int{11}
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{11}
 }
 func (m *ThreadLike) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ThreadLike.Unmarshal(m, b)
@@ -589,6 +629,252 @@ func (m *ThreadLike) GetTarget() string {
 	return ""
 }
 
+type ThreadReaction struct {
+	Target               string   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Reaction             string   `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadReaction) Reset()         { *m = ThreadReaction{} }
+func (m *ThreadReaction) String() string { return proto.CompactTextString(m) }
+func (*ThreadReaction) ProtoMessage()    {}
+func (*ThreadReaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{12}
+}
+func (m *ThreadReaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadReaction.Unmarshal(m, b)
+}
+func (m *ThreadReaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadReaction.Marshal(b, m, deterministic)
+}
+func (dst *ThreadReaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadReaction.Merge(dst, src)
+}
+func (m *ThreadReaction) XXX_Size() int {
+	return xxx_messageInfo_ThreadReaction.Size(m)
+}
+func (m *ThreadReaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadReaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadReaction proto.InternalMessageInfo
+
+func (m *ThreadReaction) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *ThreadReaction) GetReaction() string {
+	if m != nil {
+		return m.Reaction
+	}
+	return ""
+}
+
+type ThreadEdit struct {
+	Target               string   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Body                 string   `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Mentions             []string `protobuf:"bytes,3,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadEdit) Reset()         { *m = ThreadEdit{} }
+func (m *ThreadEdit) String() string { return proto.CompactTextString(m) }
+func (*ThreadEdit) ProtoMessage()    {}
+func (*ThreadEdit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{13}
+}
+func (m *ThreadEdit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadEdit.Unmarshal(m, b)
+}
+func (m *ThreadEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadEdit.Marshal(b, m, deterministic)
+}
+func (dst *ThreadEdit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadEdit.Merge(dst, src)
+}
+func (m *ThreadEdit) XXX_Size() int {
+	return xxx_messageInfo_ThreadEdit.Size(m)
+}
+func (m *ThreadEdit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadEdit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadEdit proto.InternalMessageInfo
+
+func (m *ThreadEdit) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *ThreadEdit) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *ThreadEdit) GetMentions() []string {
+	if m != nil {
+		return m.Mentions
+	}
+	return nil
+}
+
+type ThreadRole struct {
+	Address              string      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Role                 Thread_Role `protobuf:"varint,2,opt,name=role,proto3,enum=Thread_Role" json:"role,omitempty"`
+	Sig                  []byte      `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ThreadRole) Reset()         { *m = ThreadRole{} }
+func (m *ThreadRole) String() string { return proto.CompactTextString(m) }
+func (*ThreadRole) ProtoMessage()    {}
+func (*ThreadRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{14}
+}
+func (m *ThreadRole) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadRole.Unmarshal(m, b)
+}
+func (m *ThreadRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadRole.Marshal(b, m, deterministic)
+}
+func (dst *ThreadRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadRole.Merge(dst, src)
+}
+func (m *ThreadRole) XXX_Size() int {
+	return xxx_messageInfo_ThreadRole.Size(m)
+}
+func (m *ThreadRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadRole proto.InternalMessageInfo
+
+func (m *ThreadRole) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ThreadRole) GetRole() Thread_Role {
+	if m != nil {
+		return m.Role
+	}
+	return Thread_NONE
+}
+
+func (m *ThreadRole) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
+type ThreadRoleList struct {
+	Items                []*ThreadRole `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ThreadRoleList) Reset()         { *m = ThreadRoleList{} }
+func (m *ThreadRoleList) String() string { return proto.CompactTextString(m) }
+func (*ThreadRoleList) ProtoMessage()    {}
+func (*ThreadRoleList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{15}
+}
+func (m *ThreadRoleList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadRoleList.Unmarshal(m, b)
+}
+func (m *ThreadRoleList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadRoleList.Marshal(b, m, deterministic)
+}
+func (dst *ThreadRoleList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadRoleList.Merge(dst, src)
+}
+func (m *ThreadRoleList) XXX_Size() int {
+	return xxx_messageInfo_ThreadRoleList.Size(m)
+}
+func (m *ThreadRoleList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadRoleList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadRoleList proto.InternalMessageInfo
+
+func (m *ThreadRoleList) GetItems() []*ThreadRole {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ThreadKeyRotate struct {
+	Epoch                int32             `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Keys                 map[string][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Sig                  []byte            `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ThreadKeyRotate) Reset()         { *m = ThreadKeyRotate{} }
+func (m *ThreadKeyRotate) String() string { return proto.CompactTextString(m) }
+func (*ThreadKeyRotate) ProtoMessage()    {}
+func (*ThreadKeyRotate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_threads_service_04caffcf560a9436, []int{16}
+}
+func (m *ThreadKeyRotate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadKeyRotate.Unmarshal(m, b)
+}
+func (m *ThreadKeyRotate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadKeyRotate.Marshal(b, m, deterministic)
+}
+func (dst *ThreadKeyRotate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadKeyRotate.Merge(dst, src)
+}
+func (m *ThreadKeyRotate) XXX_Size() int {
+	return xxx_messageInfo_ThreadKeyRotate.Size(m)
+}
+func (m *ThreadKeyRotate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadKeyRotate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadKeyRotate proto.InternalMessageInfo
+
+func (m *ThreadKeyRotate) GetEpoch() int32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ThreadKeyRotate) GetKeys() map[string][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ThreadKeyRotate) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ThreadEnvelope)(nil), "ThreadEnvelope")
 	proto.RegisterType((*ThreadBlock)(nil), "ThreadBlock")
@@ -603,47 +889,64 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "ThreadFiles.KeysEntry")
 	proto.RegisterType((*ThreadComment)(nil), "ThreadComment")
 	proto.RegisterType((*ThreadLike)(nil), "ThreadLike")
+	proto.RegisterType((*ThreadReaction)(nil), "ThreadReaction")
+	proto.RegisterType((*ThreadEdit)(nil), "ThreadEdit")
+	proto.RegisterType((*ThreadRole)(nil), "ThreadRole")
+	proto.RegisterType((*ThreadRoleList)(nil), "ThreadRoleList")
+	proto.RegisterType((*ThreadKeyRotate)(nil), "ThreadKeyRotate")
+	proto.RegisterMapType((map[string][]byte)(nil), "ThreadKeyRotate.KeysEntry")
 }
 
 func init() {
-	proto.RegisterFile("threads_service.proto", fileDescriptor_threads_service_e19f45888f58022d)
-}
-
-var fileDescriptor_threads_service_e19f45888f58022d = []byte{
-	// 553 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6b, 0xdb, 0x40,
-	0x10, 0x45, 0xb2, 0x92, 0xa0, 0x71, 0x12, 0xd2, 0x6d, 0x1a, 0x94, 0x1c, 0x1a, 0xa3, 0x86, 0x62,
-	0x72, 0xd8, 0x80, 0x7b, 0x68, 0x69, 0x0f, 0xc5, 0x29, 0x09, 0xfd, 0x0a, 0x14, 0x91, 0x53, 0x2f,
-	0x65, 0x6d, 0x4d, 0x25, 0x61, 0x69, 0x57, 0xec, 0xae, 0x4d, 0xf5, 0x2b, 0xfa, 0x0f, 0xfa, 0x5b,
-	0xcb, 0xae, 0xb4, 0xb2, 0xa9, 0xf1, 0xa1, 0x17, 0x31, 0x6f, 0xe6, 0xed, 0xce, 0xdb, 0x99, 0x27,
-	0x78, 0xa6, 0x73, 0x89, 0x2c, 0x55, 0x3f, 0x14, 0xca, 0x55, 0x31, 0x47, 0x5a, 0x4b, 0xa1, 0xc5,
-	0xc5, 0x79, 0x26, 0x44, 0x56, 0xe2, 0x8d, 0x45, 0xb3, 0xe5, 0xcf, 0x1b, 0xc6, 0x9b, 0xae, 0x74,
-	0xf9, 0x6f, 0x49, 0x17, 0x15, 0x2a, 0xcd, 0xaa, 0xba, 0x23, 0x0c, 0x2b, 0x91, 0x62, 0xd9, 0x82,
-	0x98, 0xc3, 0xf1, 0xa3, 0xed, 0x70, 0xc7, 0x57, 0x58, 0x8a, 0x1a, 0xc9, 0x19, 0xec, 0xb7, 0x3d,
-	0x23, 0x6f, 0xe4, 0x8d, 0xc3, 0xa4, 0x43, 0x84, 0x40, 0x90, 0x33, 0x95, 0x47, 0xbe, 0xcd, 0xda,
-	0x98, 0x3c, 0x07, 0x98, 0x17, 0x75, 0x8e, 0x52, 0xe3, 0x2f, 0x1d, 0x0d, 0x46, 0xde, 0xf8, 0x30,
-	0xd9, 0xc8, 0x90, 0x13, 0x18, 0xa8, 0x22, 0x8b, 0x02, 0x5b, 0x30, 0x61, 0xfc, 0xdb, 0x83, 0x61,
-	0xdb, 0xf0, 0xb6, 0x14, 0xf3, 0x05, 0xb9, 0x86, 0xfd, 0x1c, 0x59, 0x8a, 0xd2, 0x76, 0x1b, 0x4e,
-	0x08, 0xdd, 0xa8, 0x7e, 0xb4, 0x95, 0xa4, 0x63, 0x90, 0x2b, 0x08, 0x74, 0x53, 0xa3, 0x55, 0x70,
-	0x3c, 0x39, 0xa1, 0x96, 0xd3, 0x7e, 0x1f, 0x9b, 0x1a, 0x13, 0x5b, 0x25, 0x14, 0x0e, 0x6a, 0xd6,
-	0x94, 0x82, 0xa5, 0x56, 0xd0, 0x70, 0x72, 0x4a, 0xdb, 0x89, 0x50, 0x37, 0x11, 0x3a, 0xe5, 0x4d,
-	0xe2, 0x48, 0x46, 0xd1, 0x93, 0xad, 0x9e, 0x84, 0x42, 0x90, 0x32, 0x8d, 0x9d, 0xaa, 0x8b, 0xad,
-	0x2b, 0x1e, 0xdd, 0x50, 0x13, 0xcb, 0x23, 0x91, 0xe9, 0x2a, 0x91, 0x6b, 0x15, 0xf9, 0xa3, 0xc1,
-	0x38, 0x4c, 0x1c, 0x34, 0xf3, 0x64, 0x4b, 0x9d, 0x0b, 0x69, 0xe5, 0x84, 0x49, 0x87, 0xcc, 0x09,
-	0x96, 0xa6, 0x12, 0x95, 0xb2, 0xf3, 0x09, 0x13, 0x07, 0xe3, 0x07, 0x08, 0x5b, 0x41, 0xd3, 0x34,
-	0x25, 0x97, 0x70, 0x50, 0xf0, 0x55, 0xa1, 0xfb, 0x09, 0xed, 0xd1, 0x6f, 0x88, 0x32, 0x71, 0x59,
-	0x72, 0xd9, 0xef, 0xcb, 0xb7, 0xf5, 0x83, 0x6e, 0x82, 0x6e, 0x71, 0xf1, 0x4b, 0x38, 0x6c, 0x33,
-	0x9f, 0x32, 0x2e, 0x64, 0xbb, 0x60, 0x26, 0x33, 0xd4, 0xfd, 0x82, 0x2d, 0x8a, 0xaf, 0x00, 0x5a,
-	0xde, 0x7d, 0xc9, 0xb2, 0x9d, 0xac, 0xa9, 0x63, 0x7d, 0x16, 0x05, 0x37, 0x8f, 0xd8, 0x54, 0x17,
-	0xae, 0x65, 0x9d, 0x43, 0x50, 0x23, 0xca, 0x4e, 0x54, 0x27, 0xda, 0xa6, 0xe2, 0xf7, 0xce, 0x73,
-	0x53, 0xce, 0xc5, 0x92, 0xcf, 0xb1, 0x27, 0x7b, 0x5b, 0x64, 0x63, 0x3b, 0xce, 0x2a, 0x74, 0xb6,
-	0x33, 0x71, 0xfc, 0x02, 0x8e, 0xda, 0x0b, 0x1e, 0x50, 0x29, 0x96, 0xa1, 0x21, 0xcd, 0x44, 0xda,
-	0x74, 0x1a, 0x6c, 0x1c, 0xff, 0xe9, 0x9d, 0x76, 0x5f, 0x94, 0xa8, 0x76, 0x3d, 0xa8, 0x3f, 0xeb,
-	0xaf, 0xcf, 0x92, 0x6b, 0x08, 0x16, 0xd8, 0xa8, 0x68, 0x30, 0x1a, 0x8c, 0x87, 0x93, 0x33, 0xba,
-	0x71, 0x0f, 0xfd, 0x82, 0x8d, 0xba, 0xe3, 0x5a, 0x36, 0x89, 0xe5, 0x5c, 0xbc, 0x86, 0xb0, 0x4f,
-	0x19, 0xc3, 0x2f, 0xd0, 0xe9, 0x30, 0x21, 0x39, 0x85, 0xbd, 0x15, 0x2b, 0x97, 0xee, 0x01, 0x2d,
-	0x78, 0xeb, 0xbf, 0xf1, 0xe2, 0x77, 0xee, 0x15, 0x1f, 0x44, 0x55, 0x21, 0xd7, 0xff, 0xa3, 0x70,
-	0xbd, 0xac, 0xaf, 0xc5, 0x62, 0xe7, 0x4a, 0x6f, 0x9f, 0xc2, 0x51, 0x21, 0xa8, 0xf9, 0x15, 0x0b,
-	0x63, 0xde, 0xd9, 0x77, 0xbf, 0x9e, 0xcd, 0xf6, 0xad, 0x89, 0x5f, 0xfd, 0x0d, 0x00, 0x00, 0xff,
-	0xff, 0xcf, 0xe1, 0x9a, 0x7c, 0x5b, 0x04, 0x00, 0x00,
+	proto.RegisterFile("threads_service.proto", fileDescriptor_threads_service_04caffcf560a9436)
+}
+
+var fileDescriptor_threads_service_04caffcf560a9436 = []byte{
+	// 735 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6b, 0xdb, 0x4c,
+	0x10, 0x46, 0xb2, 0x1d, 0xc7, 0x63, 0x27, 0x6f, 0xde, 0x7d, 0xf3, 0x06, 0xc5, 0x87, 0xc4, 0x15,
+	0xa1, 0x98, 0x1c, 0x14, 0x70, 0x0e, 0x2d, 0xbd, 0x14, 0xa7, 0x4d, 0x68, 0x9b, 0x14, 0x8a, 0x30,
+	0x14, 0x72, 0x09, 0x6b, 0x6b, 0x6a, 0x0b, 0xcb, 0x5a, 0xb1, 0x5a, 0x9b, 0xea, 0x57, 0xb4, 0xbf,
+	0xa0, 0x87, 0xfe, 0xd2, 0xb2, 0x5f, 0xb2, 0x12, 0xe3, 0x43, 0xa0, 0x17, 0xb1, 0xb3, 0xf3, 0x68,
+	0xe7, 0x99, 0x67, 0x3e, 0xe0, 0x7f, 0x31, 0xe3, 0x48, 0xa3, 0xfc, 0x21, 0x47, 0xbe, 0x8a, 0x27,
+	0x18, 0x64, 0x9c, 0x09, 0xd6, 0x3d, 0x9e, 0x32, 0x36, 0x4d, 0xf0, 0x42, 0x59, 0xe3, 0xe5, 0xb7,
+	0x0b, 0x9a, 0x16, 0xc6, 0x75, 0xfa, 0xd4, 0x25, 0xe2, 0x05, 0xe6, 0x82, 0x2e, 0x32, 0x03, 0x68,
+	0x2f, 0x58, 0x84, 0x89, 0x36, 0xfc, 0x14, 0xf6, 0x47, 0x2a, 0xc2, 0x75, 0xba, 0xc2, 0x84, 0x65,
+	0x48, 0x8e, 0x60, 0x47, 0xc7, 0xf4, 0x9c, 0x9e, 0xd3, 0x6f, 0x85, 0xc6, 0x22, 0x04, 0xea, 0x33,
+	0x9a, 0xcf, 0x3c, 0x57, 0xdd, 0xaa, 0x33, 0x39, 0x01, 0x98, 0xc4, 0xd9, 0x0c, 0xb9, 0xc0, 0xef,
+	0xc2, 0xab, 0xf5, 0x9c, 0x7e, 0x27, 0xac, 0xdc, 0x90, 0x03, 0xa8, 0xe5, 0xf1, 0xd4, 0xab, 0x2b,
+	0x87, 0x3c, 0xfa, 0x3f, 0x1c, 0x68, 0xeb, 0x80, 0x57, 0x09, 0x9b, 0xcc, 0xc9, 0x39, 0xec, 0xcc,
+	0x90, 0x46, 0xc8, 0x55, 0xb4, 0xf6, 0x80, 0x04, 0x15, 0xef, 0x07, 0xe5, 0x09, 0x0d, 0x82, 0x9c,
+	0x41, 0x5d, 0x14, 0x19, 0x2a, 0x06, 0xfb, 0x83, 0x83, 0x40, 0x61, 0xf4, 0x77, 0x54, 0x64, 0x18,
+	0x2a, 0x2f, 0x09, 0xa0, 0x99, 0xd1, 0x22, 0x61, 0x34, 0x52, 0x84, 0xda, 0x83, 0xc3, 0x40, 0x2b,
+	0x12, 0x58, 0x45, 0x82, 0x61, 0x5a, 0x84, 0x16, 0x24, 0x19, 0xfd, 0xbb, 0x11, 0x93, 0x04, 0x50,
+	0x8f, 0xa8, 0x40, 0xc3, 0xaa, 0xbb, 0xf1, 0xc4, 0xc8, 0x8a, 0x1a, 0x2a, 0x1c, 0xf1, 0x64, 0x54,
+	0x8e, 0xa9, 0xc8, 0x3d, 0xb7, 0x57, 0xeb, 0xb7, 0x42, 0x6b, 0x4a, 0x3d, 0xe9, 0x52, 0xcc, 0x18,
+	0x57, 0x74, 0x5a, 0xa1, 0xb1, 0xe4, 0x1f, 0x34, 0x8a, 0x38, 0xe6, 0xb9, 0xd2, 0xa7, 0x15, 0x5a,
+	0xd3, 0xff, 0xe9, 0x40, 0x4b, 0x33, 0x1a, 0x46, 0x11, 0x39, 0x85, 0x66, 0x9c, 0xae, 0x62, 0x51,
+	0x4a, 0xd4, 0x08, 0xbe, 0x20, 0xf2, 0xd0, 0xde, 0x92, 0xd3, 0xb2, 0x60, 0xae, 0xf2, 0x37, 0x8d,
+	0x84, 0x65, 0xe5, 0x5e, 0x40, 0x83, 0xb3, 0x04, 0x73, 0xaf, 0xd6, 0xab, 0xf5, 0xdb, 0x83, 0xb6,
+	0xf5, 0xb3, 0x04, 0x43, 0xed, 0x21, 0x27, 0x50, 0x9f, 0x63, 0x21, 0x99, 0x48, 0x04, 0x18, 0xc4,
+	0x2d, 0x16, 0xa1, 0xba, 0xf7, 0x5f, 0x42, 0x47, 0x5f, 0x7d, 0x9c, 0xa6, 0x8c, 0xeb, 0x26, 0xa1,
+	0x7c, 0x8a, 0xa2, 0x6c, 0x12, 0x65, 0xf9, 0x67, 0x00, 0x1a, 0x77, 0x93, 0xd0, 0xe9, 0x56, 0xd4,
+	0xd0, 0xa2, 0x3e, 0xb1, 0x38, 0x95, 0x42, 0x54, 0x13, 0x6c, 0xad, 0x33, 0x3b, 0x86, 0x7a, 0x86,
+	0xc8, 0x3d, 0xb7, 0x9a, 0xb7, 0xba, 0xf2, 0xdf, 0xda, 0xbe, 0x1d, 0xa6, 0x29, 0x5b, 0xa6, 0x13,
+	0x2c, 0xc1, 0xce, 0x06, 0x58, 0xb6, 0x6e, 0x4a, 0x17, 0x68, 0x5b, 0x57, 0x9e, 0xfd, 0x7b, 0xd8,
+	0xd3, 0x0f, 0x7c, 0xc6, 0x3c, 0xa7, 0x53, 0x94, 0xa0, 0x31, 0x8b, 0x0a, 0xc3, 0x41, 0x9d, 0x49,
+	0x17, 0x76, 0x17, 0x98, 0x8a, 0x98, 0xa5, 0xb6, 0xac, 0xa5, 0x4d, 0x8e, 0x61, 0x97, 0x63, 0x96,
+	0x14, 0x0f, 0x82, 0x99, 0xca, 0x36, 0x95, 0x3d, 0x62, 0xfe, 0xaf, 0xb2, 0xc9, 0x6f, 0x62, 0xa9,
+	0xee, 0x16, 0x1d, 0xca, 0x90, 0x6e, 0x25, 0xe4, 0xb9, 0xa9, 0x84, 0xae, 0xd5, 0x51, 0x50, 0x79,
+	0x27, 0xb8, 0xc5, 0x22, 0xbf, 0x4e, 0x05, 0x37, 0x55, 0xe9, 0xbe, 0x82, 0x56, 0x79, 0x25, 0x67,
+	0x6d, 0x8e, 0x96, 0xbe, 0x3c, 0x92, 0x43, 0x68, 0xac, 0x68, 0xb2, 0xb4, 0x79, 0x6b, 0xe3, 0x8d,
+	0xfb, 0xda, 0xf1, 0xbf, 0xda, 0xe4, 0xdf, 0xb1, 0x85, 0x4c, 0xe8, 0x59, 0x0c, 0xab, 0xa2, 0xd4,
+	0x1e, 0x8b, 0xb2, 0xae, 0xff, 0x5d, 0x3c, 0xdf, 0xde, 0x25, 0xef, 0x6d, 0xf1, 0x42, 0xa4, 0x13,
+	0xf9, 0xe3, 0xd6, 0xf8, 0x5d, 0x29, 0xb2, 0xc6, 0x18, 0x0e, 0xa5, 0xed, 0x8f, 0x6c, 0xac, 0xeb,
+	0x28, 0xfe, 0x7b, 0x19, 0xdc, 0x03, 0xac, 0xc7, 0xa3, 0x3a, 0xa4, 0xce, 0xa3, 0x21, 0x25, 0x3d,
+	0xa8, 0xcb, 0xd1, 0x31, 0xcb, 0xa8, 0x63, 0xea, 0x14, 0xa8, 0xa1, 0x52, 0x1e, 0xbb, 0xfc, 0x6a,
+	0xeb, 0xe5, 0x77, 0x59, 0xe6, 0xcd, 0x12, 0xbc, 0x8b, 0x73, 0x21, 0x47, 0x33, 0x16, 0xb8, 0x90,
+	0xaf, 0x6f, 0x8e, 0xa6, 0xf2, 0xf8, 0xbf, 0x1d, 0xf8, 0x67, 0x3d, 0x8e, 0x4c, 0xc8, 0x6d, 0x73,
+	0x08, 0x0d, 0xcc, 0xd8, 0x64, 0xa6, 0x48, 0x35, 0x42, 0x6d, 0xc8, 0x9d, 0xa5, 0x5a, 0xc7, 0x55,
+	0x6f, 0x75, 0x83, 0x27, 0x7f, 0x3d, 0x6d, 0x9f, 0x4d, 0x82, 0xcf, 0x6a, 0xa8, 0x4e, 0xa5, 0xa1,
+	0xae, 0xfe, 0x83, 0xbd, 0x98, 0x05, 0x72, 0xe7, 0xc7, 0x72, 0x4b, 0x8e, 0xef, 0xdd, 0x6c, 0x3c,
+	0xde, 0x51, 0xdb, 0xf2, 0xf2, 0xcf, 0x00, 0xa3, 0x21, 0x4f, 0xf1, 0xc4, 0x06, 0x00, 0x00,
 }
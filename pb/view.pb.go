@@ -46,7 +46,100 @@ func (x AddThreadConfig_Schema_Preset) String() string {
 	return proto.EnumName(AddThreadConfig_Schema_Preset_name, int32(x))
 }
 func (AddThreadConfig_Schema_Preset) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{0, 0, 0}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{0, 0, 0}
+}
+
+type WalkOptions_Order int32
+
+const (
+	WalkOptions_REVERSE_CHRONOLOGICAL WalkOptions_Order = 0
+	WalkOptions_TOPOLOGICAL           WalkOptions_Order = 1
+)
+
+var WalkOptions_Order_name = map[int32]string{
+	0: "REVERSE_CHRONOLOGICAL",
+	1: "TOPOLOGICAL",
+}
+var WalkOptions_Order_value = map[string]int32{
+	"REVERSE_CHRONOLOGICAL": 0,
+	"TOPOLOGICAL":           1,
+}
+
+func (x WalkOptions_Order) String() string {
+	return proto.EnumName(WalkOptions_Order_name, int32(x))
+}
+func (WalkOptions_Order) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{2, 0}
+}
+
+type WalkStep_Status int32
+
+const (
+	WalkStep_OK          WalkStep_Status = 0
+	WalkStep_REJECTED    WalkStep_Status = 1
+	WalkStep_MISSING     WalkStep_Status = 2
+	WalkStep_UNFETCHABLE WalkStep_Status = 3
+)
+
+var WalkStep_Status_name = map[int32]string{
+	0: "OK",
+	1: "REJECTED",
+	2: "MISSING",
+	3: "UNFETCHABLE",
+}
+var WalkStep_Status_value = map[string]int32{
+	"OK":          0,
+	"REJECTED":    1,
+	"MISSING":     2,
+	"UNFETCHABLE": 3,
+}
+
+func (x WalkStep_Status) String() string {
+	return proto.EnumName(WalkStep_Status_name, int32(x))
+}
+func (WalkStep_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{3, 0}
+}
+
+type ThreadVerifyIssue_Type int32
+
+const (
+	ThreadVerifyIssue_MISSING_BLOCK   ThreadVerifyIssue_Type = 0
+	ThreadVerifyIssue_UNPINNED_BLOCK  ThreadVerifyIssue_Type = 1
+	ThreadVerifyIssue_UNDECRYPTABLE   ThreadVerifyIssue_Type = 2
+	ThreadVerifyIssue_INVALID_SIG     ThreadVerifyIssue_Type = 3
+	ThreadVerifyIssue_UNINDEXED_BLOCK ThreadVerifyIssue_Type = 4
+	ThreadVerifyIssue_MISSING_FILE    ThreadVerifyIssue_Type = 5
+	ThreadVerifyIssue_UNPINNED_FILE   ThreadVerifyIssue_Type = 6
+	ThreadVerifyIssue_ORPHAN_INDEX    ThreadVerifyIssue_Type = 7
+)
+
+var ThreadVerifyIssue_Type_name = map[int32]string{
+	0: "MISSING_BLOCK",
+	1: "UNPINNED_BLOCK",
+	2: "UNDECRYPTABLE",
+	3: "INVALID_SIG",
+	4: "UNINDEXED_BLOCK",
+	5: "MISSING_FILE",
+	6: "UNPINNED_FILE",
+	7: "ORPHAN_INDEX",
+}
+var ThreadVerifyIssue_Type_value = map[string]int32{
+	"MISSING_BLOCK":   0,
+	"UNPINNED_BLOCK":  1,
+	"UNDECRYPTABLE":   2,
+	"INVALID_SIG":     3,
+	"UNINDEXED_BLOCK": 4,
+	"MISSING_FILE":    5,
+	"UNPINNED_FILE":   6,
+	"ORPHAN_INDEX":    7,
+}
+
+func (x ThreadVerifyIssue_Type) String() string {
+	return proto.EnumName(ThreadVerifyIssue_Type_name, int32(x))
+}
+func (ThreadVerifyIssue_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{6, 0}
 }
 
 type FeedRequest_Mode int32
@@ -72,7 +165,7 @@ func (x FeedRequest_Mode) String() string {
 	return proto.EnumName(FeedRequest_Mode_name, int32(x))
 }
 func (FeedRequest_Mode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{9, 0}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{17, 0}
 }
 
 type WalletUpdate_Type int32
@@ -101,7 +194,42 @@ func (x WalletUpdate_Type) String() string {
 	return proto.EnumName(WalletUpdate_Type_name, int32(x))
 }
 func (WalletUpdate_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{27, 0}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{39, 0}
+}
+
+type Event_Type int32
+
+const (
+	Event_PING          Event_Type = 0
+	Event_THREAD_UPDATE Event_Type = 1
+	Event_WALLET_UPDATE Event_Type = 2
+	Event_NOTIFICATION  Event_Type = 3
+	Event_NODE_ONLINE   Event_Type = 4
+	Event_NODE_OFFLINE  Event_Type = 5
+)
+
+var Event_Type_name = map[int32]string{
+	0: "PING",
+	1: "THREAD_UPDATE",
+	2: "WALLET_UPDATE",
+	3: "NOTIFICATION",
+	4: "NODE_ONLINE",
+	5: "NODE_OFFLINE",
+}
+var Event_Type_value = map[string]int32{
+	"PING":          0,
+	"THREAD_UPDATE": 1,
+	"WALLET_UPDATE": 2,
+	"NOTIFICATION":  3,
+	"NODE_ONLINE":   4,
+	"NODE_OFFLINE":  5,
+}
+
+func (x Event_Type) String() string {
+	return proto.EnumName(Event_Type_name, int32(x))
+}
+func (Event_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{40, 0}
 }
 
 type LogLevel_Level int32
@@ -136,7 +264,7 @@ func (x LogLevel_Level) String() string {
 	return proto.EnumName(LogLevel_Level_name, int32(x))
 }
 func (LogLevel_Level) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{29, 0}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{42, 0}
 }
 
 type AddThreadConfig struct {
@@ -156,7 +284,7 @@ func (m *AddThreadConfig) Reset()         { *m = AddThreadConfig{} }
 func (m *AddThreadConfig) String() string { return proto.CompactTextString(m) }
 func (*AddThreadConfig) ProtoMessage()    {}
 func (*AddThreadConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{0}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{0}
 }
 func (m *AddThreadConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddThreadConfig.Unmarshal(m, b)
@@ -238,7 +366,7 @@ func (m *AddThreadConfig_Schema) Reset()         { *m = AddThreadConfig_Schema{}
 func (m *AddThreadConfig_Schema) String() string { return proto.CompactTextString(m) }
 func (*AddThreadConfig_Schema) ProtoMessage()    {}
 func (*AddThreadConfig_Schema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{0, 0}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{0, 0}
 }
 func (m *AddThreadConfig_Schema) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddThreadConfig_Schema.Unmarshal(m, b)
@@ -292,7 +420,7 @@ func (m *BlockViz) Reset()         { *m = BlockViz{} }
 func (m *BlockViz) String() string { return proto.CompactTextString(m) }
 func (*BlockViz) ProtoMessage()    {}
 func (*BlockViz) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{1}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{1}
 }
 func (m *BlockViz) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockViz.Unmarshal(m, b)
@@ -333,6 +461,308 @@ func (m *BlockViz) GetNext() string {
 	return ""
 }
 
+type WalkOptions struct {
+	Start                string            `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Order                WalkOptions_Order `protobuf:"varint,2,opt,name=order,proto3,enum=WalkOptions_Order" json:"order,omitempty"`
+	Depth                int32             `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Types                []Block_BlockType `protobuf:"varint,4,rep,packed,name=types,proto3,enum=Block_BlockType" json:"types,omitempty"`
+	Limit                int32             `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Fetch                bool              `protobuf:"varint,6,opt,name=fetch,proto3" json:"fetch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WalkOptions) Reset()         { *m = WalkOptions{} }
+func (m *WalkOptions) String() string { return proto.CompactTextString(m) }
+func (*WalkOptions) ProtoMessage()    {}
+func (*WalkOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{2}
+}
+func (m *WalkOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalkOptions.Unmarshal(m, b)
+}
+func (m *WalkOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalkOptions.Marshal(b, m, deterministic)
+}
+func (dst *WalkOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalkOptions.Merge(dst, src)
+}
+func (m *WalkOptions) XXX_Size() int {
+	return xxx_messageInfo_WalkOptions.Size(m)
+}
+func (m *WalkOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalkOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalkOptions proto.InternalMessageInfo
+
+func (m *WalkOptions) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *WalkOptions) GetOrder() WalkOptions_Order {
+	if m != nil {
+		return m.Order
+	}
+	return WalkOptions_REVERSE_CHRONOLOGICAL
+}
+
+func (m *WalkOptions) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *WalkOptions) GetTypes() []Block_BlockType {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *WalkOptions) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *WalkOptions) GetFetch() bool {
+	if m != nil {
+		return m.Fetch
+	}
+	return false
+}
+
+type WalkStep struct {
+	Id                   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status               WalkStep_Status `protobuf:"varint,2,opt,name=status,proto3,enum=WalkStep_Status" json:"status,omitempty"`
+	Depth                int32           `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Block                *Block          `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *WalkStep) Reset()         { *m = WalkStep{} }
+func (m *WalkStep) String() string { return proto.CompactTextString(m) }
+func (*WalkStep) ProtoMessage()    {}
+func (*WalkStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{3}
+}
+func (m *WalkStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalkStep.Unmarshal(m, b)
+}
+func (m *WalkStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalkStep.Marshal(b, m, deterministic)
+}
+func (dst *WalkStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalkStep.Merge(dst, src)
+}
+func (m *WalkStep) XXX_Size() int {
+	return xxx_messageInfo_WalkStep.Size(m)
+}
+func (m *WalkStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalkStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalkStep proto.InternalMessageInfo
+
+func (m *WalkStep) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WalkStep) GetStatus() WalkStep_Status {
+	if m != nil {
+		return m.Status
+	}
+	return WalkStep_OK
+}
+
+func (m *WalkStep) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *WalkStep) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+type WalkStepList struct {
+	Items                []*WalkStep `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *WalkStepList) Reset()         { *m = WalkStepList{} }
+func (m *WalkStepList) String() string { return proto.CompactTextString(m) }
+func (*WalkStepList) ProtoMessage()    {}
+func (*WalkStepList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{4}
+}
+func (m *WalkStepList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalkStepList.Unmarshal(m, b)
+}
+func (m *WalkStepList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalkStepList.Marshal(b, m, deterministic)
+}
+func (dst *WalkStepList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalkStepList.Merge(dst, src)
+}
+func (m *WalkStepList) XXX_Size() int {
+	return xxx_messageInfo_WalkStepList.Size(m)
+}
+func (m *WalkStepList) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalkStepList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalkStepList proto.InternalMessageInfo
+
+func (m *WalkStepList) GetItems() []*WalkStep {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ThreadVerifyReport struct {
+	Thread               string               `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Head                 string               `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
+	Checked              int32                `protobuf:"varint,3,opt,name=checked,proto3" json:"checked,omitempty"`
+	Issues               []*ThreadVerifyIssue `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ThreadVerifyReport) Reset()         { *m = ThreadVerifyReport{} }
+func (m *ThreadVerifyReport) String() string { return proto.CompactTextString(m) }
+func (*ThreadVerifyReport) ProtoMessage()    {}
+func (*ThreadVerifyReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{5}
+}
+func (m *ThreadVerifyReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadVerifyReport.Unmarshal(m, b)
+}
+func (m *ThreadVerifyReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadVerifyReport.Marshal(b, m, deterministic)
+}
+func (dst *ThreadVerifyReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadVerifyReport.Merge(dst, src)
+}
+func (m *ThreadVerifyReport) XXX_Size() int {
+	return xxx_messageInfo_ThreadVerifyReport.Size(m)
+}
+func (m *ThreadVerifyReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadVerifyReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadVerifyReport proto.InternalMessageInfo
+
+func (m *ThreadVerifyReport) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ThreadVerifyReport) GetHead() string {
+	if m != nil {
+		return m.Head
+	}
+	return ""
+}
+
+func (m *ThreadVerifyReport) GetChecked() int32 {
+	if m != nil {
+		return m.Checked
+	}
+	return 0
+}
+
+func (m *ThreadVerifyReport) GetIssues() []*ThreadVerifyIssue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
+type ThreadVerifyIssue struct {
+	Block                string                 `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Type                 ThreadVerifyIssue_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ThreadVerifyIssue_Type" json:"type,omitempty"`
+	Detail               string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	Repaired             bool                   `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ThreadVerifyIssue) Reset()         { *m = ThreadVerifyIssue{} }
+func (m *ThreadVerifyIssue) String() string { return proto.CompactTextString(m) }
+func (*ThreadVerifyIssue) ProtoMessage()    {}
+func (*ThreadVerifyIssue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{6}
+}
+func (m *ThreadVerifyIssue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadVerifyIssue.Unmarshal(m, b)
+}
+func (m *ThreadVerifyIssue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadVerifyIssue.Marshal(b, m, deterministic)
+}
+func (dst *ThreadVerifyIssue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadVerifyIssue.Merge(dst, src)
+}
+func (m *ThreadVerifyIssue) XXX_Size() int {
+	return xxx_messageInfo_ThreadVerifyIssue.Size(m)
+}
+func (m *ThreadVerifyIssue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadVerifyIssue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadVerifyIssue proto.InternalMessageInfo
+
+func (m *ThreadVerifyIssue) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *ThreadVerifyIssue) GetType() ThreadVerifyIssue_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ThreadVerifyIssue_MISSING_BLOCK
+}
+
+func (m *ThreadVerifyIssue) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+func (m *ThreadVerifyIssue) GetRepaired() bool {
+	if m != nil {
+		return m.Repaired
+	}
+	return false
+}
+
 type Step struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Link                 *Link    `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
@@ -345,7 +775,7 @@ func (m *Step) Reset()         { *m = Step{} }
 func (m *Step) String() string { return proto.CompactTextString(m) }
 func (*Step) ProtoMessage()    {}
 func (*Step) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{2}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{7}
 }
 func (m *Step) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Step.Unmarshal(m, b)
@@ -390,7 +820,7 @@ func (m *Directory) Reset()         { *m = Directory{} }
 func (m *Directory) String() string { return proto.CompactTextString(m) }
 func (*Directory) ProtoMessage()    {}
 func (*Directory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{3}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{8}
 }
 func (m *Directory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Directory.Unmarshal(m, b)
@@ -428,7 +858,7 @@ func (m *DirectoryList) Reset()         { *m = DirectoryList{} }
 func (m *DirectoryList) String() string { return proto.CompactTextString(m) }
 func (*DirectoryList) ProtoMessage()    {}
 func (*DirectoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{4}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{9}
 }
 func (m *DirectoryList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DirectoryList.Unmarshal(m, b)
@@ -466,7 +896,7 @@ func (m *Keys) Reset()         { *m = Keys{} }
 func (m *Keys) String() string { return proto.CompactTextString(m) }
 func (*Keys) ProtoMessage()    {}
 func (*Keys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{5}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{10}
 }
 func (m *Keys) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Keys.Unmarshal(m, b)
@@ -493,6 +923,176 @@ func (m *Keys) GetFiles() map[string]string {
 	return nil
 }
 
+type BlockSearchFilters struct {
+	Thread               string            `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Types                []Block_BlockType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=Block_BlockType" json:"types,omitempty"`
+	Author               string            `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Offset               string            `protobuf:"bytes,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32             `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BlockSearchFilters) Reset()         { *m = BlockSearchFilters{} }
+func (m *BlockSearchFilters) String() string { return proto.CompactTextString(m) }
+func (*BlockSearchFilters) ProtoMessage()    {}
+func (*BlockSearchFilters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{11}
+}
+func (m *BlockSearchFilters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockSearchFilters.Unmarshal(m, b)
+}
+func (m *BlockSearchFilters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockSearchFilters.Marshal(b, m, deterministic)
+}
+func (dst *BlockSearchFilters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSearchFilters.Merge(dst, src)
+}
+func (m *BlockSearchFilters) XXX_Size() int {
+	return xxx_messageInfo_BlockSearchFilters.Size(m)
+}
+func (m *BlockSearchFilters) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSearchFilters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSearchFilters proto.InternalMessageInfo
+
+func (m *BlockSearchFilters) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *BlockSearchFilters) GetTypes() []Block_BlockType {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *BlockSearchFilters) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *BlockSearchFilters) GetOffset() string {
+	if m != nil {
+		return m.Offset
+	}
+	return ""
+}
+
+func (m *BlockSearchFilters) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type BlockSearchResult struct {
+	Block                *Block   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	File                 string   `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	Snippet              string   `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockSearchResult) Reset()         { *m = BlockSearchResult{} }
+func (m *BlockSearchResult) String() string { return proto.CompactTextString(m) }
+func (*BlockSearchResult) ProtoMessage()    {}
+func (*BlockSearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{12}
+}
+func (m *BlockSearchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockSearchResult.Unmarshal(m, b)
+}
+func (m *BlockSearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockSearchResult.Marshal(b, m, deterministic)
+}
+func (dst *BlockSearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSearchResult.Merge(dst, src)
+}
+func (m *BlockSearchResult) XXX_Size() int {
+	return xxx_messageInfo_BlockSearchResult.Size(m)
+}
+func (m *BlockSearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSearchResult proto.InternalMessageInfo
+
+func (m *BlockSearchResult) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlockSearchResult) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *BlockSearchResult) GetSnippet() string {
+	if m != nil {
+		return m.Snippet
+	}
+	return ""
+}
+
+type BlockSearchResultList struct {
+	Items                []*BlockSearchResult `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next                 string               `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BlockSearchResultList) Reset()         { *m = BlockSearchResultList{} }
+func (m *BlockSearchResultList) String() string { return proto.CompactTextString(m) }
+func (*BlockSearchResultList) ProtoMessage()    {}
+func (*BlockSearchResultList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{13}
+}
+func (m *BlockSearchResultList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockSearchResultList.Unmarshal(m, b)
+}
+func (m *BlockSearchResultList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockSearchResultList.Marshal(b, m, deterministic)
+}
+func (dst *BlockSearchResultList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSearchResultList.Merge(dst, src)
+}
+func (m *BlockSearchResultList) XXX_Size() int {
+	return xxx_messageInfo_BlockSearchResultList.Size(m)
+}
+func (m *BlockSearchResultList) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSearchResultList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSearchResultList proto.InternalMessageInfo
+
+func (m *BlockSearchResultList) GetItems() []*BlockSearchResult {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *BlockSearchResultList) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
 type InviteView struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -507,7 +1107,7 @@ func (m *InviteView) Reset()         { *m = InviteView{} }
 func (m *InviteView) String() string { return proto.CompactTextString(m) }
 func (*InviteView) ProtoMessage()    {}
 func (*InviteView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{6}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{14}
 }
 func (m *InviteView) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteView.Unmarshal(m, b)
@@ -566,7 +1166,7 @@ func (m *InviteViewList) Reset()         { *m = InviteViewList{} }
 func (m *InviteViewList) String() string { return proto.CompactTextString(m) }
 func (*InviteViewList) ProtoMessage()    {}
 func (*InviteViewList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{7}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{15}
 }
 func (m *InviteViewList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteViewList.Unmarshal(m, b)
@@ -606,7 +1206,7 @@ func (m *ExternalInvite) Reset()         { *m = ExternalInvite{} }
 func (m *ExternalInvite) String() string { return proto.CompactTextString(m) }
 func (*ExternalInvite) ProtoMessage()    {}
 func (*ExternalInvite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{8}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{16}
 }
 func (m *ExternalInvite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExternalInvite.Unmarshal(m, b)
@@ -648,20 +1248,25 @@ func (m *ExternalInvite) GetInviter() string {
 }
 
 type FeedRequest struct {
-	Thread               string           `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
-	Offset               string           `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit                int32            `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Mode                 FeedRequest_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=FeedRequest_Mode" json:"mode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Thread               string               `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Offset               string               `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32                `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Mode                 FeedRequest_Mode     `protobuf:"varint,4,opt,name=mode,proto3,enum=FeedRequest_Mode" json:"mode,omitempty"`
+	Author               string               `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Types                []Block_BlockType    `protobuf:"varint,6,rep,packed,name=types,proto3,enum=Block_BlockType" json:"types,omitempty"`
+	After                *timestamp.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Before               *timestamp.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	Media                string               `protobuf:"bytes,9,opt,name=media,proto3" json:"media,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *FeedRequest) Reset()         { *m = FeedRequest{} }
 func (m *FeedRequest) String() string { return proto.CompactTextString(m) }
 func (*FeedRequest) ProtoMessage()    {}
 func (*FeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{9}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{17}
 }
 func (m *FeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeedRequest.Unmarshal(m, b)
@@ -709,20 +1314,56 @@ func (m *FeedRequest) GetMode() FeedRequest_Mode {
 	return FeedRequest_CHRONO
 }
 
+func (m *FeedRequest) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *FeedRequest) GetTypes() []Block_BlockType {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *FeedRequest) GetAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (m *FeedRequest) GetBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *FeedRequest) GetMedia() string {
+	if m != nil {
+		return m.Media
+	}
+	return ""
+}
+
 type FeedItem struct {
-	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
-	Thread               string   `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
-	Payload              *any.Any `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Block                string           `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Thread               string           `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Payload              *any.Any         `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Reactions            []*ReactionCount `protobuf:"bytes,4,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *FeedItem) Reset()         { *m = FeedItem{} }
 func (m *FeedItem) String() string { return proto.CompactTextString(m) }
 func (*FeedItem) ProtoMessage()    {}
 func (*FeedItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{10}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{18}
 }
 func (m *FeedItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeedItem.Unmarshal(m, b)
@@ -763,6 +1404,13 @@ func (m *FeedItem) GetPayload() *any.Any {
 	return nil
 }
 
+func (m *FeedItem) GetReactions() []*ReactionCount {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
 type FeedItemList struct {
 	Items                []*FeedItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	Count                int32       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
@@ -776,7 +1424,7 @@ func (m *FeedItemList) Reset()         { *m = FeedItemList{} }
 func (m *FeedItemList) String() string { return proto.CompactTextString(m) }
 func (*FeedItemList) ProtoMessage()    {}
 func (*FeedItemList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{11}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{19}
 }
 func (m *FeedItemList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeedItemList.Unmarshal(m, b)
@@ -831,7 +1479,7 @@ func (m *Merge) Reset()         { *m = Merge{} }
 func (m *Merge) String() string { return proto.CompactTextString(m) }
 func (*Merge) ProtoMessage()    {}
 func (*Merge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{12}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{20}
 }
 func (m *Merge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Merge.Unmarshal(m, b)
@@ -893,7 +1541,7 @@ func (m *Ignore) Reset()         { *m = Ignore{} }
 func (m *Ignore) String() string { return proto.CompactTextString(m) }
 func (*Ignore) ProtoMessage()    {}
 func (*Ignore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{13}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{21}
 }
 func (m *Ignore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Ignore.Unmarshal(m, b)
@@ -955,7 +1603,7 @@ func (m *Flag) Reset()         { *m = Flag{} }
 func (m *Flag) String() string { return proto.CompactTextString(m) }
 func (*Flag) ProtoMessage()    {}
 func (*Flag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{14}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{22}
 }
 func (m *Flag) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Flag.Unmarshal(m, b)
@@ -1017,7 +1665,7 @@ func (m *Join) Reset()         { *m = Join{} }
 func (m *Join) String() string { return proto.CompactTextString(m) }
 func (*Join) ProtoMessage()    {}
 func (*Join) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{15}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{23}
 }
 func (m *Join) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Join.Unmarshal(m, b)
@@ -1078,7 +1726,7 @@ func (m *Announce) Reset()         { *m = Announce{} }
 func (m *Announce) String() string { return proto.CompactTextString(m) }
 func (*Announce) ProtoMessage()    {}
 func (*Announce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{16}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{24}
 }
 func (m *Announce) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Announce.Unmarshal(m, b)
@@ -1133,7 +1781,7 @@ func (m *Leave) Reset()         { *m = Leave{} }
 func (m *Leave) String() string { return proto.CompactTextString(m) }
 func (*Leave) ProtoMessage()    {}
 func (*Leave) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{17}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{25}
 }
 func (m *Leave) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Leave.Unmarshal(m, b)
@@ -1188,6 +1836,12 @@ type Text struct {
 	Body                 string               `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
 	Comments             []*Comment           `protobuf:"bytes,5,rep,name=comments,proto3" json:"comments,omitempty"`
 	Likes                []*Like              `protobuf:"bytes,6,rep,name=likes,proto3" json:"likes,omitempty"`
+	Mentions             []*User              `protobuf:"bytes,7,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	Edited               bool                 `protobuf:"varint,8,opt,name=edited,proto3" json:"edited,omitempty"`
+	Revisions            []*Revision          `protobuf:"bytes,9,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	ReplyTo              string               `protobuf:"bytes,10,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	Target               *FeedItem            `protobuf:"bytes,11,opt,name=target,proto3" json:"target,omitempty"`
+	Replies              []*Text              `protobuf:"bytes,12,rep,name=replies,proto3" json:"replies,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1197,7 +1851,7 @@ func (m *Text) Reset()         { *m = Text{} }
 func (m *Text) String() string { return proto.CompactTextString(m) }
 func (*Text) ProtoMessage()    {}
 func (*Text) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{18}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{26}
 }
 func (m *Text) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Text.Unmarshal(m, b)
@@ -1259,6 +1913,48 @@ func (m *Text) GetLikes() []*Like {
 	return nil
 }
 
+func (m *Text) GetMentions() []*User {
+	if m != nil {
+		return m.Mentions
+	}
+	return nil
+}
+
+func (m *Text) GetEdited() bool {
+	if m != nil {
+		return m.Edited
+	}
+	return false
+}
+
+func (m *Text) GetRevisions() []*Revision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+func (m *Text) GetReplyTo() string {
+	if m != nil {
+		return m.ReplyTo
+	}
+	return ""
+}
+
+func (m *Text) GetTarget() *FeedItem {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *Text) GetReplies() []*Text {
+	if m != nil {
+		return m.Replies
+	}
+	return nil
+}
+
 type TextList struct {
 	Items                []*Text  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1270,7 +1966,7 @@ func (m *TextList) Reset()         { *m = TextList{} }
 func (m *TextList) String() string { return proto.CompactTextString(m) }
 func (*TextList) ProtoMessage()    {}
 func (*TextList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{19}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{27}
 }
 func (m *TextList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TextList.Unmarshal(m, b)
@@ -1310,7 +2006,7 @@ func (m *File) Reset()         { *m = File{} }
 func (m *File) String() string { return proto.CompactTextString(m) }
 func (*File) ProtoMessage()    {}
 func (*File) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{20}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{28}
 }
 func (m *File) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_File.Unmarshal(m, b)
@@ -1370,7 +2066,7 @@ func (m *Files) Reset()         { *m = Files{} }
 func (m *Files) String() string { return proto.CompactTextString(m) }
 func (*Files) ProtoMessage()    {}
 func (*Files) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{21}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{29}
 }
 func (m *Files) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Files.Unmarshal(m, b)
@@ -1464,7 +2160,7 @@ func (m *FilesList) Reset()         { *m = FilesList{} }
 func (m *FilesList) String() string { return proto.CompactTextString(m) }
 func (*FilesList) ProtoMessage()    {}
 func (*FilesList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{22}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{30}
 }
 func (m *FilesList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilesList.Unmarshal(m, b)
@@ -1497,6 +2193,9 @@ type Comment struct {
 	User                 *User                `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
 	Body                 string               `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
 	Target               *FeedItem            `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	Mentions             []*User              `protobuf:"bytes,6,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	Edited               bool                 `protobuf:"varint,7,opt,name=edited,proto3" json:"edited,omitempty"`
+	Revisions            []*Revision          `protobuf:"bytes,8,rep,name=revisions,proto3" json:"revisions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1506,7 +2205,7 @@ func (m *Comment) Reset()         { *m = Comment{} }
 func (m *Comment) String() string { return proto.CompactTextString(m) }
 func (*Comment) ProtoMessage()    {}
 func (*Comment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{23}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{31}
 }
 func (m *Comment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Comment.Unmarshal(m, b)
@@ -1561,6 +2260,27 @@ func (m *Comment) GetTarget() *FeedItem {
 	return nil
 }
 
+func (m *Comment) GetMentions() []*User {
+	if m != nil {
+		return m.Mentions
+	}
+	return nil
+}
+
+func (m *Comment) GetEdited() bool {
+	if m != nil {
+		return m.Edited
+	}
+	return false
+}
+
+func (m *Comment) GetRevisions() []*Revision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
 type CommentList struct {
 	Items                []*Comment `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -1572,7 +2292,7 @@ func (m *CommentList) Reset()         { *m = CommentList{} }
 func (m *CommentList) String() string { return proto.CompactTextString(m) }
 func (*CommentList) ProtoMessage()    {}
 func (*CommentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{24}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{32}
 }
 func (m *CommentList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommentList.Unmarshal(m, b)
@@ -1613,7 +2333,7 @@ func (m *Like) Reset()         { *m = Like{} }
 func (m *Like) String() string { return proto.CompactTextString(m) }
 func (*Like) ProtoMessage()    {}
 func (*Like) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{25}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{33}
 }
 func (m *Like) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Like.Unmarshal(m, b)
@@ -1672,7 +2392,7 @@ func (m *LikeList) Reset()         { *m = LikeList{} }
 func (m *LikeList) String() string { return proto.CompactTextString(m) }
 func (*LikeList) ProtoMessage()    {}
 func (*LikeList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{26}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{34}
 }
 func (m *LikeList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LikeList.Unmarshal(m, b)
@@ -1699,6 +2419,222 @@ func (m *LikeList) GetItems() []*Like {
 	return nil
 }
 
+type Reaction struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	User                 *User                `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Reaction             string               `protobuf:"bytes,4,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Target               *FeedItem            `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Reaction) Reset()         { *m = Reaction{} }
+func (m *Reaction) String() string { return proto.CompactTextString(m) }
+func (*Reaction) ProtoMessage()    {}
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{35}
+}
+func (m *Reaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Reaction.Unmarshal(m, b)
+}
+func (m *Reaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Reaction.Marshal(b, m, deterministic)
+}
+func (dst *Reaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reaction.Merge(dst, src)
+}
+func (m *Reaction) XXX_Size() int {
+	return xxx_messageInfo_Reaction.Size(m)
+}
+func (m *Reaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reaction proto.InternalMessageInfo
+
+func (m *Reaction) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Reaction) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *Reaction) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *Reaction) GetReaction() string {
+	if m != nil {
+		return m.Reaction
+	}
+	return ""
+}
+
+func (m *Reaction) GetTarget() *FeedItem {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+type ReactionList struct {
+	Items                []*Reaction `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReactionList) Reset()         { *m = ReactionList{} }
+func (m *ReactionList) String() string { return proto.CompactTextString(m) }
+func (*ReactionList) ProtoMessage()    {}
+func (*ReactionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{36}
+}
+func (m *ReactionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReactionList.Unmarshal(m, b)
+}
+func (m *ReactionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReactionList.Marshal(b, m, deterministic)
+}
+func (dst *ReactionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactionList.Merge(dst, src)
+}
+func (m *ReactionList) XXX_Size() int {
+	return xxx_messageInfo_ReactionList.Size(m)
+}
+func (m *ReactionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactionList proto.InternalMessageInfo
+
+func (m *ReactionList) GetItems() []*Reaction {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ReactionCount struct {
+	Reaction             string   `protobuf:"bytes,1,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Reacted              bool     `protobuf:"varint,3,opt,name=reacted,proto3" json:"reacted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReactionCount) Reset()         { *m = ReactionCount{} }
+func (m *ReactionCount) String() string { return proto.CompactTextString(m) }
+func (*ReactionCount) ProtoMessage()    {}
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{37}
+}
+func (m *ReactionCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReactionCount.Unmarshal(m, b)
+}
+func (m *ReactionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReactionCount.Marshal(b, m, deterministic)
+}
+func (dst *ReactionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactionCount.Merge(dst, src)
+}
+func (m *ReactionCount) XXX_Size() int {
+	return xxx_messageInfo_ReactionCount.Size(m)
+}
+func (m *ReactionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactionCount proto.InternalMessageInfo
+
+func (m *ReactionCount) GetReaction() string {
+	if m != nil {
+		return m.Reaction
+	}
+	return ""
+}
+
+func (m *ReactionCount) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReactionCount) GetReacted() bool {
+	if m != nil {
+		return m.Reacted
+	}
+	return false
+}
+
+type Revision struct {
+	Block                string               `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Body                 string               `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Revision) Reset()         { *m = Revision{} }
+func (m *Revision) String() string { return proto.CompactTextString(m) }
+func (*Revision) ProtoMessage()    {}
+func (*Revision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{38}
+}
+func (m *Revision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Revision.Unmarshal(m, b)
+}
+func (m *Revision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Revision.Marshal(b, m, deterministic)
+}
+func (dst *Revision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revision.Merge(dst, src)
+}
+func (m *Revision) XXX_Size() int {
+	return xxx_messageInfo_Revision.Size(m)
+}
+func (m *Revision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revision proto.InternalMessageInfo
+
+func (m *Revision) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *Revision) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *Revision) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
 type WalletUpdate struct {
 	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Key                  string            `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
@@ -1712,7 +2648,7 @@ func (m *WalletUpdate) Reset()         { *m = WalletUpdate{} }
 func (m *WalletUpdate) String() string { return proto.CompactTextString(m) }
 func (*WalletUpdate) ProtoMessage()    {}
 func (*WalletUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{27}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{39}
 }
 func (m *WalletUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletUpdate.Unmarshal(m, b)
@@ -1753,6 +2689,84 @@ func (m *WalletUpdate) GetType() WalletUpdate_Type {
 	return WalletUpdate_THREAD_ADDED
 }
 
+type Event struct {
+	Type                 Event_Type           `protobuf:"varint,1,opt,name=type,proto3,enum=Event_Type" json:"type,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Thread               string               `protobuf:"bytes,3,opt,name=thread,proto3" json:"thread,omitempty"`
+	ThreadUpdate         *FeedItem            `protobuf:"bytes,4,opt,name=thread_update,json=threadUpdate,proto3" json:"thread_update,omitempty"`
+	WalletUpdate         *WalletUpdate        `protobuf:"bytes,5,opt,name=wallet_update,json=walletUpdate,proto3" json:"wallet_update,omitempty"`
+	Notification         *Notification        `protobuf:"bytes,6,opt,name=notification,proto3" json:"notification,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{40}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (dst *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(dst, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetType() Event_Type {
+	if m != nil {
+		return m.Type
+	}
+	return Event_PING
+}
+
+func (m *Event) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *Event) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *Event) GetThreadUpdate() *FeedItem {
+	if m != nil {
+		return m.ThreadUpdate
+	}
+	return nil
+}
+
+func (m *Event) GetWalletUpdate() *WalletUpdate {
+	if m != nil {
+		return m.WalletUpdate
+	}
+	return nil
+}
+
+func (m *Event) GetNotification() *Notification {
+	if m != nil {
+		return m.Notification
+	}
+	return nil
+}
+
 type Summary struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -1769,7 +2783,7 @@ func (m *Summary) Reset()         { *m = Summary{} }
 func (m *Summary) String() string { return proto.CompactTextString(m) }
 func (*Summary) ProtoMessage()    {}
 func (*Summary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{28}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{41}
 }
 func (m *Summary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Summary.Unmarshal(m, b)
@@ -1842,7 +2856,7 @@ func (m *LogLevel) Reset()         { *m = LogLevel{} }
 func (m *LogLevel) String() string { return proto.CompactTextString(m) }
 func (*LogLevel) ProtoMessage()    {}
 func (*LogLevel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_view_8f9931836b8998c9, []int{29}
+	return fileDescriptor_view_3bf94d3fdb9df680, []int{42}
 }
 func (m *LogLevel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogLevel.Unmarshal(m, b)
@@ -1873,12 +2887,20 @@ func init() {
 	proto.RegisterType((*AddThreadConfig)(nil), "AddThreadConfig")
 	proto.RegisterType((*AddThreadConfig_Schema)(nil), "AddThreadConfig.Schema")
 	proto.RegisterType((*BlockViz)(nil), "BlockViz")
+	proto.RegisterType((*WalkOptions)(nil), "WalkOptions")
+	proto.RegisterType((*WalkStep)(nil), "WalkStep")
+	proto.RegisterType((*WalkStepList)(nil), "WalkStepList")
+	proto.RegisterType((*ThreadVerifyReport)(nil), "ThreadVerifyReport")
+	proto.RegisterType((*ThreadVerifyIssue)(nil), "ThreadVerifyIssue")
 	proto.RegisterType((*Step)(nil), "Step")
 	proto.RegisterType((*Directory)(nil), "Directory")
 	proto.RegisterMapType((map[string]*FileIndex)(nil), "Directory.FilesEntry")
 	proto.RegisterType((*DirectoryList)(nil), "DirectoryList")
 	proto.RegisterType((*Keys)(nil), "Keys")
 	proto.RegisterMapType((map[string]string)(nil), "Keys.FilesEntry")
+	proto.RegisterType((*BlockSearchFilters)(nil), "BlockSearchFilters")
+	proto.RegisterType((*BlockSearchResult)(nil), "BlockSearchResult")
+	proto.RegisterType((*BlockSearchResultList)(nil), "BlockSearchResultList")
 	proto.RegisterType((*InviteView)(nil), "InviteView")
 	proto.RegisterType((*InviteViewList)(nil), "InviteViewList")
 	proto.RegisterType((*ExternalInvite)(nil), "ExternalInvite")
@@ -1901,109 +2923,174 @@ func init() {
 	proto.RegisterType((*CommentList)(nil), "CommentList")
 	proto.RegisterType((*Like)(nil), "Like")
 	proto.RegisterType((*LikeList)(nil), "LikeList")
+	proto.RegisterType((*Reaction)(nil), "Reaction")
+	proto.RegisterType((*ReactionList)(nil), "ReactionList")
+	proto.RegisterType((*ReactionCount)(nil), "ReactionCount")
+	proto.RegisterType((*Revision)(nil), "Revision")
 	proto.RegisterType((*WalletUpdate)(nil), "WalletUpdate")
+	proto.RegisterType((*Event)(nil), "Event")
 	proto.RegisterType((*Summary)(nil), "Summary")
 	proto.RegisterType((*LogLevel)(nil), "LogLevel")
 	proto.RegisterMapType((map[string]LogLevel_Level)(nil), "LogLevel.SystemsEntry")
 	proto.RegisterEnum("AddThreadConfig_Schema_Preset", AddThreadConfig_Schema_Preset_name, AddThreadConfig_Schema_Preset_value)
+	proto.RegisterEnum("WalkOptions_Order", WalkOptions_Order_name, WalkOptions_Order_value)
+	proto.RegisterEnum("WalkStep_Status", WalkStep_Status_name, WalkStep_Status_value)
+	proto.RegisterEnum("ThreadVerifyIssue_Type", ThreadVerifyIssue_Type_name, ThreadVerifyIssue_Type_value)
 	proto.RegisterEnum("FeedRequest_Mode", FeedRequest_Mode_name, FeedRequest_Mode_value)
 	proto.RegisterEnum("WalletUpdate_Type", WalletUpdate_Type_name, WalletUpdate_Type_value)
+	proto.RegisterEnum("Event_Type", Event_Type_name, Event_Type_value)
 	proto.RegisterEnum("LogLevel_Level", LogLevel_Level_name, LogLevel_Level_value)
 }
 
-func init() { proto.RegisterFile("view.proto", fileDescriptor_view_8f9931836b8998c9) }
-
-var fileDescriptor_view_8f9931836b8998c9 = []byte{
-	// 1454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x5d, 0x6f, 0xdb, 0x54,
-	0x18, 0xae, 0x13, 0x3b, 0x1f, 0x6f, 0xd2, 0xce, 0x1c, 0xca, 0xf0, 0xba, 0x69, 0xcd, 0x3c, 0xb6,
-	0x75, 0x82, 0x79, 0xd0, 0x09, 0x34, 0x71, 0xe7, 0x26, 0xee, 0x16, 0x96, 0x26, 0xd3, 0x49, 0xba,
-	0x09, 0x2e, 0xa8, 0xdc, 0xf8, 0x34, 0x35, 0x71, 0xec, 0x60, 0x9f, 0x74, 0x0d, 0x17, 0x48, 0x48,
-	0x70, 0x33, 0xc1, 0x1d, 0x77, 0x48, 0xdc, 0xc3, 0x2f, 0xe0, 0x8a, 0x1f, 0xc0, 0x3f, 0xe0, 0xdf,
-	0xa0, 0xf3, 0xe1, 0x7c, 0x34, 0x19, 0xdb, 0x90, 0x0a, 0xdc, 0x44, 0x7e, 0x3f, 0x72, 0xce, 0xf3,
-	0x9e, 0xe7, 0xbc, 0x1f, 0x07, 0xe0, 0xc4, 0x27, 0xcf, 0xac, 0x61, 0x1c, 0xd1, 0x68, 0xe3, 0x52,
-	0x2f, 0x8a, 0x7a, 0x01, 0xb9, 0xcb, 0xa5, 0xc3, 0xd1, 0xd1, 0x5d, 0x37, 0x1c, 0x4b, 0xd3, 0xe6,
-	0x59, 0x13, 0xf5, 0x07, 0x24, 0xa1, 0xee, 0x60, 0x28, 0x1d, 0x4a, 0x83, 0xc8, 0x23, 0x81, 0x10,
-	0xcc, 0xe7, 0x59, 0xb8, 0x60, 0x7b, 0x5e, 0xe7, 0x38, 0x26, 0xae, 0x57, 0x8d, 0xc2, 0x23, 0xbf,
-	0x87, 0x74, 0xc8, 0xf6, 0xc9, 0xd8, 0x50, 0x2a, 0xca, 0x56, 0x11, 0xb3, 0x4f, 0x84, 0x40, 0x0d,
-	0xdd, 0x01, 0x31, 0x32, 0x5c, 0xc5, 0xbf, 0xd1, 0x5d, 0xc8, 0x25, 0xdd, 0x63, 0x32, 0x70, 0x8d,
-	0x6c, 0x45, 0xd9, 0x2a, 0x6d, 0xbf, 0x6d, 0x9d, 0x59, 0xc7, 0x6a, 0x73, 0x33, 0x96, 0x6e, 0xa8,
-	0x02, 0x2a, 0x1d, 0x0f, 0x89, 0xa1, 0x56, 0x94, 0xad, 0xb5, 0xed, 0xb2, 0x25, 0x7c, 0xad, 0xce,
-	0x78, 0x48, 0x30, 0xb7, 0xa0, 0xdb, 0x90, 0x4f, 0x8e, 0xdd, 0xd8, 0x0f, 0x7b, 0x86, 0xc6, 0x9d,
-	0x2e, 0xa4, 0x4e, 0x6d, 0xa1, 0xc6, 0xa9, 0x1d, 0x5d, 0x81, 0xe2, 0xb3, 0x63, 0x9f, 0x92, 0xc0,
-	0x4f, 0xa8, 0x91, 0xab, 0x64, 0xb7, 0x8a, 0x78, 0xaa, 0x40, 0xeb, 0xa0, 0x1d, 0x45, 0x71, 0x97,
-	0x18, 0xf9, 0x8a, 0xb2, 0x55, 0xc0, 0x42, 0xd8, 0xf8, 0x59, 0x81, 0x9c, 0xc0, 0x84, 0xd6, 0x20,
-	0xe3, 0x7b, 0x32, 0xc2, 0x8c, 0xef, 0xb1, 0x00, 0xbf, 0x48, 0xa2, 0x30, 0x0d, 0x90, 0x7d, 0xa3,
-	0x8f, 0x20, 0x37, 0x8c, 0x49, 0x42, 0x28, 0x0f, 0x70, 0x6d, 0xfb, 0xea, 0x0b, 0x02, 0xb4, 0x1e,
-	0x73, 0x2f, 0x2c, 0xbd, 0xcd, 0xfb, 0x90, 0x13, 0x1a, 0x54, 0x00, 0xb5, 0xd9, 0x6a, 0x3a, 0xfa,
-	0x0a, 0xfb, 0xda, 0x69, 0xb4, 0x76, 0x74, 0x05, 0x5d, 0x80, 0x52, 0xd5, 0xde, 0x73, 0xb0, 0x7d,
-	0x80, 0x5b, 0x8d, 0x86, 0x9e, 0x41, 0x45, 0xd0, 0xf6, 0x9c, 0x5a, 0xdd, 0xd6, 0xb3, 0xe6, 0x43,
-	0x28, 0xec, 0x04, 0x51, 0xb7, 0xff, 0xc4, 0xff, 0x8a, 0x21, 0xf2, 0x22, 0x9a, 0x48, 0x8c, 0xfc,
-	0x9b, 0x85, 0xd5, 0x8d, 0x46, 0x21, 0xe5, 0x30, 0x35, 0x2c, 0x04, 0x4e, 0x0e, 0x39, 0x15, 0x28,
-	0x19, 0x39, 0xe4, 0x94, 0x9a, 0x1f, 0x82, 0xda, 0xa6, 0x64, 0x38, 0x21, 0x4e, 0x99, 0x21, 0xee,
-	0x12, 0xa8, 0x81, 0x1f, 0xf6, 0xf9, 0x22, 0xa5, 0x6d, 0xcd, 0x6a, 0xf8, 0x61, 0x1f, 0x73, 0x95,
-	0xf9, 0x35, 0x14, 0x6b, 0x7e, 0x4c, 0xba, 0x34, 0x8a, 0xc7, 0xe8, 0x5d, 0xd0, 0x8e, 0xfc, 0x80,
-	0x30, 0x08, 0xd9, 0xad, 0xd2, 0xf6, 0x5b, 0xd6, 0xc4, 0x64, 0xed, 0x32, 0xbd, 0x13, 0xd2, 0x78,
-	0x8c, 0x85, 0xcf, 0x46, 0x0d, 0x60, 0xaa, 0x5c, 0x72, 0x83, 0x2a, 0xa0, 0x9d, 0xb8, 0xc1, 0x88,
-	0xc8, 0x5d, 0x81, 0x2f, 0x51, 0x0f, 0x3d, 0x72, 0x8a, 0x85, 0xe1, 0xe3, 0xcc, 0x7d, 0xc5, 0xfc,
-	0x00, 0x56, 0x27, 0x9b, 0x34, 0x18, 0x91, 0x15, 0xd0, 0x7c, 0x4a, 0x06, 0x29, 0x06, 0x98, 0x62,
-	0xc0, 0xc2, 0x60, 0x1e, 0x83, 0xfa, 0x88, 0x8c, 0x13, 0x74, 0x73, 0x1e, 0xad, 0x6e, 0x31, 0xed,
-	0x12, 0xa0, 0xf7, 0x5f, 0x02, 0x74, 0x7d, 0x16, 0x68, 0x71, 0x16, 0xdc, 0x37, 0x0a, 0x40, 0x3d,
-	0x3c, 0xf1, 0x29, 0x79, 0xe2, 0x93, 0x67, 0xcb, 0xae, 0xd0, 0x42, 0x8e, 0x6c, 0x42, 0xde, 0xe7,
-	0xff, 0x88, 0x65, 0x92, 0x68, 0xd6, 0x7e, 0x42, 0x62, 0x9c, 0x6a, 0x91, 0x05, 0xaa, 0xe7, 0x52,
-	0x91, 0x13, 0xa5, 0xed, 0x0d, 0x4b, 0xe4, 0xae, 0x95, 0xe6, 0xae, 0xd5, 0x49, 0x73, 0x17, 0x73,
-	0x3f, 0xf3, 0x1e, 0xac, 0x4d, 0x21, 0xf0, 0x13, 0xba, 0x36, 0x7f, 0x42, 0x25, 0x6b, 0x6a, 0x4f,
-	0x8f, 0xa8, 0x01, 0x6b, 0xce, 0x29, 0x25, 0x71, 0xe8, 0x06, 0xc2, 0xb8, 0x80, 0x5d, 0x1e, 0x43,
-	0x66, 0x7a, 0x0c, 0xc6, 0x3c, 0xf2, 0xe2, 0x04, 0xb2, 0xf9, 0x8b, 0x02, 0xa5, 0x5d, 0x42, 0x3c,
-	0x4c, 0xbe, 0x1c, 0x91, 0x84, 0xa2, 0x8b, 0x90, 0xa3, 0x3c, 0x29, 0xe4, 0x7a, 0x52, 0x62, 0xfa,
-	0xe8, 0xe8, 0x88, 0xa5, 0x8f, 0x58, 0x56, 0x4a, 0xec, 0x80, 0x03, 0x7f, 0xe0, 0x8b, 0xfb, 0xaa,
-	0x61, 0x21, 0xa0, 0x1b, 0xa0, 0xb2, 0xb2, 0x24, 0x8b, 0xc3, 0x1b, 0xd6, 0xcc, 0x0e, 0xd6, 0x5e,
-	0xe4, 0x11, 0xcc, 0xcd, 0xe6, 0x1d, 0x50, 0x99, 0x84, 0x00, 0x72, 0xd5, 0x87, 0xb8, 0xd5, 0x6c,
-	0xe9, 0x2b, 0x68, 0x15, 0x8a, 0x76, 0xb3, 0xd9, 0xea, 0xd8, 0x1d, 0xa7, 0xa6, 0x2b, 0xcc, 0xd4,
-	0xee, 0xd8, 0xd5, 0x47, 0x6d, 0x3d, 0x63, 0x1e, 0x43, 0x81, 0x2d, 0x54, 0xa7, 0x64, 0xc0, 0xf6,
-	0x3d, 0x64, 0xc9, 0x25, 0x61, 0x0a, 0x61, 0x06, 0x7d, 0x66, 0x0e, 0xbd, 0x05, 0xf9, 0xa1, 0x3b,
-	0x0e, 0x22, 0xd7, 0x93, 0xcc, 0xad, 0x2f, 0x70, 0x63, 0x87, 0x63, 0x9c, 0x3a, 0x99, 0x9f, 0x42,
-	0x39, 0xdd, 0x89, 0xd3, 0xb2, 0x39, 0x4f, 0x4b, 0xd1, 0x4a, 0xad, 0x92, 0x94, 0xd7, 0xc8, 0xe5,
-	0x1f, 0x14, 0xd0, 0xf6, 0x48, 0xdc, 0x23, 0x2f, 0x08, 0x21, 0xbd, 0x43, 0x99, 0x57, 0xbb, 0x43,
-	0x2c, 0xff, 0x47, 0xc9, 0xd9, 0x1b, 0xc9, 0x55, 0xe8, 0x3a, 0xe4, 0xa9, 0x1b, 0xf7, 0x08, 0x4d,
-	0x0c, 0xf5, 0x2c, 0xee, 0xd4, 0x62, 0x7e, 0xaf, 0x40, 0xae, 0xde, 0x0b, 0xa3, 0xf8, 0x5f, 0x00,
-	0x74, 0x0d, 0x72, 0x62, 0x5b, 0x99, 0x21, 0x33, 0x78, 0xa4, 0xc1, 0x7c, 0xae, 0x80, 0xba, 0x1b,
-	0xb8, 0xbd, 0xff, 0x05, 0x98, 0x6f, 0x15, 0x50, 0x3f, 0x89, 0xfc, 0xf0, 0xfc, 0xc1, 0x5c, 0x66,
-	0x69, 0xd4, 0x27, 0x29, 0x51, 0xac, 0x8c, 0xf7, 0x09, 0x16, 0x3a, 0xb3, 0x0f, 0x05, 0x3b, 0x0c,
-	0xa3, 0x51, 0xd8, 0x3d, 0x7f, 0x8e, 0xcc, 0xef, 0x14, 0xd0, 0x1a, 0xc4, 0x3d, 0x21, 0xff, 0x71,
-	0xd0, 0xbf, 0x2b, 0xa0, 0x76, 0xc8, 0x29, 0x3d, 0x7f, 0x18, 0x08, 0xd4, 0xc3, 0xc8, 0x1b, 0xf3,
-	0x6b, 0x50, 0xc4, 0xfc, 0x1b, 0xbd, 0x03, 0x85, 0x6e, 0x34, 0x18, 0x90, 0x90, 0x26, 0x86, 0xc6,
-	0xd1, 0x15, 0xac, 0xaa, 0x50, 0xe0, 0x89, 0x65, 0x1a, 0x40, 0x6e, 0x49, 0x00, 0xb7, 0xa0, 0xc0,
-	0xf0, 0xf3, 0xfa, 0x71, 0x79, 0xbe, 0x7e, 0x68, 0x16, 0xb3, 0xa4, 0x05, 0xfd, 0x57, 0x76, 0xe5,
-	0xfd, 0x80, 0x1f, 0xb8, 0xcf, 0x7a, 0x28, 0x8f, 0x54, 0xc3, 0x42, 0x40, 0x57, 0x41, 0x65, 0xbd,
-	0x6e, 0x49, 0xab, 0xe5, 0x7a, 0xd6, 0x2a, 0x59, 0xb7, 0x4f, 0x8c, 0xac, 0x6c, 0x95, 0xcc, 0x81,
-	0x8f, 0x01, 0x69, 0xab, 0xe4, 0x66, 0xd6, 0xd3, 0xa7, 0xca, 0x7f, 0xdc, 0xd3, 0x7f, 0xcc, 0x80,
-	0xc6, 0x3b, 0xee, 0xdf, 0x54, 0x60, 0x91, 0x55, 0x69, 0x05, 0xe6, 0xd2, 0x84, 0xaf, 0xec, 0x6b,
-	0xf2, 0xa5, 0x2e, 0xf2, 0x65, 0x40, 0xbe, 0xeb, 0x0e, 0xa9, 0x1f, 0x85, 0x7c, 0xae, 0x2c, 0xe2,
-	0x54, 0x64, 0xc7, 0x2c, 0xa6, 0x86, 0x94, 0x0f, 0x86, 0x54, 0x8e, 0x0a, 0x73, 0x94, 0xe6, 0x5f,
-	0x4e, 0x69, 0x61, 0x91, 0x52, 0xb6, 0xb3, 0x68, 0x28, 0x89, 0x51, 0xe4, 0x43, 0x6a, 0x2a, 0x9a,
-	0xb7, 0xa1, 0xc8, 0x4f, 0x85, 0xb3, 0x7d, 0x65, 0x9e, 0xed, 0x9c, 0x98, 0x5b, 0x52, 0xba, 0x7f,
-	0x52, 0x20, 0x2f, 0xf7, 0x5d, 0xe8, 0xdc, 0xe7, 0x7c, 0xab, 0xa7, 0x25, 0x4f, 0x7b, 0x51, 0xc9,
-	0xbb, 0x03, 0x25, 0x09, 0x8e, 0x87, 0x72, 0x75, 0x3e, 0x94, 0xe9, 0x89, 0xc9, 0x60, 0x58, 0x85,
-	0x64, 0x27, 0x74, 0x9e, 0x91, 0xbc, 0x42, 0xa1, 0xbe, 0x05, 0x05, 0x86, 0x62, 0x79, 0xae, 0x09,
-	0x06, 0x05, 0xde, 0xdf, 0x14, 0x28, 0x3f, 0x75, 0x83, 0x80, 0xd0, 0xfd, 0x21, 0xdf, 0xf7, 0xe5,
-	0xb3, 0xd3, 0x4d, 0xf9, 0xd0, 0x11, 0xcf, 0x06, 0x64, 0xcd, 0xfe, 0x7d, 0xe6, 0xb9, 0x63, 0x7e,
-	0x0e, 0x2a, 0x93, 0x90, 0x0e, 0xe5, 0xce, 0x43, 0xec, 0xd8, 0xb5, 0x03, 0xbb, 0x56, 0x73, 0x6a,
-	0xfa, 0x0a, 0x42, 0xb0, 0x26, 0x35, 0xd8, 0xd9, 0x6b, 0x3d, 0xe1, 0x73, 0xcd, 0x45, 0x40, 0x76,
-	0xb5, 0xda, 0xda, 0x6f, 0x76, 0x0e, 0x1e, 0x3b, 0x0e, 0x96, 0xbe, 0x19, 0x64, 0xc0, 0xfa, 0x9c,
-	0x3e, 0xfd, 0x47, 0xd6, 0xfc, 0x43, 0x81, 0x7c, 0x7b, 0x34, 0x18, 0xb8, 0xf1, 0x78, 0x01, 0xb5,
-	0x01, 0x79, 0xd7, 0xf3, 0x62, 0x92, 0x24, 0x12, 0x79, 0x2a, 0xa2, 0xf7, 0x00, 0xb9, 0x5d, 0x3e,
-	0x8d, 0x1c, 0x0c, 0x09, 0x89, 0x0f, 0xc4, 0x94, 0x22, 0x86, 0x35, 0x5d, 0x5a, 0x1e, 0x13, 0x12,
-	0x57, 0xf9, 0xc0, 0x72, 0x0d, 0xca, 0xe2, 0x46, 0x4b, 0x3f, 0x95, 0xfb, 0x95, 0xa8, 0x7c, 0x26,
-	0x31, 0x97, 0x4d, 0x28, 0xf1, 0x7c, 0x92, 0x1e, 0x1a, 0xf7, 0x00, 0xae, 0x12, 0x0e, 0xd7, 0x61,
-	0xb5, 0x1b, 0x85, 0xd4, 0xed, 0x52, 0xe9, 0x92, 0xe3, 0x2e, 0x65, 0xa9, 0xe4, 0x4e, 0xe6, 0x9f,
-	0x0a, 0x14, 0x1a, 0x51, 0xaf, 0x41, 0x4e, 0x48, 0x80, 0xde, 0x87, 0x7c, 0x32, 0x4e, 0x66, 0x38,
-	0xbb, 0x68, 0xa5, 0x36, 0xab, 0x2d, 0x0c, 0xa2, 0x92, 0xa5, 0x6e, 0x1b, 0x8f, 0xa0, 0x3c, 0x6b,
-	0x58, 0x52, 0xcd, 0x6e, 0xcc, 0x56, 0x33, 0xf6, 0xf4, 0x9c, 0xac, 0xc8, 0x7f, 0x67, 0x4b, 0x5a,
-	0x93, 0x35, 0x3c, 0x86, 0xa3, 0x0c, 0x85, 0x2a, 0xae, 0x77, 0xea, 0x55, 0xbb, 0xa1, 0xaf, 0xb0,
-	0x97, 0x9c, 0x83, 0x71, 0x0b, 0xeb, 0x0a, 0x2a, 0x41, 0xfe, 0xa9, 0x8d, 0x9b, 0xf5, 0xe6, 0x03,
-	0x3d, 0xc3, 0x26, 0xd2, 0x66, 0xab, 0x53, 0xaf, 0x3a, 0x7a, 0x96, 0x3d, 0x04, 0xeb, 0xcd, 0xdd,
-	0x96, 0xae, 0x32, 0xef, 0x9a, 0xb3, 0xb3, 0xff, 0x40, 0xd7, 0x76, 0xde, 0x84, 0x55, 0x3f, 0xb2,
-	0x28, 0x39, 0xa5, 0xac, 0x10, 0x0f, 0x0f, 0x3f, 0xcb, 0x0c, 0x0f, 0x0f, 0x73, 0xfc, 0xe6, 0xdf,
-	0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xfa, 0x1f, 0x76, 0xf7, 0x0f, 0x00, 0x00,
+func init() { proto.RegisterFile("view.proto", fileDescriptor_view_3bf94d3fdb9df680) }
+
+var fileDescriptor_view_3bf94d3fdb9df680 = []byte{
+	// 2338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5d, 0x6f, 0xdb, 0xd6,
+	0x19, 0x0e, 0x25, 0x92, 0xa2, 0x5e, 0xc9, 0x0e, 0x73, 0x9a, 0xa6, 0x8a, 0x5b, 0x34, 0x0e, 0xbb,
+	0x36, 0xee, 0xda, 0x32, 0xad, 0x83, 0x0d, 0xc1, 0xb0, 0x1b, 0x59, 0xa2, 0x13, 0x35, 0x32, 0x65,
+	0x1c, 0xc9, 0xce, 0xba, 0x01, 0x13, 0x68, 0xf1, 0xd8, 0xe6, 0x2c, 0x91, 0x1a, 0x79, 0xe4, 0x58,
+	0xbb, 0x18, 0x50, 0x60, 0xdb, 0x45, 0xb1, 0xdd, 0x0d, 0xbb, 0x18, 0xb0, 0x5d, 0xed, 0xaa, 0x3f,
+	0x60, 0xd8, 0x6f, 0xd8, 0x3f, 0xd8, 0x0f, 0xd8, 0xdd, 0x2e, 0x77, 0xbf, 0xe1, 0x7c, 0x49, 0x54,
+	0x24, 0xc7, 0xe9, 0x30, 0x63, 0xbb, 0x31, 0xf8, 0x7e, 0x1c, 0xf2, 0xfd, 0x78, 0xde, 0x0f, 0x1d,
+	0x03, 0x9c, 0x47, 0xe4, 0x85, 0x3b, 0x4e, 0x13, 0x9a, 0x6c, 0xdc, 0x3d, 0x49, 0x92, 0x93, 0x21,
+	0x79, 0xc8, 0xa9, 0xa3, 0xc9, 0xf1, 0xc3, 0x20, 0x9e, 0x4a, 0xd1, 0xbd, 0x97, 0x45, 0x34, 0x1a,
+	0x91, 0x8c, 0x06, 0xa3, 0xb1, 0x54, 0xa8, 0x8c, 0x92, 0x90, 0x0c, 0x05, 0xe1, 0x7c, 0x55, 0x84,
+	0x9b, 0xf5, 0x30, 0xec, 0x9d, 0xa6, 0x24, 0x08, 0x1b, 0x49, 0x7c, 0x1c, 0x9d, 0x20, 0x1b, 0x8a,
+	0x67, 0x64, 0x5a, 0xd3, 0x36, 0xb5, 0xad, 0x32, 0x66, 0x8f, 0x08, 0x81, 0x1e, 0x07, 0x23, 0x52,
+	0x2b, 0x70, 0x16, 0x7f, 0x46, 0x0f, 0xc1, 0xcc, 0x06, 0xa7, 0x64, 0x14, 0xd4, 0x8a, 0x9b, 0xda,
+	0x56, 0x65, 0xfb, 0x2d, 0xf7, 0xa5, 0xf7, 0xb8, 0x5d, 0x2e, 0xc6, 0x52, 0x0d, 0x6d, 0x82, 0x4e,
+	0xa7, 0x63, 0x52, 0xd3, 0x37, 0xb5, 0xad, 0xf5, 0xed, 0xaa, 0x2b, 0x74, 0xdd, 0xde, 0x74, 0x4c,
+	0x30, 0x97, 0xa0, 0x0f, 0xa1, 0x94, 0x9d, 0x06, 0x69, 0x14, 0x9f, 0xd4, 0x0c, 0xae, 0x74, 0x53,
+	0x29, 0x75, 0x05, 0x1b, 0x2b, 0x39, 0x7a, 0x07, 0xca, 0x2f, 0x4e, 0x23, 0x4a, 0x86, 0x51, 0x46,
+	0x6b, 0xe6, 0x66, 0x71, 0xab, 0x8c, 0xe7, 0x0c, 0x74, 0x1b, 0x8c, 0xe3, 0x24, 0x1d, 0x90, 0x5a,
+	0x69, 0x53, 0xdb, 0xb2, 0xb0, 0x20, 0x36, 0xfe, 0xa8, 0x81, 0x29, 0x6c, 0x42, 0xeb, 0x50, 0x88,
+	0x42, 0xe9, 0x61, 0x21, 0x0a, 0x99, 0x83, 0x3f, 0xc9, 0x92, 0x58, 0x39, 0xc8, 0x9e, 0xd1, 0x77,
+	0xc1, 0x1c, 0xa7, 0x24, 0x23, 0x94, 0x3b, 0xb8, 0xbe, 0xfd, 0xee, 0x25, 0x0e, 0xba, 0xfb, 0x5c,
+	0x0b, 0x4b, 0x6d, 0xe7, 0x31, 0x98, 0x82, 0x83, 0x2c, 0xd0, 0xfd, 0x8e, 0xef, 0xd9, 0x37, 0xd8,
+	0xd3, 0x4e, 0xbb, 0xb3, 0x63, 0x6b, 0xe8, 0x26, 0x54, 0x1a, 0xf5, 0x3d, 0x0f, 0xd7, 0xfb, 0xb8,
+	0xd3, 0x6e, 0xdb, 0x05, 0x54, 0x06, 0x63, 0xcf, 0x6b, 0xb6, 0xea, 0x76, 0xd1, 0x79, 0x0a, 0xd6,
+	0xce, 0x30, 0x19, 0x9c, 0x1d, 0x46, 0x3f, 0x63, 0x16, 0x85, 0x09, 0xcd, 0xa4, 0x8d, 0xfc, 0x99,
+	0xb9, 0x35, 0x48, 0x26, 0x31, 0xe5, 0x66, 0x1a, 0x58, 0x10, 0x3c, 0x39, 0xe4, 0x42, 0x58, 0xc9,
+	0x92, 0x43, 0x2e, 0xa8, 0xf3, 0x0f, 0x0d, 0x2a, 0xcf, 0x83, 0xe1, 0x59, 0x67, 0x4c, 0xa3, 0x24,
+	0xe6, 0x27, 0x33, 0x1a, 0xa4, 0x54, 0xbe, 0x4e, 0x10, 0x68, 0x0b, 0x8c, 0x24, 0x0d, 0x49, 0xca,
+	0xdf, 0xb7, 0xbe, 0x8d, 0xdc, 0xdc, 0x11, 0xb7, 0xc3, 0x24, 0x58, 0x28, 0xb0, 0xf3, 0x21, 0x19,
+	0xd3, 0x53, 0xfe, 0x11, 0x03, 0x0b, 0x02, 0x7d, 0x00, 0x06, 0xcb, 0x5b, 0x56, 0xd3, 0x37, 0x8b,
+	0x5b, 0xeb, 0xdb, 0xb6, 0xcb, 0xad, 0x17, 0x7f, 0x79, 0x5a, 0x85, 0x98, 0x9d, 0x1e, 0x46, 0xa3,
+	0x88, 0xf2, 0xac, 0x1a, 0x58, 0x10, 0x3c, 0x49, 0x84, 0x0e, 0x4e, 0x6b, 0xa6, 0x4c, 0x12, 0x23,
+	0x9c, 0x47, 0x60, 0xf0, 0x2f, 0xa3, 0xbb, 0xf0, 0x26, 0xf6, 0x0e, 0x3d, 0xdc, 0xf5, 0xfa, 0x8d,
+	0xa7, 0xb8, 0xe3, 0x77, 0xda, 0x9d, 0x27, 0xad, 0x46, 0xbd, 0x6d, 0xdf, 0x60, 0x31, 0xec, 0x75,
+	0xf6, 0x67, 0x0c, 0xcd, 0xf9, 0xb3, 0x06, 0x16, 0xb3, 0xbd, 0x4b, 0xc9, 0x78, 0x29, 0xb7, 0x5b,
+	0x60, 0x66, 0x34, 0xa0, 0x93, 0x4c, 0xba, 0x69, 0xbb, 0x4a, 0xd5, 0xed, 0x72, 0x3e, 0x96, 0xf2,
+	0x4b, 0xbc, 0x7c, 0x07, 0x8c, 0x23, 0xe6, 0x11, 0x07, 0x6e, 0x65, 0xdb, 0x14, 0xfe, 0x61, 0xc1,
+	0x74, 0xbe, 0x0f, 0xa6, 0x78, 0x0b, 0x32, 0xa1, 0xd0, 0x79, 0x66, 0xdf, 0x40, 0x55, 0xb0, 0xb0,
+	0xf7, 0xb9, 0xd7, 0xe8, 0x79, 0x4d, 0x5b, 0x43, 0x15, 0x28, 0xed, 0xb5, 0xba, 0xdd, 0x96, 0xff,
+	0xc4, 0x2e, 0x30, 0xc3, 0x0f, 0xfc, 0x5d, 0xaf, 0xd7, 0x78, 0x5a, 0xdf, 0x69, 0x7b, 0x76, 0xd1,
+	0x79, 0x08, 0x55, 0x65, 0x4c, 0x9b, 0x01, 0xf7, 0x1e, 0x18, 0x11, 0x25, 0x23, 0x96, 0xf6, 0xe2,
+	0x56, 0x65, 0xbb, 0x3c, 0x33, 0x15, 0x0b, 0xbe, 0xf3, 0x2b, 0x0d, 0x90, 0xc0, 0xe0, 0x21, 0x49,
+	0xa3, 0xe3, 0x29, 0x26, 0xe3, 0x24, 0xa5, 0xe8, 0x0e, 0x98, 0x94, 0x73, 0xa5, 0xdf, 0x92, 0x62,
+	0xd8, 0x38, 0x65, 0x5c, 0x89, 0x6b, 0xf6, 0x8c, 0x6a, 0x50, 0x1a, 0x9c, 0x92, 0xc1, 0x19, 0x09,
+	0xa5, 0x9f, 0x8a, 0x44, 0xdf, 0x06, 0x33, 0xca, 0xb2, 0x89, 0x4c, 0x68, 0x65, 0x1b, 0xb9, 0xf9,
+	0x4f, 0xb5, 0x98, 0x08, 0x4b, 0x0d, 0xe7, 0xeb, 0x02, 0xdc, 0x5a, 0x92, 0xb2, 0x08, 0x8a, 0x58,
+	0x49, 0x9c, 0x71, 0x02, 0x7d, 0x24, 0x2b, 0x5f, 0xc4, 0xff, 0xad, 0xe5, 0xb7, 0xe6, 0x9b, 0xc0,
+	0x1d, 0x30, 0x43, 0x42, 0x83, 0x68, 0x28, 0x01, 0x2d, 0x29, 0xb4, 0x01, 0x56, 0x4a, 0xc6, 0x41,
+	0x94, 0x92, 0x90, 0x67, 0xc2, 0xc2, 0x33, 0xda, 0xf9, 0x83, 0x06, 0x3a, 0x7b, 0x05, 0xba, 0x05,
+	0x6b, 0x32, 0xda, 0xfd, 0x9d, 0x76, 0xa7, 0xc1, 0xd2, 0x81, 0x60, 0xfd, 0xc0, 0xdf, 0x6f, 0xf9,
+	0xbe, 0xd7, 0x94, 0x3c, 0x8d, 0xa9, 0x1d, 0xf8, 0x4d, 0xaf, 0x81, 0xbf, 0xd8, 0xef, 0xf1, 0x4c,
+	0xf0, 0xd4, 0xb4, 0xfc, 0xc3, 0x7a, 0xbb, 0xd5, 0xec, 0x77, 0x5b, 0x4f, 0xec, 0x22, 0x7a, 0x03,
+	0x6e, 0x1e, 0xf8, 0x2d, 0xbf, 0xe9, 0xfd, 0x60, 0x76, 0x50, 0x47, 0x36, 0x54, 0xd5, 0xfb, 0x77,
+	0x5b, 0x6d, 0xcf, 0x36, 0xc4, 0xab, 0xe4, 0xeb, 0x39, 0xcb, 0x64, 0x4a, 0x1d, 0xbc, 0xff, 0xb4,
+	0xee, 0xf7, 0xf9, 0x71, 0xbb, 0xe4, 0x7c, 0x07, 0x74, 0x0e, 0x4d, 0xd5, 0x47, 0xb5, 0x5c, 0x1f,
+	0xbd, 0x0b, 0xfa, 0x30, 0x8a, 0xcf, 0x78, 0x70, 0x2a, 0xdb, 0x86, 0xdb, 0x8e, 0xe2, 0x33, 0xcc,
+	0x59, 0xce, 0xcf, 0xa1, 0xdc, 0x8c, 0x52, 0x32, 0xa0, 0x49, 0x3a, 0x45, 0x1f, 0x81, 0x71, 0x1c,
+	0x0d, 0x89, 0x82, 0xc6, 0x9b, 0xee, 0x4c, 0xe4, 0xee, 0x32, 0xbe, 0x17, 0xd3, 0x74, 0x8a, 0x85,
+	0xce, 0x46, 0x13, 0x60, 0xce, 0x5c, 0xd1, 0xd0, 0x37, 0xc1, 0x38, 0x0f, 0x86, 0x13, 0x22, 0xbf,
+	0x0a, 0xfc, 0x15, 0xad, 0x38, 0x24, 0x17, 0x58, 0x08, 0xbe, 0x57, 0x78, 0xac, 0x39, 0x9f, 0xc1,
+	0xda, 0xec, 0x23, 0x1c, 0x9e, 0x9b, 0x8b, 0xf0, 0x84, 0xb9, 0x0d, 0x0a, 0x9f, 0xa7, 0xa0, 0x3f,
+	0x23, 0xd3, 0x8c, 0xb5, 0x86, 0xbc, 0xb5, 0xb6, 0xcb, 0xb8, 0x2b, 0x0c, 0x7d, 0x7c, 0x85, 0xa1,
+	0xb7, 0xf3, 0x86, 0x96, 0xf3, 0xc6, 0xfd, 0x5e, 0x03, 0xc4, 0x2b, 0xb1, 0x4b, 0x82, 0x74, 0x70,
+	0xba, 0x1b, 0x0d, 0x29, 0x49, 0xb3, 0x4b, 0x2b, 0x61, 0xd6, 0xab, 0x0a, 0xaf, 0xee, 0x55, 0x77,
+	0xc0, 0x0c, 0x26, 0xf4, 0x34, 0x49, 0x15, 0xfc, 0x04, 0xc5, 0xf8, 0xc9, 0xf1, 0x31, 0x9b, 0x06,
+	0xba, 0xe0, 0x0b, 0x6a, 0x75, 0x6f, 0x73, 0xfa, 0x70, 0x2b, 0x67, 0x1b, 0x26, 0xd9, 0x64, 0x48,
+	0xe7, 0x8d, 0x44, 0x5b, 0xd1, 0x48, 0x18, 0x36, 0x58, 0x48, 0x54, 0xa9, 0xb2, 0x67, 0x56, 0xaa,
+	0x59, 0x1c, 0x8d, 0xc7, 0x44, 0x75, 0x77, 0x45, 0x3a, 0x07, 0xf0, 0xe6, 0xd2, 0x07, 0x78, 0x8a,
+	0xb6, 0x16, 0x53, 0x84, 0xdc, 0x25, 0x35, 0x99, 0xaa, 0xd9, 0xdc, 0x28, 0xe4, 0xe6, 0xc6, 0x97,
+	0x1a, 0x40, 0x2b, 0x3e, 0x8f, 0x28, 0x39, 0x8c, 0xc8, 0x8b, 0x55, 0x63, 0x72, 0x69, 0x0f, 0xb8,
+	0x07, 0xa5, 0x88, 0x9f, 0x48, 0xe5, 0x22, 0x60, 0xb8, 0x07, 0x19, 0x49, 0xb1, 0xe2, 0x22, 0x17,
+	0xf4, 0x30, 0xa0, 0x44, 0xb6, 0xcf, 0x0d, 0x57, 0xec, 0x27, 0xae, 0xda, 0x4f, 0xdc, 0x9e, 0xda,
+	0x4f, 0x30, 0xd7, 0x73, 0x1e, 0xc1, 0xfa, 0xdc, 0x04, 0xee, 0xd3, 0xfd, 0x45, 0x9f, 0x2a, 0xee,
+	0x5c, 0xae, 0x70, 0xd7, 0x86, 0x75, 0xef, 0x82, 0x92, 0x34, 0x0e, 0x86, 0x42, 0xb8, 0x64, 0xbb,
+	0xc4, 0x56, 0x61, 0x8e, 0xad, 0xda, 0xa2, 0xe5, 0xe5, 0x99, 0xc9, 0xce, 0xdf, 0x0b, 0x50, 0xd9,
+	0x25, 0x24, 0xc4, 0xe4, 0xa7, 0x13, 0x92, 0x5d, 0xde, 0x5e, 0xe7, 0xa0, 0x28, 0xac, 0x06, 0x45,
+	0x31, 0x3f, 0xf0, 0xde, 0x07, 0x9d, 0xad, 0x5e, 0x72, 0x01, 0xba, 0xe5, 0xe6, 0xbe, 0xe0, 0xee,
+	0x25, 0x21, 0xc1, 0x5c, 0x9c, 0x43, 0xa0, 0xb1, 0x80, 0xc0, 0x19, 0x82, 0xcd, 0x57, 0x23, 0xf8,
+	0x53, 0x30, 0x82, 0x63, 0xe6, 0x54, 0xe9, 0xca, 0x80, 0x0b, 0x45, 0xb4, 0x0d, 0xe6, 0x11, 0x39,
+	0x4e, 0x52, 0x52, 0xb3, 0xae, 0x3c, 0x22, 0x35, 0x99, 0x8b, 0x23, 0x12, 0x46, 0x41, 0xad, 0x2c,
+	0x0a, 0x93, 0x13, 0xce, 0x27, 0xa0, 0x33, 0x4f, 0x10, 0x80, 0x29, 0x86, 0xb6, 0x7d, 0x03, 0xad,
+	0x41, 0xb9, 0xee, 0xfb, 0x9d, 0x5e, 0x5d, 0x0c, 0x44, 0x00, 0xb3, 0xdb, 0xab, 0x37, 0x9e, 0x75,
+	0xed, 0x82, 0xf3, 0x3b, 0x0d, 0x2c, 0x16, 0x85, 0x16, 0x25, 0xa3, 0x4b, 0x66, 0xc7, 0x3c, 0xf4,
+	0x85, 0x85, 0xd0, 0xbb, 0x50, 0x1a, 0x07, 0xd3, 0x61, 0x12, 0x84, 0x12, 0x76, 0xb7, 0x97, 0x8c,
+	0xae, 0xc7, 0x53, 0xac, 0x94, 0xd0, 0xc7, 0x50, 0x4e, 0x49, 0x30, 0xe0, 0xbb, 0x8d, 0x1c, 0x6f,
+	0xeb, 0x2e, 0x96, 0x9c, 0x06, 0x5b, 0xa4, 0xf0, 0x5c, 0xc1, 0xf9, 0x02, 0xaa, 0xca, 0xae, 0xd5,
+	0x73, 0x59, 0x49, 0x55, 0x31, 0xbd, 0xfe, 0x6a, 0xf6, 0x1b, 0x0d, 0x8c, 0x3d, 0x92, 0x9e, 0x5c,
+	0x36, 0x2c, 0x55, 0xb9, 0x14, 0x5e, 0xaf, 0x5c, 0xd8, 0xfc, 0x98, 0x64, 0x2f, 0x17, 0x1f, 0x67,
+	0xa1, 0xf7, 0xa0, 0x44, 0x83, 0xf4, 0x84, 0x50, 0xe5, 0x71, 0xce, 0x6e, 0x25, 0x71, 0x7e, 0xad,
+	0x81, 0xd9, 0x3a, 0x89, 0x65, 0x4e, 0xaf, 0xd7, 0xa0, 0xfb, 0x60, 0x8a, 0xcf, 0xca, 0x66, 0x90,
+	0xb3, 0x47, 0x0a, 0x9c, 0xaf, 0x34, 0xd0, 0x77, 0x87, 0xc1, 0xc9, 0xff, 0x85, 0x31, 0xbf, 0xd0,
+	0x40, 0xff, 0x3c, 0x89, 0xe2, 0xeb, 0x37, 0xe6, 0x6d, 0xd6, 0x31, 0xce, 0x66, 0x9b, 0x17, 0x5b,
+	0x03, 0xce, 0x08, 0x16, 0x3c, 0xe7, 0x0c, 0xac, 0x7a, 0x1c, 0x27, 0x93, 0x78, 0x70, 0xfd, 0x39,
+	0x72, 0x7e, 0xa9, 0x81, 0xd1, 0x26, 0xc1, 0x39, 0xf9, 0x1f, 0x3b, 0xfd, 0x65, 0x11, 0xf4, 0x1e,
+	0xb9, 0xa0, 0xd7, 0x6f, 0x06, 0x02, 0xfd, 0x28, 0x09, 0xa7, 0x72, 0xb0, 0xf3, 0x67, 0xf4, 0x2d,
+	0xb0, 0x06, 0xc9, 0x68, 0x44, 0x62, 0x9a, 0xd5, 0x0c, 0x6e, 0x9d, 0xe5, 0x36, 0x04, 0x03, 0xcf,
+	0x24, 0x73, 0x07, 0xcc, 0x65, 0x07, 0xd0, 0x7d, 0xb0, 0x98, 0x16, 0x6f, 0x38, 0xa5, 0xcd, 0xe2,
+	0xfc, 0xab, 0x33, 0x36, 0x6b, 0x6e, 0x24, 0x8c, 0x28, 0x09, 0x79, 0xe3, 0xb5, 0xb0, 0xa4, 0xd0,
+	0x03, 0xd6, 0xac, 0xce, 0xa3, 0x8c, 0x9f, 0x2d, 0xcb, 0xd2, 0xc5, 0x92, 0x83, 0xe7, 0x32, 0x74,
+	0x97, 0x2f, 0xc5, 0xc3, 0x69, 0x9f, 0x26, 0x35, 0x10, 0x33, 0x8c, 0xd3, 0xbd, 0x24, 0x07, 0xef,
+	0xca, 0x25, 0xf0, 0x66, 0xa3, 0x9b, 0x69, 0x47, 0x24, 0xab, 0x55, 0xa5, 0x81, 0x2c, 0xe2, 0x58,
+	0x71, 0x9d, 0x07, 0x60, 0x31, 0x06, 0x6f, 0x81, 0x6f, 0x2f, 0xb6, 0x40, 0xa9, 0x2a, 0xc7, 0xef,
+	0xd7, 0xac, 0x6a, 0xd9, 0xc6, 0x72, 0x1b, 0x8c, 0x88, 0xad, 0x91, 0x3c, 0x59, 0x06, 0x16, 0x04,
+	0x7a, 0x37, 0xb7, 0xdb, 0x2c, 0x6e, 0x9b, 0x9c, 0xcf, 0x46, 0x1b, 0x5b, 0x78, 0xb3, 0x5a, 0x51,
+	0x6e, 0x8b, 0x4c, 0x81, 0x6f, 0xc2, 0x6a, 0x5b, 0xe4, 0x62, 0xb6, 0xd6, 0xce, 0x99, 0xff, 0xf1,
+	0x5a, 0xfb, 0xdb, 0x02, 0x18, 0x4c, 0x90, 0xbd, 0x62, 0xe4, 0x88, 0xc8, 0xa9, 0x91, 0xc3, 0xa9,
+	0x19, 0xe4, 0x8a, 0xdf, 0x10, 0x72, 0xfa, 0x32, 0xe4, 0xd8, 0x6f, 0xb0, 0x80, 0xff, 0xd0, 0x96,
+	0x43, 0x5e, 0x91, 0x2c, 0xcc, 0x62, 0x71, 0x56, 0x90, 0x62, 0x96, 0xca, 0x6d, 0x79, 0x01, 0x95,
+	0xa5, 0xab, 0x51, 0x69, 0xad, 0x40, 0x65, 0x0d, 0x4a, 0x62, 0x82, 0x0a, 0x60, 0x95, 0xb1, 0x22,
+	0x9d, 0x0f, 0xa1, 0xcc, 0xa3, 0xc2, 0xb3, 0xfd, 0xce, 0x62, 0xb6, 0x4d, 0xb1, 0xba, 0xab, 0x74,
+	0xff, 0x4b, 0x83, 0x92, 0xfc, 0xee, 0xd2, 0x9e, 0x75, 0xcd, 0x85, 0x39, 0x87, 0xb5, 0x71, 0x19,
+	0xac, 0xf3, 0x85, 0x67, 0x5e, 0x55, 0x78, 0xa5, 0xcb, 0x0b, 0xcf, 0xba, 0xbc, 0xf0, 0x9c, 0x4f,
+	0xa0, 0x22, 0x03, 0xc0, 0xc3, 0xf5, 0xee, 0x62, 0xb8, 0xe6, 0x59, 0x91, 0x01, 0x63, 0x83, 0x84,
+	0x65, 0xe1, 0x3a, 0xa3, 0xf5, 0x1a, 0xf3, 0xec, 0x01, 0x58, 0xcc, 0x8a, 0xd5, 0xf5, 0x2c, 0x50,
+	0x22, 0xec, 0xfd, 0x93, 0x06, 0x96, 0x5a, 0x8e, 0xae, 0xd3, 0x66, 0xfe, 0xa3, 0x5e, 0x7c, 0x46,
+	0x66, 0x79, 0x46, 0xbf, 0x46, 0xa6, 0xd9, 0xf5, 0x89, 0xb2, 0x72, 0xf5, 0x9a, 0xa6, 0xa4, 0xca,
+	0xaf, 0x1f, 0xc1, 0xda, 0xc2, 0xce, 0xb7, 0x60, 0x80, 0xf6, 0x92, 0x01, 0xab, 0x77, 0xba, 0x1a,
+	0x6b, 0x9a, 0xc1, 0x80, 0xca, 0xeb, 0x13, 0x0b, 0x2b, 0xd2, 0x09, 0x59, 0xcc, 0x04, 0x40, 0xfe,
+	0x4b, 0x43, 0x4b, 0x15, 0x40, 0x71, 0x5e, 0x00, 0xce, 0x5f, 0x34, 0x7e, 0x67, 0x34, 0x24, 0xf4,
+	0x60, 0xcc, 0x95, 0xae, 0xfe, 0xa1, 0xf3, 0x81, 0xbc, 0x7f, 0x29, 0xce, 0xaf, 0xf9, 0x66, 0xc7,
+	0x73, 0x57, 0x2f, 0xce, 0x8f, 0xe5, 0x2d, 0x8a, 0x0d, 0xd5, 0xde, 0x53, 0xec, 0xd5, 0x9b, 0xfd,
+	0x7a, 0xb3, 0xe9, 0x35, 0xc5, 0x25, 0x8a, 0xe4, 0x60, 0x6f, 0xaf, 0x73, 0xc8, 0x17, 0xf9, 0x3b,
+	0x80, 0xea, 0x8d, 0x46, 0xe7, 0xc0, 0xef, 0xf5, 0xf7, 0x3d, 0x0f, 0x4b, 0xdd, 0x02, 0xaa, 0xc1,
+	0xed, 0x05, 0xbe, 0x3a, 0x51, 0x74, 0xfe, 0x59, 0x00, 0xc3, 0x3b, 0x67, 0x4d, 0xe3, 0x9e, 0xb4,
+	0x48, 0xe3, 0x16, 0x55, 0x5c, 0xce, 0xcd, 0x99, 0xf2, 0x8d, 0x23, 0x35, 0xff, 0x99, 0x50, 0x7c,
+	0xe9, 0x67, 0xc2, 0x9a, 0x78, 0xea, 0x4f, 0xc6, 0xb9, 0x5f, 0xa1, 0x39, 0x2c, 0x55, 0x85, 0x5c,
+	0x06, 0x73, 0x1b, 0xd6, 0x5e, 0xf0, 0xe8, 0x28, 0x7d, 0x81, 0xbd, 0xb5, 0x85, 0x98, 0xe1, 0xea,
+	0x8b, 0x1c, 0x85, 0x3e, 0x83, 0x6a, 0x9c, 0xd0, 0xe8, 0x38, 0x1a, 0x04, 0x1c, 0x47, 0xa6, 0x3c,
+	0xe2, 0xe7, 0x98, 0x78, 0x41, 0xc5, 0x39, 0x93, 0x91, 0xb6, 0x40, 0xdf, 0x67, 0x57, 0x83, 0x37,
+	0xd8, 0x3d, 0x92, 0x8c, 0xf0, 0xc1, 0x7e, 0xb3, 0xde, 0xf3, 0xc4, 0x2d, 0xd5, 0xf3, 0x7a, 0xbb,
+	0xed, 0xf5, 0x14, 0xab, 0xc0, 0x32, 0xe3, 0x77, 0x7a, 0xad, 0xdd, 0x56, 0xa3, 0xde, 0x6b, 0x75,
+	0x7c, 0xbb, 0xc8, 0xee, 0xad, 0xfc, 0x4e, 0xd3, 0xeb, 0x77, 0xfc, 0x76, 0xcb, 0xf7, 0xc4, 0x15,
+	0x95, 0x60, 0xec, 0xee, 0x72, 0x8e, 0xe1, 0xfc, 0x55, 0x83, 0x52, 0x77, 0x32, 0x1a, 0x05, 0xe9,
+	0x74, 0x09, 0x2c, 0x35, 0x28, 0x05, 0x61, 0x98, 0x92, 0x2c, 0x93, 0x80, 0x51, 0x24, 0xfa, 0x18,
+	0x50, 0x30, 0xe0, 0x90, 0xef, 0x8f, 0x09, 0x49, 0xfb, 0xfc, 0x51, 0xfe, 0xa0, 0xb5, 0xa5, 0x64,
+	0x9f, 0x90, 0x54, 0xd4, 0xd1, 0x7d, 0x90, 0x71, 0x94, 0x7a, 0x3a, 0xd7, 0xab, 0x50, 0x79, 0x5d,
+	0x3e, 0xe1, 0x39, 0xaf, 0xf0, 0x29, 0x26, 0x35, 0xc4, 0x7d, 0x09, 0x70, 0x96, 0x50, 0x78, 0x0f,
+	0xd6, 0x06, 0x49, 0x4c, 0x83, 0x01, 0x95, 0x2a, 0x26, 0x57, 0xa9, 0x4a, 0x26, 0x57, 0x72, 0xfe,
+	0xa6, 0x81, 0xd5, 0x4e, 0x4e, 0xda, 0xe4, 0x9c, 0x0c, 0xd1, 0xa7, 0x50, 0xca, 0xa6, 0x59, 0xae,
+	0xe2, 0xef, 0xb8, 0x4a, 0xe6, 0x76, 0x85, 0x40, 0xec, 0x0f, 0x4a, 0x6d, 0xe3, 0x19, 0x54, 0xf3,
+	0x82, 0x15, 0x3b, 0xc4, 0xfb, 0xf9, 0x1d, 0x82, 0xfd, 0x0b, 0x62, 0xf6, 0x46, 0xfe, 0x37, 0xbf,
+	0x48, 0xf8, 0x60, 0x08, 0x3b, 0xaa, 0x60, 0x35, 0x70, 0xab, 0x27, 0xaf, 0xa7, 0xcb, 0x60, 0x78,
+	0x18, 0x77, 0xb0, 0xb8, 0xfd, 0x7d, 0x5e, 0xc7, 0xbe, 0xb8, 0xfd, 0x05, 0x30, 0x59, 0xf2, 0x1a,
+	0x9e, 0x5d, 0x64, 0x89, 0x6f, 0xf9, 0xbb, 0x1d, 0x5b, 0x67, 0xda, 0x4d, 0x6f, 0xe7, 0xe0, 0x89,
+	0x6d, 0xec, 0xbc, 0x01, 0x6b, 0x51, 0xe2, 0x52, 0x72, 0x41, 0xd9, 0xfa, 0x33, 0x3e, 0xfa, 0x61,
+	0x61, 0x7c, 0x74, 0x64, 0x72, 0xcc, 0x3f, 0xfa, 0xf7, 0x00, 0x15, 0x34, 0x39, 0x37, 0xff, 0x19,
+	0x00, 0x00,
 }
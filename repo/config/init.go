@@ -63,7 +63,8 @@ type Logs struct {
 
 // Thread settings
 type Threads struct {
-	Defaults ThreadDefaults // default settings
+	Defaults         ThreadDefaults // default settings
+	StreamEncryption bool           // when true, files are encrypted in chunks with bounded memory, which older peers can't decrypt
 }
 
 // ThreadDefaults settings
@@ -77,12 +78,31 @@ type Cafe struct {
 	Client CafeClient
 }
 
-// TODO: add some more knobs: max num. clients, max client msg age, inbox size, etc.
+// TODO: add some more knobs: max num. clients, etc.
 type CafeHost struct {
-	Open        bool   // When true, other peers can register with this node for cafe services.
-	URL         string // Override the resolved URL of this cafe, useful for load HTTPS and/or load balancers
-	NeighborURL string // Specifies the URL of a secondary cafe. Must return cafe info.
-	SizeLimit   int64  // Maximum file size limit to accept for POST requests in bytes.
+	Open             bool                 // When true, other peers can register with this node for cafe services.
+	URL              string               // Override the resolved URL of this cafe, useful for load HTTPS and/or load balancers
+	NeighborURL      string               // Specifies the URL of a secondary cafe. Must return cafe info.
+	SizeLimit        int64                // Maximum file size limit to accept for POST requests in bytes.
+	Quota            CafeQuota            // Default quota of each registered client.
+	TokenQuotas      map[string]CafeQuota // Quotas overriding the default for clients registered with a token, keyed by token or token ID.
+	AdminToken       string               // Bearer token required by the cafe admin API, which is disabled when empty.
+	Retention        CafeRetention        // How long data is kept for inactive clients.
+	Replicas         []string             // Peer IDs of cafes that client data is replicated to, and accepted from.
+	WebhookAllowlist []string             // Hosts, IPs, or CIDR blocks webhooks may be posted to even if loopback, private, or link-local.
+}
+
+// CafeRetention limits how long a cafe keeps client data, as durations (e.g., "720h"),
+// empty values keep data forever
+type CafeRetention struct {
+	ClientMaxAge  string // Max time a client can go unseen before it's expired and its data is reclaimed.
+	MessageMaxAge string // Max time a message can wait in a client's inbox before it's deleted.
+}
+
+// CafeQuota limits what a cafe will store for a client, zero values are unlimited
+type CafeQuota struct {
+	StorageLimit int64 // Maximum bytes pinned for a client, including thread snapshots.
+	InboxLimit   int   // Maximum number of messages waiting in a client's inbox.
 }
 
 // CafeClient settings
@@ -176,13 +196,25 @@ func Init() (*Config, error) {
 			Defaults: ThreadDefaults{
 				ID: "",
 			},
+			StreamEncryption: false,
 		},
 		Cafe: Cafe{
 			Host: CafeHost{
-				Open:        false,
-				URL:         "",
-				NeighborURL: "",
-				SizeLimit:   0,
+				Open:             false,
+				URL:              "",
+				NeighborURL:      "",
+				SizeLimit:        0,
+				AdminToken:       "",
+				Replicas:         []string{},
+				WebhookAllowlist: []string{},
+				Retention: CafeRetention{
+					ClientMaxAge:  "",
+					MessageMaxAge: "",
+				},
+				Quota: CafeQuota{
+					StorageLimit: 0,
+					InboxLimit:   0,
+				},
 			},
 			Client: CafeClient{
 				Mobile: MobileCafeClient{
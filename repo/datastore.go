@@ -16,8 +16,14 @@ type Datastore interface {
 	ThreadPeers() ThreadPeerStore
 	Blocks() BlockStore
 	BlockMessages() BlockMessageStore
+	RejectedBlocks() RejectedBlockStore
+	ThreadKeys() ThreadKeyStore
+	SearchIndex() SearchIndexStore
+	ThreadAdverts() ThreadAdvertStore
 	Invites() InviteStore
 	Notifications() NotificationStore
+	NotificationSettings() NotificationSettingsStore
+	Changes() ChangeStore
 	CafeSessions() CafeSessionStore
 	CafeRequests() CafeRequestStore
 	CafeMessages() CafeMessageStore
@@ -26,6 +32,9 @@ type Datastore interface {
 	CafeTokens() CafeTokenStore
 	CafeClientThreads() CafeClientThreadStore
 	CafeClientMessages() CafeClientMessageStore
+	CafeClientPins() CafeClientPinStore
+	CafeClientBans() CafeClientBanStore
+	CafeClientTombstones() CafeClientTombstoneStore
 	Ping() error
 	Close()
 }
@@ -119,6 +128,40 @@ type BlockMessageStore interface {
 	Delete(id string) error
 }
 
+type RejectedBlockStore interface {
+	Queryable
+	Add(block *pb.RejectedBlock) error
+	Get(id string) *pb.RejectedBlock
+	ListByThread(threadId string) *pb.RejectedBlockList
+	Delete(id string) error
+	DeleteByThread(threadId string) error
+}
+
+type ThreadKeyStore interface {
+	Queryable
+	Add(key *pb.ThreadKey) error
+	Get(id string) *pb.ThreadKey
+	GetLatest(threadId string) *pb.ThreadKey
+	ListByThread(threadId string) *pb.ThreadKeyList
+	DeleteByThread(threadId string) error
+}
+
+type SearchIndexStore interface {
+	Queryable
+	Index(id string, threadId string, text string) error
+	SearchBlocks(match string, offset string, limit int, query string, args ...interface{}) ([]*pb.BlockSearchResult, error)
+	Delete(id string) error
+	DeleteByThread(threadId string) error
+}
+
+type ThreadAdvertStore interface {
+	Queryable
+	AddOrUpdate(advert *pb.ThreadAdvert) error
+	Get(id string) *pb.ThreadAdvert
+	List() *pb.ThreadAdvertList
+	Delete(id string) error
+}
+
 type InviteStore interface {
 	Queryable
 	Add(invite *pb.Invite) error
@@ -141,6 +184,21 @@ type NotificationStore interface {
 	DeleteByBlock(blockId string) error
 }
 
+type NotificationSettingsStore interface {
+	Queryable
+	AddOrUpdate(settings *pb.NotificationSettings) error
+	Get(thread string) *pb.NotificationSettings
+	List() *pb.NotificationSettingsList
+	Delete(thread string) error
+}
+
+type ChangeStore interface {
+	Queryable
+	Add(change *pb.Change) error
+	List(since int64, limit int) *pb.ChangeList
+	Latest() int64
+}
+
 // Cafe user-side stores
 
 type CafeSessionStore interface {
@@ -187,12 +245,15 @@ type CafeClientStore interface {
 	List() []pb.CafeClient
 	ListByAddress(address string) []pb.CafeClient
 	UpdateLastSeen(id string, date time.Time) error
+	SetWebhook(id string, url string) error
 	Delete(id string) error
 }
 
 type CafeClientThreadStore interface {
 	AddOrUpdate(thrd *pb.CafeClientThread) error
+	Get(id string, clientId string) *pb.CafeClientThread
 	ListByClient(clientId string) []pb.CafeClientThread
+	SizeByClient(clientId string) int64
 	Delete(id string, clientId string) error
 	DeleteByClient(clientId string) error
 }
@@ -203,6 +264,35 @@ type CafeClientMessageStore interface {
 	CountByClient(clientId string) int
 	Delete(id string, clientId string) error
 	DeleteByClient(clientId string, limit int) error
+	DeleteByClientBefore(clientId string, date time.Time) error
+	DeleteBefore(date time.Time) (int, error)
+}
+
+type CafeClientPinStore interface {
+	AddOrUpdate(pin *pb.CafeClientPin) error
+	Get(cid string, clientId string) *pb.CafeClientPin
+	ListByClient(clientId string) []pb.CafeClientPin
+	SizeByClient(clientId string) int64
+	CountByCid(cid string) int
+	Delete(cid string, clientId string) error
+	DeleteByClient(clientId string) error
+}
+
+type CafeClientBanStore interface {
+	Add(ban *pb.CafeClientBan) error
+	Get(id string) *pb.CafeClientBan
+	List() []pb.CafeClientBan
+	ListByAddress(address string) []pb.CafeClientBan
+	Delete(id string) error
+}
+
+type CafeClientTombstoneStore interface {
+	AddOrUpdate(tombstone *pb.CafeClientTombstone) error
+	Get(id string, clientId string, ttype pb.CafeClientTombstone_Type) *pb.CafeClientTombstone
+	ListByClient(clientId string) []pb.CafeClientTombstone
+	ListByType(ttype pb.CafeClientTombstone_Type) []pb.CafeClientTombstone
+	DeleteByClient(clientId string) error
+	DeleteBefore(date time.Time) (int, error)
 }
 
 type CafeTokenStore interface {
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type CafeClientBanDB struct {
+	modelStore
+}
+
+func NewCafeClientBanStore(db *sql.DB, lock *sync.Mutex) repo.CafeClientBanStore {
+	return &CafeClientBanDB{modelStore{db, lock}}
+}
+
+func (c *CafeClientBanDB) Add(ban *pb.CafeClientBan) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into cafe_client_bans(id, address, date) values(?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		ban.Id,
+		ban.Address,
+		util.ProtoNanos(ban.Date),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *CafeClientBanDB) Get(id string) *pb.CafeClientBan {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from cafe_client_bans where id='" + id + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
+func (c *CafeClientBanDB) List() []pb.CafeClientBan {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_bans order by date desc;"
+	return c.handleQuery(stm)
+}
+
+func (c *CafeClientBanDB) ListByAddress(address string) []pb.CafeClientBan {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_bans where address='" + address + "' order by date desc;"
+	return c.handleQuery(stm)
+}
+
+func (c *CafeClientBanDB) Delete(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_bans where id=?", id)
+	return err
+}
+
+func (c *CafeClientBanDB) handleQuery(stm string) []pb.CafeClientBan {
+	var list []pb.CafeClientBan
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var id, address string
+		var dateInt int64
+		if err := rows.Scan(&id, &address, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, pb.CafeClientBan{
+			Id:      id,
+			Address: address,
+			Date:    util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
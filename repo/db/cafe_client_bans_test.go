@@ -0,0 +1,70 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeClientBanStore repo.CafeClientBanStore
+
+func init() {
+	setupCafeClientBanDB()
+}
+
+func setupCafeClientBanDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	cafeClientBanStore = NewCafeClientBanStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientBanDB_Add(t *testing.T) {
+	for _, ban := range []*pb.CafeClientBan{
+		{Id: "client", Address: "address", Date: ptypes.TimestampNow()},
+		{Id: "client", Address: "address", Date: ptypes.TimestampNow()},
+		{Id: "client2", Address: "address", Date: ptypes.TimestampNow()},
+		{Id: "client3", Date: ptypes.TimestampNow()},
+	} {
+		if err := cafeClientBanStore.Add(ban); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	if len(cafeClientBanStore.List()) != 3 {
+		t.Error("list bad result")
+	}
+}
+
+func TestCafeClientBanDB_Get(t *testing.T) {
+	ban := cafeClientBanStore.Get("client")
+	if ban == nil {
+		t.Error("could not get ban")
+		return
+	}
+	if ban.Address != "address" {
+		t.Error("get bad result")
+	}
+	if cafeClientBanStore.Get("unknown") != nil {
+		t.Error("get unknown should be nil")
+	}
+}
+
+func TestCafeClientBanDB_ListByAddress(t *testing.T) {
+	if len(cafeClientBanStore.ListByAddress("address")) != 2 {
+		t.Error("list by address bad result")
+	}
+}
+
+func TestCafeClientBanDB_Delete(t *testing.T) {
+	if err := cafeClientBanStore.Delete("client"); err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientBanStore.Get("client") != nil {
+		t.Error("delete failed")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/repo"
@@ -81,6 +82,29 @@ func (c *CafeClientMessagesDB) DeleteByClient(clientId string, limit int) error
 	return err
 }
 
+// DeleteBefore deletes all messages received before date, returning the number deleted
+func (c *CafeClientMessagesDB) DeleteBefore(date time.Time) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res, err := c.db.Exec("delete from cafe_client_messages where date<?", date.UnixNano())
+	if err != nil {
+		return 0, err
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// DeleteByClientBefore deletes a client's messages received before date
+func (c *CafeClientMessagesDB) DeleteByClientBefore(clientId string, date time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_messages where clientId=? and date<?", clientId, date.UnixNano())
+	return err
+}
+
 func (c *CafeClientMessagesDB) handleQuery(stm string) []pb.CafeClientMessage {
 	var list []pb.CafeClientMessage
 	rows, err := c.db.Query(stm)
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+var cafeClientMessageStore repo.CafeClientMessageStore
+
+func init() {
+	setupCafeClientMessageDB()
+}
+
+func setupCafeClientMessageDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	cafeClientMessageStore = NewCafeClientMessageStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientMessagesDB_AddOrUpdate(t *testing.T) {
+	now := time.Now()
+	for id, date := range map[string]time.Time{
+		"msg1": now.Add(-time.Hour * 2),
+		"msg2": now.Add(-time.Hour),
+		"msg3": now,
+	} {
+		if err := cafeClientMessageStore.AddOrUpdate(&pb.CafeClientMessage{
+			Id:     id,
+			Peer:   "peer",
+			Client: "client",
+			Date:   util.ProtoTs(date.UnixNano()),
+		}); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	if count := cafeClientMessageStore.CountByClient("client"); count != 3 {
+		t.Errorf("count by client bad result: %d", count)
+	}
+}
+
+func TestCafeClientMessagesDB_DeleteByClientBefore(t *testing.T) {
+	if err := cafeClientMessageStore.AddOrUpdate(&pb.CafeClientMessage{
+		Id:     "msg4",
+		Peer:   "peer",
+		Client: "client2",
+		Date:   util.ProtoTs(time.Now().Add(-time.Hour * 3).UnixNano()),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := cafeClientMessageStore.DeleteByClientBefore("client2", time.Now()); err != nil {
+		t.Error(err)
+		return
+	}
+	if count := cafeClientMessageStore.CountByClient("client2"); count != 0 {
+		t.Errorf("count after delete bad result: %d", count)
+	}
+	if count := cafeClientMessageStore.CountByClient("client"); count != 3 {
+		t.Error("delete by client before should not affect other clients")
+	}
+}
+
+func TestCafeClientMessagesDB_DeleteBefore(t *testing.T) {
+	deleted, err := cafeClientMessageStore.DeleteBefore(time.Now().Add(-time.Minute * 30))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if deleted != 2 {
+		t.Errorf("delete before bad result: %d", deleted)
+	}
+	if count := cafeClientMessageStore.CountByClient("client"); count != 1 {
+		t.Errorf("count after delete bad result: %d", count)
+	}
+}
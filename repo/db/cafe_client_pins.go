@@ -0,0 +1,121 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type CafeClientPinDB struct {
+	modelStore
+}
+
+func NewCafeClientPinStore(db *sql.DB, lock *sync.Mutex) repo.CafeClientPinStore {
+	return &CafeClientPinDB{modelStore{db, lock}}
+}
+
+func (c *CafeClientPinDB) AddOrUpdate(pin *pb.CafeClientPin) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into cafe_client_pins(cid, clientId, size, date) values(?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		pin.Cid,
+		pin.Client,
+		pin.Size,
+		util.ProtoNanos(pin.Date),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *CafeClientPinDB) Get(cid string, clientId string) *pb.CafeClientPin {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from cafe_client_pins where cid='" + cid + "' and clientId='" + clientId + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
+func (c *CafeClientPinDB) ListByClient(clientId string) []pb.CafeClientPin {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_pins where clientId='" + clientId + "' order by date desc;"
+	return c.handleQuery(stm)
+}
+
+// SizeByClient returns the total size of a client's pins in bytes
+func (c *CafeClientPinDB) SizeByClient(clientId string) int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select coalesce(sum(size), 0) from cafe_client_pins where clientId=?", clientId)
+	var size int64
+	row.Scan(&size)
+	return size
+}
+
+// CountByCid returns the number of clients a cid is pinned for
+func (c *CafeClientPinDB) CountByCid(cid string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select Count(*) from cafe_client_pins where cid=?", cid)
+	var count int
+	row.Scan(&count)
+	return count
+}
+
+func (c *CafeClientPinDB) Delete(cid string, clientId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_pins where cid=? and clientId=?", cid, clientId)
+	return err
+}
+
+func (c *CafeClientPinDB) DeleteByClient(clientId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_pins where clientId=?", clientId)
+	return err
+}
+
+func (c *CafeClientPinDB) handleQuery(stm string) []pb.CafeClientPin {
+	var list []pb.CafeClientPin
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var cid, clientId string
+		var size, dateInt int64
+		if err := rows.Scan(&cid, &clientId, &size, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, pb.CafeClientPin{
+			Cid:    cid,
+			Client: clientId,
+			Size:   size,
+			Date:   util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
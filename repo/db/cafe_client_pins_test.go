@@ -0,0 +1,95 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeClientPinStore repo.CafeClientPinStore
+
+func init() {
+	setupCafeClientPinDB()
+}
+
+func setupCafeClientPinDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	cafeClientPinStore = NewCafeClientPinStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientPinDB_AddOrUpdate(t *testing.T) {
+	for _, pin := range []*pb.CafeClientPin{
+		{Cid: "cid1", Client: "client", Size: 100, Date: ptypes.TimestampNow()},
+		{Cid: "cid2", Client: "client", Size: 200, Date: ptypes.TimestampNow()},
+		{Cid: "cid2", Client: "client", Size: 250, Date: ptypes.TimestampNow()},
+		{Cid: "cid1", Client: "client2", Size: 100, Date: ptypes.TimestampNow()},
+	} {
+		if err := cafeClientPinStore.AddOrUpdate(pin); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	if len(cafeClientPinStore.ListByClient("client")) != 2 {
+		t.Error("list by client bad result")
+	}
+}
+
+func TestCafeClientPinDB_Get(t *testing.T) {
+	pin := cafeClientPinStore.Get("cid2", "client")
+	if pin == nil {
+		t.Error("failed to get pin")
+		return
+	}
+	if pin.Size != 250 {
+		t.Errorf("get bad size: %d", pin.Size)
+	}
+	if cafeClientPinStore.Get("cid2", "client2") != nil {
+		t.Error("get should match client")
+	}
+}
+
+func TestCafeClientPinDB_SizeByClient(t *testing.T) {
+	if size := cafeClientPinStore.SizeByClient("client"); size != 350 {
+		t.Errorf("size by client bad result: %d", size)
+	}
+	if size := cafeClientPinStore.SizeByClient("unknown"); size != 0 {
+		t.Errorf("size of unknown client should be zero: %d", size)
+	}
+}
+
+func TestCafeClientPinDB_CountByCid(t *testing.T) {
+	if count := cafeClientPinStore.CountByCid("cid1"); count != 2 {
+		t.Errorf("count by cid bad result: %d", count)
+	}
+	if count := cafeClientPinStore.CountByCid("cid2"); count != 1 {
+		t.Errorf("count by cid bad result: %d", count)
+	}
+}
+
+func TestCafeClientPinDB_Delete(t *testing.T) {
+	if err := cafeClientPinStore.Delete("cid1", "client"); err != nil {
+		t.Error(err)
+		return
+	}
+	if size := cafeClientPinStore.SizeByClient("client"); size != 250 {
+		t.Errorf("size after delete bad result: %d", size)
+	}
+	if size := cafeClientPinStore.SizeByClient("client2"); size != 100 {
+		t.Error("delete should not affect other clients")
+	}
+}
+
+func TestCafeClientPinDB_DeleteByClient(t *testing.T) {
+	if err := cafeClientPinStore.DeleteByClient("client"); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(cafeClientPinStore.ListByClient("client")) != 0 {
+		t.Error("delete by client failed")
+	}
+}
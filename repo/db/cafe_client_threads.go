@@ -6,6 +6,7 @@ import (
 
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
 )
 
 type CafeClientThreadDB struct {
@@ -23,7 +24,7 @@ func (c *CafeClientThreadDB) AddOrUpdate(thrd *pb.CafeClientThread) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert or replace into cafe_client_threads(id, clientId, ciphertext) values(?,?,?)`
+	stm := `insert or replace into cafe_client_threads(id, clientId, ciphertext, date) values(?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -34,6 +35,7 @@ func (c *CafeClientThreadDB) AddOrUpdate(thrd *pb.CafeClientThread) error {
 		thrd.Id,
 		thrd.Client,
 		thrd.Ciphertext,
+		util.ProtoNanos(thrd.Date),
 	)
 	if err != nil {
 		tx.Rollback()
@@ -43,6 +45,16 @@ func (c *CafeClientThreadDB) AddOrUpdate(thrd *pb.CafeClientThread) error {
 	return nil
 }
 
+func (c *CafeClientThreadDB) Get(id string, clientId string) *pb.CafeClientThread {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from cafe_client_threads where id='" + id + "' and clientId='" + clientId + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
 func (c *CafeClientThreadDB) ListByClient(clientId string) []pb.CafeClientThread {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -50,6 +62,16 @@ func (c *CafeClientThreadDB) ListByClient(clientId string) []pb.CafeClientThread
 	return c.handleQuery(stm)
 }
 
+// SizeByClient returns the total ciphertext size of a client's threads in bytes
+func (c *CafeClientThreadDB) SizeByClient(clientId string) int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select coalesce(sum(length(ciphertext)), 0) from cafe_client_threads where clientId=?", clientId)
+	var size int64
+	row.Scan(&size)
+	return size
+}
+
 func (c *CafeClientThreadDB) Delete(id string, clientId string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -74,7 +96,8 @@ func (c *CafeClientThreadDB) handleQuery(stm string) []pb.CafeClientThread {
 	for rows.Next() {
 		var id, clientId string
 		var ciphertext []byte
-		if err := rows.Scan(&id, &clientId, &ciphertext); err != nil {
+		var dateInt int64
+		if err := rows.Scan(&id, &clientId, &ciphertext, &dateInt); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
@@ -82,6 +105,7 @@ func (c *CafeClientThreadDB) handleQuery(stm string) []pb.CafeClientThread {
 			Id:         id,
 			Client:     clientId,
 			Ciphertext: ciphertext,
+			Date:       util.ProtoTs(dateInt),
 		})
 	}
 	return list
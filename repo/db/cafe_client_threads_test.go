@@ -0,0 +1,69 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeClientThreadStore repo.CafeClientThreadStore
+
+func init() {
+	setupCafeClientThreadDB()
+}
+
+func setupCafeClientThreadDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	cafeClientThreadStore = NewCafeClientThreadStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientThreadDB_AddOrUpdate(t *testing.T) {
+	for _, thrd := range []*pb.CafeClientThread{
+		{Id: "thread", Client: "client", Ciphertext: []byte("old"), Date: ptypes.TimestampNow()},
+		{Id: "thread", Client: "client", Ciphertext: []byte("new"), Date: ptypes.TimestampNow()},
+		{Id: "thread", Client: "client2", Ciphertext: []byte("other"), Date: ptypes.TimestampNow()},
+	} {
+		if err := cafeClientThreadStore.AddOrUpdate(thrd); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	if len(cafeClientThreadStore.ListByClient("client")) != 1 {
+		t.Error("list by client bad result")
+	}
+}
+
+func TestCafeClientThreadDB_Get(t *testing.T) {
+	thrd := cafeClientThreadStore.Get("thread", "client")
+	if thrd == nil {
+		t.Error("failed to get thread")
+		return
+	}
+	if string(thrd.Ciphertext) != "new" {
+		t.Error("get bad ciphertext")
+	}
+	if thrd.Date == nil || thrd.Date.Seconds == 0 {
+		t.Error("get bad date")
+	}
+	if cafeClientThreadStore.Get("thread", "client3") != nil {
+		t.Error("get should match client")
+	}
+}
+
+func TestCafeClientThreadDB_Delete(t *testing.T) {
+	if err := cafeClientThreadStore.Delete("thread", "client"); err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientThreadStore.Get("thread", "client") != nil {
+		t.Error("delete failed")
+	}
+	if cafeClientThreadStore.Get("thread", "client2") == nil {
+		t.Error("delete should not affect other clients")
+	}
+}
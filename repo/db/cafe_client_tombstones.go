@@ -0,0 +1,124 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type CafeClientTombstoneDB struct {
+	modelStore
+}
+
+func NewCafeClientTombstoneStore(db *sql.DB, lock *sync.Mutex) repo.CafeClientTombstoneStore {
+	return &CafeClientTombstoneDB{modelStore{db, lock}}
+}
+
+func (c *CafeClientTombstoneDB) AddOrUpdate(tombstone *pb.CafeClientTombstone) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into cafe_client_tombstones(id, clientId, type, date) values(?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		tombstone.Id,
+		tombstone.Client,
+		int32(tombstone.Type),
+		util.ProtoNanos(tombstone.Date),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *CafeClientTombstoneDB) Get(id string, clientId string, ttype pb.CafeClientTombstone_Type) *pb.CafeClientTombstone {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_tombstones where id='" + id + "' and clientId='" + clientId +
+		"' and type=" + strconv.Itoa(int(ttype)) + ";"
+	res := c.handleQuery(stm)
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
+func (c *CafeClientTombstoneDB) ListByClient(clientId string) []pb.CafeClientTombstone {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_tombstones where clientId='" + clientId + "' order by date desc;"
+	return c.handleQuery(stm)
+}
+
+func (c *CafeClientTombstoneDB) ListByType(ttype pb.CafeClientTombstone_Type) []pb.CafeClientTombstone {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_tombstones where type=" + strconv.Itoa(int(ttype)) + " order by date desc;"
+	return c.handleQuery(stm)
+}
+
+func (c *CafeClientTombstoneDB) DeleteByClient(clientId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_tombstones where clientId=?", clientId)
+	return err
+}
+
+// DeleteBefore deletes pin, thread, and client tombstones dated before date, returning
+// the number deleted. Message tombstones are kept until their client is deleted, since
+// their date is that of the newest removed message.
+func (c *CafeClientTombstoneDB) DeleteBefore(date time.Time) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res, err := c.db.Exec("delete from cafe_client_tombstones where type!=? and date<?",
+		int32(pb.CafeClientTombstone_MESSAGES), date.UnixNano())
+	if err != nil {
+		return 0, err
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (c *CafeClientTombstoneDB) handleQuery(stm string) []pb.CafeClientTombstone {
+	var list []pb.CafeClientTombstone
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var id, clientId string
+		var typeInt int
+		var dateInt int64
+		if err := rows.Scan(&id, &clientId, &typeInt, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, pb.CafeClientTombstone{
+			Id:     id,
+			Client: clientId,
+			Type:   pb.CafeClientTombstone_Type(typeInt),
+			Date:   util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
@@ -0,0 +1,85 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+var cafeClientTombstoneStore repo.CafeClientTombstoneStore
+
+func init() {
+	setupCafeClientTombstoneDB()
+}
+
+func setupCafeClientTombstoneDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	cafeClientTombstoneStore = NewCafeClientTombstoneStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientTombstoneDB_AddOrUpdate(t *testing.T) {
+	old := util.ProtoTs(time.Now().Add(-time.Hour).UnixNano())
+	for _, ts := range []*pb.CafeClientTombstone{
+		{Id: "cid", Client: "client", Type: pb.CafeClientTombstone_PIN, Date: old},
+		{Id: "cid", Client: "client", Type: pb.CafeClientTombstone_PIN, Date: ptypes.TimestampNow()},
+		{Id: "thread", Client: "client", Type: pb.CafeClientTombstone_THREAD, Date: old},
+		{Id: "client", Client: "client", Type: pb.CafeClientTombstone_MESSAGES, Date: old},
+		{Id: "client2", Client: "client2", Type: pb.CafeClientTombstone_CLIENT, Date: old},
+	} {
+		if err := cafeClientTombstoneStore.AddOrUpdate(ts); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	if len(cafeClientTombstoneStore.ListByClient("client")) != 3 {
+		t.Error("list by client bad result")
+	}
+	if len(cafeClientTombstoneStore.ListByType(pb.CafeClientTombstone_CLIENT)) != 1 {
+		t.Error("list by type bad result")
+	}
+}
+
+func TestCafeClientTombstoneDB_Get(t *testing.T) {
+	ts := cafeClientTombstoneStore.Get("cid", "client", pb.CafeClientTombstone_PIN)
+	if ts == nil {
+		t.Error("failed to get tombstone")
+		return
+	}
+	if util.ProtoTsIsNewer(util.ProtoTs(time.Now().Add(-time.Minute).UnixNano()), ts.Date) {
+		t.Error("tombstone should have been updated")
+	}
+	if cafeClientTombstoneStore.Get("cid", "client", pb.CafeClientTombstone_THREAD) != nil {
+		t.Error("get should match type")
+	}
+}
+
+func TestCafeClientTombstoneDB_DeleteBefore(t *testing.T) {
+	count, err := cafeClientTombstoneStore.DeleteBefore(time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if count != 2 {
+		t.Errorf("delete before bad count: %d", count)
+	}
+	if cafeClientTombstoneStore.Get("client", "client", pb.CafeClientTombstone_MESSAGES) == nil {
+		t.Error("message tombstones should be kept")
+	}
+}
+
+func TestCafeClientTombstoneDB_DeleteByClient(t *testing.T) {
+	if err := cafeClientTombstoneStore.DeleteByClient("client"); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(cafeClientTombstoneStore.ListByClient("client")) != 0 {
+		t.Error("delete by client failed")
+	}
+}
@@ -25,7 +25,7 @@ func (c *CafeClientDB) Add(client *pb.CafeClient) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into cafe_clients(id, address, created, lastSeen, tokenId) values(?,?,?,?,?)`
+	stm := `insert into cafe_clients(id, address, created, lastSeen, tokenId, webhook) values(?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -38,6 +38,7 @@ func (c *CafeClientDB) Add(client *pb.CafeClient) error {
 		util.ProtoNanos(client.Created),
 		util.ProtoNanos(client.Seen),
 		client.Token,
+		client.Webhook,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -87,6 +88,13 @@ func (c *CafeClientDB) UpdateLastSeen(id string, date time.Time) error {
 	return err
 }
 
+func (c *CafeClientDB) SetWebhook(id string, url string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_clients set webhook=? where id=?", url, id)
+	return err
+}
+
 func (c *CafeClientDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -102,9 +110,9 @@ func (c *CafeClientDB) handleQuery(stm string) []pb.CafeClient {
 		return nil
 	}
 	for rows.Next() {
-		var id, address, tokenId string
+		var id, address, tokenId, webhook string
 		var createdInt, lastSeenInt int64
-		if err := rows.Scan(&id, &address, &createdInt, &lastSeenInt, &tokenId); err != nil {
+		if err := rows.Scan(&id, &address, &createdInt, &lastSeenInt, &tokenId, &webhook); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
@@ -114,6 +122,7 @@ func (c *CafeClientDB) handleQuery(stm string) []pb.CafeClient {
 			Created: util.ProtoTs(createdInt),
 			Seen:    util.ProtoTs(lastSeenInt),
 			Token:   tokenId,
+			Webhook: webhook,
 		})
 	}
 	return list
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeClientStore repo.CafeClientStore
+
+func init() {
+	setupCafeClientDB()
+}
+
+func setupCafeClientDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	cafeClientStore = NewCafeClientStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientDB_Add(t *testing.T) {
+	err := cafeClientStore.Add(&pb.CafeClient{
+		Id:      "client",
+		Address: "address",
+		Created: ptypes.TimestampNow(),
+		Seen:    ptypes.TimestampNow(),
+		Token:   "token",
+		Webhook: "https://example.com/hook",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	client := cafeClientStore.Get("client")
+	if client == nil {
+		t.Error("could not get client")
+		return
+	}
+	if client.Webhook != "https://example.com/hook" {
+		t.Error("webhook bad result")
+	}
+}
+
+func TestCafeClientDB_SetWebhook(t *testing.T) {
+	if err := cafeClientStore.SetWebhook("client", ""); err != nil {
+		t.Error(err)
+		return
+	}
+	client := cafeClientStore.Get("client")
+	if client == nil || client.Webhook != "" {
+		t.Error("set webhook failed")
+	}
+}
+
+func TestCafeClientDB_Delete(t *testing.T) {
+	if err := cafeClientStore.Delete("client"); err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientStore.Get("client") != nil {
+		t.Error("delete failed")
+	}
+}
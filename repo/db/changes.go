@@ -0,0 +1,98 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type ChangeDB struct {
+	modelStore
+}
+
+func NewChangeStore(db *sql.DB, lock *sync.Mutex) repo.ChangeStore {
+	return &ChangeDB{modelStore{db, lock}}
+}
+
+// Add appends a change to the log, setting its sequence number
+func (c *ChangeDB) Add(change *pb.Change) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert into changes(type, id, threadId, date) values(?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	res, err := stmt.Exec(
+		int32(change.Type),
+		change.Id,
+		change.Thread,
+		util.ProtoNanos(change.Date),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	seq, err := res.LastInsertId()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	change.Seq = seq
+	return nil
+}
+
+// List returns changes after the given sequence number, oldest first
+func (c *ChangeDB) List(since int64, limit int) *pb.ChangeList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from changes where seq>" + strconv.FormatInt(since, 10) + " order by seq asc limit " + strconv.Itoa(limit) + ";"
+	return c.handleQuery(stm)
+}
+
+// Latest returns the sequence number of the newest change
+func (c *ChangeDB) Latest() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	row := c.db.QueryRow("select coalesce(max(seq), 0) from changes;")
+	var seq int64
+	row.Scan(&seq)
+	return seq
+}
+
+func (c *ChangeDB) handleQuery(stm string) *pb.ChangeList {
+	list := &pb.ChangeList{Items: make([]*pb.Change, 0)}
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var seq, dateInt int64
+		var typeInt int
+		var id, threadId string
+		if err := rows.Scan(&seq, &typeInt, &id, &threadId, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list.Items = append(list.Items, &pb.Change{
+			Seq:    seq,
+			Type:   pb.Change_Type(typeInt),
+			Id:     id,
+			Thread: threadId,
+			Date:   util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var changeStore repo.ChangeStore
+
+func init() {
+	setupChangeDB()
+}
+
+func setupChangeDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	changeStore = NewChangeStore(conn, new(sync.Mutex))
+}
+
+func TestChangeDB_Latest(t *testing.T) {
+	if changeStore.Latest() != 0 {
+		t.Error("empty change log should have a zero sequence number")
+	}
+}
+
+func TestChangeDB_Add(t *testing.T) {
+	for _, id := range []string{"abcde", "fghij", "klmno"} {
+		change := &pb.Change{
+			Type:   pb.Change_BLOCK_ADDED,
+			Id:     id,
+			Thread: "thread_id",
+			Date:   ptypes.TimestampNow(),
+		}
+		if err := changeStore.Add(change); err != nil {
+			t.Error(err)
+			return
+		}
+		if change.Seq == 0 {
+			t.Error("change sequence number not set")
+		}
+	}
+	if changeStore.Latest() != 3 {
+		t.Errorf("expected latest sequence number 3, got %d", changeStore.Latest())
+	}
+}
+
+func TestChangeDB_List(t *testing.T) {
+	all := changeStore.List(0, -1)
+	if len(all.Items) != 3 {
+		t.Error("returned incorrect number of changes")
+		return
+	}
+	if all.Items[0].Id != "abcde" || all.Items[2].Seq != 3 {
+		t.Error("changes should be listed by ascending sequence number")
+	}
+
+	since := changeStore.List(all.Items[0].Seq, 1)
+	if len(since.Items) != 1 || since.Items[0].Id != "fghij" {
+		t.Error("changes since a sequence number bad result")
+	}
+}
@@ -23,25 +23,34 @@ var pbUnmarshaler = jsonpb.Unmarshaler{
 }
 
 type SQLiteDatastore struct {
-	config             repo.ConfigStore
-	peers              repo.PeerStore
-	files              repo.FileStore
-	threads            repo.ThreadStore
-	threadPeers        repo.ThreadPeerStore
-	blocks             repo.BlockStore
-	blockMessages      repo.BlockMessageStore
-	invites            repo.InviteStore
-	notifications      repo.NotificationStore
-	cafeSessions       repo.CafeSessionStore
-	cafeRequests       repo.CafeRequestStore
-	cafeMessages       repo.CafeMessageStore
-	cafeClientNonces   repo.CafeClientNonceStore
-	cafeClients        repo.CafeClientStore
-	cafeTokens         repo.CafeTokenStore
-	cafeClientThreads  repo.CafeClientThreadStore
-	cafeClientMessages repo.CafeClientMessageStore
-	db                 *sql.DB
-	lock               *sync.Mutex
+	config               repo.ConfigStore
+	peers                repo.PeerStore
+	files                repo.FileStore
+	threads              repo.ThreadStore
+	threadPeers          repo.ThreadPeerStore
+	blocks               repo.BlockStore
+	blockMessages        repo.BlockMessageStore
+	rejectedBlocks       repo.RejectedBlockStore
+	threadKeys           repo.ThreadKeyStore
+	searchIndex          repo.SearchIndexStore
+	threadAdverts        repo.ThreadAdvertStore
+	invites              repo.InviteStore
+	notifications        repo.NotificationStore
+	notificationPrefs    repo.NotificationSettingsStore
+	changes              repo.ChangeStore
+	cafeSessions         repo.CafeSessionStore
+	cafeRequests         repo.CafeRequestStore
+	cafeMessages         repo.CafeMessageStore
+	cafeClientNonces     repo.CafeClientNonceStore
+	cafeClients          repo.CafeClientStore
+	cafeTokens           repo.CafeTokenStore
+	cafeClientThreads    repo.CafeClientThreadStore
+	cafeClientMessages   repo.CafeClientMessageStore
+	cafeClientPins       repo.CafeClientPinStore
+	cafeClientBans       repo.CafeClientBanStore
+	cafeClientTombstones repo.CafeClientTombstoneStore
+	db                   *sql.DB
+	lock                 *sync.Mutex
 }
 
 func Create(repoPath, pin string) (*SQLiteDatastore, error) {
@@ -60,25 +69,34 @@ func Create(repoPath, pin string) (*SQLiteDatastore, error) {
 	}
 	mux := new(sync.Mutex)
 	return &SQLiteDatastore{
-		config:             NewConfigStore(conn, mux, dbPath),
-		peers:              NewPeerStore(conn, mux),
-		files:              NewFileStore(conn, mux),
-		threads:            NewThreadStore(conn, mux),
-		threadPeers:        NewThreadPeerStore(conn, mux),
-		blocks:             NewBlockStore(conn, mux),
-		blockMessages:      NewBlockMessageStore(conn, mux),
-		invites:            NewInviteStore(conn, mux),
-		notifications:      NewNotificationStore(conn, mux),
-		cafeSessions:       NewCafeSessionStore(conn, mux),
-		cafeRequests:       NewCafeRequestStore(conn, mux),
-		cafeMessages:       NewCafeMessageStore(conn, mux),
-		cafeClientNonces:   NewCafeClientNonceStore(conn, mux),
-		cafeClients:        NewCafeClientStore(conn, mux),
-		cafeTokens:         NewCafeTokenStore(conn, mux),
-		cafeClientThreads:  NewCafeClientThreadStore(conn, mux),
-		cafeClientMessages: NewCafeClientMessageStore(conn, mux),
-		db:                 conn,
-		lock:               mux,
+		config:               NewConfigStore(conn, mux, dbPath),
+		peers:                NewPeerStore(conn, mux),
+		files:                NewFileStore(conn, mux),
+		threads:              NewThreadStore(conn, mux),
+		threadPeers:          NewThreadPeerStore(conn, mux),
+		blocks:               NewBlockStore(conn, mux),
+		blockMessages:        NewBlockMessageStore(conn, mux),
+		rejectedBlocks:       NewRejectedBlockStore(conn, mux),
+		threadKeys:           NewThreadKeyStore(conn, mux),
+		searchIndex:          NewSearchIndexStore(conn, mux),
+		threadAdverts:        NewThreadAdvertStore(conn, mux),
+		invites:              NewInviteStore(conn, mux),
+		notifications:        NewNotificationStore(conn, mux),
+		notificationPrefs:    NewNotificationSettingsStore(conn, mux),
+		changes:              NewChangeStore(conn, mux),
+		cafeSessions:         NewCafeSessionStore(conn, mux),
+		cafeRequests:         NewCafeRequestStore(conn, mux),
+		cafeMessages:         NewCafeMessageStore(conn, mux),
+		cafeClientNonces:     NewCafeClientNonceStore(conn, mux),
+		cafeClients:          NewCafeClientStore(conn, mux),
+		cafeTokens:           NewCafeTokenStore(conn, mux),
+		cafeClientThreads:    NewCafeClientThreadStore(conn, mux),
+		cafeClientMessages:   NewCafeClientMessageStore(conn, mux),
+		cafeClientPins:       NewCafeClientPinStore(conn, mux),
+		cafeClientBans:       NewCafeClientBanStore(conn, mux),
+		cafeClientTombstones: NewCafeClientTombstoneStore(conn, mux),
+		db:                   conn,
+		lock:                 mux,
 	}, nil
 }
 
@@ -118,6 +136,22 @@ func (d *SQLiteDatastore) BlockMessages() repo.BlockMessageStore {
 	return d.blockMessages
 }
 
+func (d *SQLiteDatastore) RejectedBlocks() repo.RejectedBlockStore {
+	return d.rejectedBlocks
+}
+
+func (d *SQLiteDatastore) ThreadKeys() repo.ThreadKeyStore {
+	return d.threadKeys
+}
+
+func (d *SQLiteDatastore) SearchIndex() repo.SearchIndexStore {
+	return d.searchIndex
+}
+
+func (d *SQLiteDatastore) ThreadAdverts() repo.ThreadAdvertStore {
+	return d.threadAdverts
+}
+
 func (d *SQLiteDatastore) Invites() repo.InviteStore {
 	return d.invites
 }
@@ -126,6 +160,14 @@ func (d *SQLiteDatastore) Notifications() repo.NotificationStore {
 	return d.notifications
 }
 
+func (d *SQLiteDatastore) NotificationSettings() repo.NotificationSettingsStore {
+	return d.notificationPrefs
+}
+
+func (d *SQLiteDatastore) Changes() repo.ChangeStore {
+	return d.changes
+}
+
 func (d *SQLiteDatastore) CafeSessions() repo.CafeSessionStore {
 	return d.cafeSessions
 }
@@ -158,11 +200,24 @@ func (d *SQLiteDatastore) CafeClientMessages() repo.CafeClientMessageStore {
 	return d.cafeClientMessages
 }
 
+func (d *SQLiteDatastore) CafeClientPins() repo.CafeClientPinStore {
+	return d.cafeClientPins
+}
+
+func (d *SQLiteDatastore) CafeClientBans() repo.CafeClientBanStore {
+	return d.cafeClientBans
+}
+
+func (d *SQLiteDatastore) CafeClientTombstones() repo.CafeClientTombstoneStore {
+	return d.cafeClientTombstones
+}
+
 func (d *SQLiteDatastore) Copy(dbPath string, pin string) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 	var cp string
-	stmt := "select name from sqlite_master where type='table'"
+	// full-text shadow tables are filled by copying their virtual table
+	stmt := "select name from sqlite_master where type='table' and name not like 'search_index_%'"
 	rows, err := d.db.Query(stmt)
 	if err != nil {
 		log.Errorf("error in copy: %s", err)
@@ -236,6 +291,16 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create table block_messages (id text primary key not null, peerId text not null, envelope blob not null, date integer not null);
     create index block_message_date on block_messages (date);
 
+    create table rejected_blocks (id text primary key not null, threadId text not null, authorId text not null, address text not null, type integer not null, date integer not null, reason text not null);
+    create index rejected_block_threadId on rejected_blocks (threadId);
+
+    create table thread_keys (id text primary key not null, threadId text not null, epoch integer not null, sk blob not null, date integer not null);
+    create index thread_key_threadId on thread_keys (threadId);
+
+    create virtual table search_index using fts4(id, threadId, text, notindexed=id, notindexed=threadId);
+
+    create table thread_adverts (id text primary key not null, invite text not null, inviteKey text not null, date integer not null);
+
     create table invites (id text primary key not null, block blob not null, name text not null, inviter blob not null, date integer not null);
     create index invite_date on invites (date);
 
@@ -246,6 +311,10 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index notification_blockId on notifications (blockId);
     create index notification_read on notifications (read);
 
+    create table notification_settings (threadId text primary key not null, muted integer not null, mentionsOnly integer not null, types text not null, updated integer not null);
+
+    create table changes (seq integer primary key not null, type integer not null, id text not null, threadId text not null, date integer not null);
+
     create table cafe_sessions (cafeId text primary key not null, access text not null, refresh text not null, expiry integer not null, cafe blob not null);
 
     create table cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, type integer not null, date integer not null, size integer not null, groupId text not null, status integer not null);
@@ -258,11 +327,11 @@ func initDatabaseTables(db *sql.DB, pin string) error {
 
     create table cafe_client_nonces (value text primary key not null, address text not null, date integer not null);
 
-    create table cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null);
+    create table cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null, webhook text not null default '');
     create index cafe_client_address on cafe_clients (address);
     create index cafe_client_lastSeen on cafe_clients (lastSeen);
 
-    create table cafe_client_threads (id text not null, clientId text not null, ciphertext blob not null, primary key (id, clientId));
+    create table cafe_client_threads (id text not null, clientId text not null, ciphertext blob not null, date integer not null default 0, primary key (id, clientId));
     create index cafe_client_thread_clientId on cafe_client_threads (clientId);
 
     create table cafe_client_messages (id text not null, peerId text not null, clientId text not null, date integer not null, primary key (id, clientId));
@@ -270,6 +339,16 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index cafe_client_message_date on cafe_client_messages (date);
 
     create table cafe_tokens (id text primary key not null, token text not null, date integer not null);
+
+    create table cafe_client_pins (cid text not null, clientId text not null, size integer not null, date integer not null, primary key (cid, clientId));
+    create index cafe_client_pin_clientId on cafe_client_pins (clientId);
+
+    create table cafe_client_bans (id text primary key not null, address text not null, date integer not null);
+    create index cafe_client_ban_address on cafe_client_bans (address);
+
+    create table cafe_client_tombstones (id text not null, clientId text not null, type integer not null, date integer not null, primary key (id, clientId, type));
+    create index cafe_client_tombstone_clientId on cafe_client_tombstones (clientId);
+    create index cafe_client_tombstone_date on cafe_client_tombstones (date);
     `
 	if _, err := db.Exec(sqlStmt); err != nil {
 		return err
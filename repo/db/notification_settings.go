@@ -0,0 +1,122 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type NotificationSettingsDB struct {
+	modelStore
+}
+
+func NewNotificationSettingsStore(db *sql.DB, lock *sync.Mutex) repo.NotificationSettingsStore {
+	return &NotificationSettingsDB{modelStore{db, lock}}
+}
+
+func (c *NotificationSettingsDB) AddOrUpdate(settings *pb.NotificationSettings) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into notification_settings(threadId, muted, mentionsOnly, types, updated) values(?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+
+	types := make([]string, len(settings.Types))
+	for i, t := range settings.Types {
+		types[i] = strconv.Itoa(int(t))
+	}
+	var muted, mentionsOnly int
+	if settings.Muted {
+		muted = 1
+	}
+	if settings.MentionsOnly {
+		mentionsOnly = 1
+	}
+	_, err = stmt.Exec(
+		settings.Thread,
+		muted,
+		mentionsOnly,
+		strings.Join(types, ","),
+		util.ProtoNanos(settings.Updated),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *NotificationSettingsDB) Get(thread string) *pb.NotificationSettings {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from notification_settings where threadId='" + thread + "';")
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+// List returns all settings, account-wide settings first
+func (c *NotificationSettingsDB) List() *pb.NotificationSettingsList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from notification_settings order by threadId asc;")
+}
+
+func (c *NotificationSettingsDB) Delete(thread string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from notification_settings where threadId=?", thread)
+	return err
+}
+
+func (c *NotificationSettingsDB) handleQuery(stm string) *pb.NotificationSettingsList {
+	list := &pb.NotificationSettingsList{Items: make([]*pb.NotificationSettings, 0)}
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var threadId, types string
+		var mutedInt, mentionsOnlyInt int
+		var updatedInt int64
+		if err := rows.Scan(&threadId, &mutedInt, &mentionsOnlyInt, &types, &updatedInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+
+		var ntypes []pb.Notification_Type
+		for _, t := range util.SplitString(types, ",") {
+			i, err := strconv.Atoi(t)
+			if err != nil {
+				log.Errorf("error parsing notification type: %s", err)
+				continue
+			}
+			ntypes = append(ntypes, pb.Notification_Type(i))
+		}
+
+		list.Items = append(list.Items, &pb.NotificationSettings{
+			Thread:       threadId,
+			Muted:        mutedInt == 1,
+			MentionsOnly: mentionsOnlyInt == 1,
+			Types:        ntypes,
+			Updated:      util.ProtoTs(updatedInt),
+		})
+	}
+	return list
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var notificationSettingsStore repo.NotificationSettingsStore
+
+func init() {
+	setupNotificationSettingsDB()
+}
+
+func setupNotificationSettingsDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	notificationSettingsStore = NewNotificationSettingsStore(conn, new(sync.Mutex))
+}
+
+func TestNotificationSettingsDB_AddOrUpdate(t *testing.T) {
+	if err := notificationSettingsStore.AddOrUpdate(&pb.NotificationSettings{
+		Thread:  "thread_id",
+		Muted:   true,
+		Updated: ptypes.TimestampNow(),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := notificationSettingsStore.AddOrUpdate(&pb.NotificationSettings{
+		Thread:       "thread_id",
+		MentionsOnly: true,
+		Types:        []pb.Notification_Type{pb.Notification_MESSAGE_ADDED, pb.Notification_COMMENT_ADDED},
+		Updated:      ptypes.TimestampNow(),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := notificationSettingsStore.AddOrUpdate(&pb.NotificationSettings{
+		Updated: ptypes.TimestampNow(),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	stmt, err := notificationSettingsStore.PrepareQuery("select count(*) from notification_settings")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+
+	var count int
+	if err := stmt.QueryRow().Scan(&count); err != nil {
+		t.Error(err)
+		return
+	}
+	if count != 2 {
+		t.Errorf("expected 2 rows, got %d", count)
+	}
+}
+
+func TestNotificationSettingsDB_Get(t *testing.T) {
+	settings := notificationSettingsStore.Get("thread_id")
+	if settings == nil {
+		t.Error("could not get notification settings")
+		return
+	}
+	if settings.Muted || !settings.MentionsOnly {
+		t.Error("notification settings were not updated")
+	}
+	if len(settings.Types) != 2 || settings.Types[1] != pb.Notification_COMMENT_ADDED {
+		t.Error("notification settings have bad types")
+	}
+	if notificationSettingsStore.Get("missing") != nil {
+		t.Error("expected nil for missing settings")
+	}
+}
+
+func TestNotificationSettingsDB_List(t *testing.T) {
+	list := notificationSettingsStore.List()
+	if len(list.Items) != 2 {
+		t.Error("returned incorrect number of settings")
+		return
+	}
+	if list.Items[0].Thread != "" {
+		t.Error("account-wide settings should be listed first")
+	}
+	if len(list.Items[0].Types) != 0 {
+		t.Error("empty types should remain empty")
+	}
+}
+
+func TestNotificationSettingsDB_Delete(t *testing.T) {
+	if err := notificationSettingsStore.Delete("thread_id"); err != nil {
+		t.Error(err)
+		return
+	}
+	if notificationSettingsStore.Get("thread_id") != nil {
+		t.Error("delete failed")
+	}
+}
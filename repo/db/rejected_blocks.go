@@ -0,0 +1,108 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type RejectedBlockDB struct {
+	modelStore
+}
+
+func NewRejectedBlockStore(db *sql.DB, lock *sync.Mutex) repo.RejectedBlockStore {
+	return &RejectedBlockDB{modelStore{db, lock}}
+}
+
+func (c *RejectedBlockDB) Add(block *pb.RejectedBlock) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert into rejected_blocks(id, threadId, authorId, address, type, date, reason) values(?,?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		block.Id,
+		block.Thread,
+		block.Author,
+		block.Address,
+		int32(block.Type),
+		util.ProtoNanos(block.Date),
+		block.Reason,
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *RejectedBlockDB) Get(id string) *pb.RejectedBlock {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from rejected_blocks where id='" + id + "';")
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+func (c *RejectedBlockDB) ListByThread(threadId string) *pb.RejectedBlockList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from rejected_blocks where threadId='" + threadId + "' order by date desc;")
+}
+
+func (c *RejectedBlockDB) Delete(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from rejected_blocks where id=?", id)
+	return err
+}
+
+func (c *RejectedBlockDB) DeleteByThread(threadId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from rejected_blocks where threadId=?", threadId)
+	return err
+}
+
+func (c *RejectedBlockDB) handleQuery(stm string) *pb.RejectedBlockList {
+	list := &pb.RejectedBlockList{Items: make([]*pb.RejectedBlock, 0)}
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var id, threadId, authorId, address, reason string
+		var typeInt int
+		var dateInt int64
+		if err := rows.Scan(&id, &threadId, &authorId, &address, &typeInt, &dateInt, &reason); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+
+		list.Items = append(list.Items, &pb.RejectedBlock{
+			Id:      id,
+			Thread:  threadId,
+			Author:  authorId,
+			Address: address,
+			Type:    pb.Block_BlockType(typeInt),
+			Date:    util.ProtoTs(dateInt),
+			Reason:  reason,
+		})
+	}
+	return list
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var rejectedBlockStore repo.RejectedBlockStore
+
+func init() {
+	setupRejectedBlockDB()
+}
+
+func setupRejectedBlockDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	rejectedBlockStore = NewRejectedBlockStore(conn, new(sync.Mutex))
+}
+
+func TestRejectedBlockDB_Add(t *testing.T) {
+	if err := rejectedBlockStore.Add(&pb.RejectedBlock{
+		Id:      "abcde",
+		Thread:  "thread_id",
+		Author:  "author_id",
+		Address: "address",
+		Type:    pb.Block_LIKE,
+		Date:    ptypes.TimestampNow(),
+		Reason:  "thread is not annotatable",
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	stmt, err := rejectedBlockStore.PrepareQuery("select id from rejected_blocks where id=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+
+	var id string
+	if err := stmt.QueryRow("abcde").Scan(&id); err != nil {
+		t.Error(err)
+		return
+	}
+	if id != "abcde" {
+		t.Errorf(`expected "abcde" got %s`, id)
+	}
+}
+
+func TestRejectedBlockDB_Get(t *testing.T) {
+	block := rejectedBlockStore.Get("abcde")
+	if block == nil {
+		t.Error("could not get rejected block")
+		return
+	}
+	if block.Type != pb.Block_LIKE || block.Reason != "thread is not annotatable" {
+		t.Error("rejected block has bad fields")
+	}
+}
+
+func TestRejectedBlockDB_ListByThread(t *testing.T) {
+	list := rejectedBlockStore.ListByThread("thread_id")
+	if len(list.Items) != 1 {
+		t.Error("returned incorrect number of rejected blocks")
+	}
+}
+
+func TestRejectedBlockDB_DeleteByThread(t *testing.T) {
+	if err := rejectedBlockStore.DeleteByThread("thread_id"); err != nil {
+		t.Error(err)
+		return
+	}
+	if rejectedBlockStore.Get("abcde") != nil {
+		t.Error("delete by thread failed")
+	}
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type SearchIndexDB struct {
+	modelStore
+}
+
+func NewSearchIndexStore(db *sql.DB, lock *sync.Mutex) repo.SearchIndexStore {
+	return &SearchIndexDB{modelStore{db, lock}}
+}
+
+// Index adds or replaces the searchable text of a block or file
+func (c *SearchIndexDB) Index(id string, threadId string, text string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec("delete from search_index where id=?", id); err != nil {
+		tx.Rollback()
+		return err
+	}
+	stm := `insert into search_index(id, threadId, text) values(?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		id,
+		threadId,
+		text,
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+// SearchBlocks returns blocks with text matching a full-text query, along with blocks
+// targeting files with a matching name or metadata. The optional query filters on
+// block columns, with args bound to its placeholders.
+func (c *SearchIndexDB) SearchBlocks(match string, offset string, limit int, query string, args ...interface{}) ([]*pb.BlockSearchResult, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	matches := `
+    select b.*, '' as file, snippet(search_index, '<b>', '</b>', '...', 2, 16) as snippet
+    from search_index s join blocks b on b.id=s.id
+    where search_index match ?
+    union all
+    select b.*, f.hash as file, snippet(search_index, '<b>', '</b>', '...', 2, 16) as snippet
+    from search_index s join files f on f.hash=s.id
+    join blocks b on b.type=` + strconv.Itoa(int(pb.Block_FILES)) + ` and instr(','||f.targets||',', ','||b.target||',')>0
+    where search_index match ?`
+
+	params := append([]interface{}{match, match}, args...)
+	var q string
+	if offset != "" {
+		if query != "" {
+			q = query + " and "
+		}
+		q = "where " + q + "(date<(select date from blocks where id=?)) "
+		params = append(params, offset)
+	} else if query != "" {
+		q = "where " + query + " "
+	}
+	stm := "select * from (" + matches + ") " + q + "group by id order by date desc limit " + strconv.Itoa(limit) + ";"
+
+	rows, err := c.db.Query(stm, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*pb.BlockSearchResult, 0)
+	for rows.Next() {
+		var id, threadId, authorId, parents, target, body, file, snippet string
+		var typeInt int
+		var dateInt int64
+		if err := rows.Scan(&id, &threadId, &authorId, &typeInt, &dateInt, &parents, &target, &body, &file, &snippet); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, &pb.BlockSearchResult{
+			Block: &pb.Block{
+				Id:      id,
+				Thread:  threadId,
+				Author:  authorId,
+				Type:    pb.Block_BlockType(typeInt),
+				Date:    util.ProtoTs(dateInt),
+				Parents: util.SplitString(parents, ","),
+				Target:  target,
+				Body:    body,
+			},
+			File:    file,
+			Snippet: snippet,
+		})
+	}
+	return list, rows.Err()
+}
+
+func (c *SearchIndexDB) Delete(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from search_index where id=?", id)
+	return err
+}
+
+func (c *SearchIndexDB) DeleteByThread(threadId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from search_index where threadId=?", threadId)
+	return err
+}
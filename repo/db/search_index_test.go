@@ -0,0 +1,208 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+var searchIndexStore repo.SearchIndexStore
+var searchBlockStore repo.BlockStore
+var searchFileStore repo.FileStore
+
+func init() {
+	setupSearchIndexDB()
+}
+
+func setupSearchIndexDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	lock := new(sync.Mutex)
+	searchIndexStore = NewSearchIndexStore(conn, lock)
+	searchBlockStore = NewBlockStore(conn, lock)
+	searchFileStore = NewFileStore(conn, lock)
+}
+
+func TestSearchIndexDB_Index(t *testing.T) {
+	if err := searchIndexStore.Index("abcde", "thread_id", "hello world"); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := searchIndexStore.Index("abcde", "thread_id", "hello again"); err != nil {
+		t.Error(err)
+		return
+	}
+	stmt, err := searchIndexStore.PrepareQuery("select count(*) from search_index where id=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+
+	var count int
+	if err := stmt.QueryRow("abcde").Scan(&count); err != nil {
+		t.Error(err)
+		return
+	}
+	if count != 1 {
+		t.Errorf("expected one row, got %d", count)
+	}
+}
+
+func TestSearchIndexDB_SearchBlocks(t *testing.T) {
+	setupSearchIndexDB()
+	if err := searchBlockStore.Add(&pb.Block{
+		Id:      "abcde",
+		Thread:  "thread_id",
+		Author:  "author_id",
+		Type:    pb.Block_TEXT,
+		Date:    ptypes.TimestampNow(),
+		Parents: []string{"Qm123"},
+		Body:    "lunch at the beach",
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := searchIndexStore.Index("abcde", "thread_id", "lunch at the beach"); err != nil {
+		t.Error(err)
+		return
+	}
+
+	if err := searchBlockStore.Add(&pb.Block{
+		Id:      "fghijk",
+		Thread:  "thread_id2",
+		Author:  "author_id",
+		Type:    pb.Block_FILES,
+		Date:    util.ProtoTs(time.Now().Add(time.Minute).UnixNano()),
+		Parents: []string{"abcde"},
+		Target:  "Qm456",
+		Body:    "photos",
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := searchFileStore.Add(&pb.FileIndex{
+		Mill:     "/image/resize",
+		Checksum: "checksum",
+		Source:   "source",
+		Opts:     "opts",
+		Hash:     "Qmfile",
+		Key:      "key",
+		Media:    "image/jpeg",
+		Name:     "beach.jpg",
+		Size:     1024,
+		Added:    ptypes.TimestampNow(),
+		Targets:  []string{"Qm456"},
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := searchIndexStore.Index("Qmfile", "", "beach.jpg"); err != nil {
+		t.Error(err)
+		return
+	}
+
+	all, err := searchIndexStore.SearchBlocks("beach", "", -1, "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(all) != 2 {
+		t.Errorf("expected 2 results, got %d", len(all))
+		return
+	}
+	if all[0].Block.Id != "fghijk" || all[0].File != "Qmfile" {
+		t.Error("expected a file match first")
+	}
+	if all[1].Block.Id != "abcde" || all[1].File != "" || all[1].Snippet == "" {
+		t.Error("expected a text match second")
+	}
+
+	offset, err := searchIndexStore.SearchBlocks("beach", all[0].Block.Id, -1, "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(offset) != 1 {
+		t.Error("returned incorrect number of results")
+	}
+
+	filtered, err := searchIndexStore.SearchBlocks("beach", "", -1, "threadId=?", "thread_id")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(filtered) != 1 {
+		t.Error("returned incorrect number of results")
+	}
+
+	injected, err := searchIndexStore.SearchBlocks("beach", "') or 1=1 --", -1, "threadId=?", "x' or '1'='1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(injected) != 0 {
+		t.Error("bound values should not be evaluated as sql")
+	}
+
+	none, err := searchIndexStore.SearchBlocks("mountain", "", -1, "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(none) != 0 {
+		t.Error("returned incorrect number of results")
+	}
+
+	if _, err := searchIndexStore.SearchBlocks("\"beach", "", -1, ""); err == nil {
+		t.Error("malformed match should fail")
+	}
+}
+
+func TestSearchIndexDB_Delete(t *testing.T) {
+	if err := searchIndexStore.Delete("abcde"); err != nil {
+		t.Error(err)
+		return
+	}
+	res, err := searchIndexStore.SearchBlocks("lunch", "", -1, "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(res) != 0 {
+		t.Error("delete failed")
+	}
+}
+
+func TestSearchIndexDB_DeleteByThread(t *testing.T) {
+	setupSearchIndexDB()
+	if err := searchIndexStore.Index("abcde", "thread_id", "hello world"); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := searchIndexStore.DeleteByThread("thread_id"); err != nil {
+		t.Error(err)
+		return
+	}
+	stmt, err := searchIndexStore.PrepareQuery("select count(*) from search_index where threadId=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+
+	var count int
+	if err := stmt.QueryRow("thread_id").Scan(&count); err != nil {
+		t.Error(err)
+		return
+	}
+	if count != 0 {
+		t.Error("delete by thread id failed")
+	}
+}
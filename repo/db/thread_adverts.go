@@ -0,0 +1,94 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type ThreadAdvertDB struct {
+	modelStore
+}
+
+func NewThreadAdvertStore(db *sql.DB, lock *sync.Mutex) repo.ThreadAdvertStore {
+	return &ThreadAdvertDB{modelStore{db, lock}}
+}
+
+func (c *ThreadAdvertDB) AddOrUpdate(advert *pb.ThreadAdvert) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into thread_adverts(id, invite, inviteKey, date) values(?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		advert.Id,
+		advert.Invite,
+		advert.InviteKey,
+		util.ProtoNanos(advert.Date),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *ThreadAdvertDB) Get(id string) *pb.ThreadAdvert {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from thread_adverts where id='" + id + "';")
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+func (c *ThreadAdvertDB) List() *pb.ThreadAdvertList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from thread_adverts order by date desc;")
+}
+
+func (c *ThreadAdvertDB) Delete(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from thread_adverts where id=?", id)
+	return err
+}
+
+func (c *ThreadAdvertDB) handleQuery(stm string) *pb.ThreadAdvertList {
+	list := &pb.ThreadAdvertList{Items: make([]*pb.ThreadAdvert, 0)}
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var id, invite, inviteKey string
+		var dateInt int64
+		if err := rows.Scan(&id, &invite, &inviteKey, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+
+		list.Items = append(list.Items, &pb.ThreadAdvert{
+			Id:        id,
+			Invite:    invite,
+			InviteKey: inviteKey,
+			Date:      util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
@@ -0,0 +1,102 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+var threadAdvertStore repo.ThreadAdvertStore
+
+func init() {
+	setupThreadAdvertDB()
+}
+
+func setupThreadAdvertDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	threadAdvertStore = NewThreadAdvertStore(conn, new(sync.Mutex))
+}
+
+func TestThreadAdvertDB_AddOrUpdate(t *testing.T) {
+	if err := threadAdvertStore.AddOrUpdate(&pb.ThreadAdvert{
+		Id:        "abcde",
+		Invite:    "invite",
+		InviteKey: "key",
+		Date:      util.ProtoTs(time.Now().Add(-time.Minute).UnixNano()),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := threadAdvertStore.AddOrUpdate(&pb.ThreadAdvert{
+		Id:        "abcde",
+		Invite:    "invite2",
+		InviteKey: "key2",
+		Date:      util.ProtoTs(time.Now().Add(-time.Minute).UnixNano()),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	stmt, err := threadAdvertStore.PrepareQuery("select invite from thread_adverts where id=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+
+	var invite string
+	if err := stmt.QueryRow("abcde").Scan(&invite); err != nil {
+		t.Error(err)
+		return
+	}
+	if invite != "invite2" {
+		t.Errorf(`expected "invite2" got %s`, invite)
+	}
+}
+
+func TestThreadAdvertDB_Get(t *testing.T) {
+	advert := threadAdvertStore.Get("abcde")
+	if advert == nil {
+		t.Error("could not get thread advert")
+		return
+	}
+	if advert.InviteKey != "key2" {
+		t.Error("thread advert has bad fields")
+	}
+}
+
+func TestThreadAdvertDB_List(t *testing.T) {
+	if err := threadAdvertStore.AddOrUpdate(&pb.ThreadAdvert{
+		Id:        "fghij",
+		Invite:    "invite",
+		InviteKey: "key",
+		Date:      ptypes.TimestampNow(),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	list := threadAdvertStore.List()
+	if len(list.Items) != 2 {
+		t.Error("returned incorrect number of thread adverts")
+		return
+	}
+	if list.Items[0].Id != "fghij" {
+		t.Error("thread adverts should be listed newest first")
+	}
+}
+
+func TestThreadAdvertDB_Delete(t *testing.T) {
+	if err := threadAdvertStore.Delete("abcde"); err != nil {
+		t.Error(err)
+		return
+	}
+	if threadAdvertStore.Get("abcde") != nil {
+		t.Error("delete failed")
+	}
+}
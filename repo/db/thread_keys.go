@@ -0,0 +1,108 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type ThreadKeyDB struct {
+	modelStore
+}
+
+func NewThreadKeyStore(db *sql.DB, lock *sync.Mutex) repo.ThreadKeyStore {
+	return &ThreadKeyDB{modelStore{db, lock}}
+}
+
+func (c *ThreadKeyDB) Add(key *pb.ThreadKey) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert into thread_keys(id, threadId, epoch, sk, date) values(?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		key.Id,
+		key.Thread,
+		key.Epoch,
+		key.Sk,
+		util.ProtoNanos(key.Date),
+	)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *ThreadKeyDB) Get(id string) *pb.ThreadKey {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from thread_keys where id='" + id + "';")
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+func (c *ThreadKeyDB) GetLatest(threadId string) *pb.ThreadKey {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from thread_keys where threadId='" + threadId + "' order by epoch desc, date desc limit 1;")
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+func (c *ThreadKeyDB) ListByThread(threadId string) *pb.ThreadKeyList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.handleQuery("select * from thread_keys where threadId='" + threadId + "' order by epoch asc, date asc;")
+}
+
+func (c *ThreadKeyDB) DeleteByThread(threadId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from thread_keys where threadId=?", threadId)
+	return err
+}
+
+func (c *ThreadKeyDB) handleQuery(stm string) *pb.ThreadKeyList {
+	list := &pb.ThreadKeyList{Items: make([]*pb.ThreadKey, 0)}
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var id, threadId string
+		var epochInt int
+		var sk []byte
+		var dateInt int64
+		if err := rows.Scan(&id, &threadId, &epochInt, &sk, &dateInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+
+		list.Items = append(list.Items, &pb.ThreadKey{
+			Id:     id,
+			Thread: threadId,
+			Epoch:  int32(epochInt),
+			Sk:     sk,
+			Date:   util.ProtoTs(dateInt),
+		})
+	}
+	return list
+}
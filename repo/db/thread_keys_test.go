@@ -0,0 +1,105 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var threadKeyStore repo.ThreadKeyStore
+
+func init() {
+	setupThreadKeyDB()
+}
+
+func setupThreadKeyDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	threadKeyStore = NewThreadKeyStore(conn, new(sync.Mutex))
+}
+
+func TestThreadKeyDB_Add(t *testing.T) {
+	if err := threadKeyStore.Add(&pb.ThreadKey{
+		Id:     "abcde",
+		Thread: "thread_id",
+		Epoch:  1,
+		Sk:     []byte("sk1"),
+		Date:   ptypes.TimestampNow(),
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	stmt, err := threadKeyStore.PrepareQuery("select id from thread_keys where id=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+
+	var id string
+	if err := stmt.QueryRow("abcde").Scan(&id); err != nil {
+		t.Error(err)
+		return
+	}
+	if id != "abcde" {
+		t.Errorf(`expected "abcde" got %s`, id)
+	}
+}
+
+func TestThreadKeyDB_Get(t *testing.T) {
+	key := threadKeyStore.Get("abcde")
+	if key == nil {
+		t.Error("could not get thread key")
+		return
+	}
+	if key.Epoch != 1 || string(key.Sk) != "sk1" {
+		t.Error("thread key has bad fields")
+	}
+}
+
+func TestThreadKeyDB_GetLatest(t *testing.T) {
+	date, _ := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	if err := threadKeyStore.Add(&pb.ThreadKey{
+		Id:     "fghij",
+		Thread: "thread_id",
+		Epoch:  2,
+		Sk:     []byte("sk2"),
+		Date:   date,
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	key := threadKeyStore.GetLatest("thread_id")
+	if key == nil || key.Id != "fghij" {
+		t.Error("latest thread key should have the highest epoch")
+	}
+	if threadKeyStore.GetLatest("other") != nil {
+		t.Error("thread without keys should not have a latest key")
+	}
+}
+
+func TestThreadKeyDB_ListByThread(t *testing.T) {
+	list := threadKeyStore.ListByThread("thread_id")
+	if len(list.Items) != 2 {
+		t.Error("returned incorrect number of thread keys")
+		return
+	}
+	if list.Items[0].Epoch != 1 {
+		t.Error("thread keys should be listed by ascending epoch")
+	}
+}
+
+func TestThreadKeyDB_DeleteByThread(t *testing.T) {
+	if err := threadKeyStore.DeleteByThread("thread_id"); err != nil {
+		t.Error(err)
+		return
+	}
+	if threadKeyStore.Get("abcde") != nil {
+		t.Error("delete by thread failed")
+	}
+}
@@ -31,6 +31,16 @@ var migrations = []Migration{
 	m.Minor010{},
 	m.Minor011{},
 	m.Minor012{},
+	m.Minor013{},
+	m.Minor014{},
+	m.Minor015{},
+	m.Minor016{},
+	m.Minor017{},
+	m.Minor018{},
+	m.Minor019{},
+	m.Minor020{},
+	m.Minor021{},
+	m.Minor022{},
 }
 
 // Stat returns whether or not there's a major migration ahead of the current repover
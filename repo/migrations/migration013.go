@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor013 struct{}
+
+func (Minor013) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	query := `
+    create table rejected_blocks (id text primary key not null, threadId text not null, authorId text not null, address text not null, type integer not null, date integer not null, reason text not null);
+    create index rejected_block_threadId on rejected_blocks (threadId);
+    `
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f14, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f14.Close()
+	if _, err = f14.Write([]byte("14")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor013) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor013) Major() bool {
+	return false
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor014 struct{}
+
+func (Minor014) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	query := `
+    create table thread_keys (id text primary key not null, threadId text not null, epoch integer not null, sk blob not null, date integer not null);
+    create index thread_key_threadId on thread_keys (threadId);
+    `
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f15, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f15.Close()
+	if _, err = f15.Write([]byte("15")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor014) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor014) Major() bool {
+	return false
+}
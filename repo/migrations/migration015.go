@@ -0,0 +1,141 @@
+package migrations
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor015 struct{}
+
+func (Minor015) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	query := `
+    create virtual table search_index using fts4(id, threadId, text, notindexed=id, notindexed=threadId);
+    insert into search_index(id, threadId, text) select id, threadId, body from blocks where type in (6, 7, 8) and body!='';
+    `
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// index file names and metadata
+	rows, err := db.Query("select hash, name, meta from files;")
+	if err != nil {
+		return err
+	}
+	type file struct {
+		hash string
+		text string
+	}
+	var files []file
+	for rows.Next() {
+		var hash, name string
+		var meta []byte
+		if err := rows.Scan(&hash, &name, &meta); err != nil {
+			rows.Close()
+			return err
+		}
+		text := strings.TrimSpace(name + " " + fileMetaText(meta))
+		if text != "" {
+			files = append(files, file{hash: hash, text: text})
+		}
+	}
+	rows.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert into search_index(id, threadId, text) values(?,?,?)")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	for _, f := range files {
+		if _, err := stmt.Exec(f.hash, "", f.text); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	// update version
+	f16, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f16.Close()
+	if _, err = f16.Write([]byte("16")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor015) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor015) Major() bool {
+	return false
+}
+
+// fileMetaText returns the values of json file metadata as space separated text
+func fileMetaText(meta []byte) string {
+	if len(meta) == 0 {
+		return ""
+	}
+	var val interface{}
+	if err := json.Unmarshal(meta, &val); err != nil {
+		return ""
+	}
+
+	var words []string
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				walk(v[k])
+			}
+		case []interface{}:
+			for _, i := range v {
+				walk(i)
+			}
+		case string:
+			words = append(words, v)
+		case float64:
+			words = append(words, fmt.Sprint(v))
+		}
+	}
+	walk(val)
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,90 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt014(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table files (mill text not null, checksum text not null, source text not null, opts text not null, hash text not null, key text not null, media text not null, name text not null, size integer not null, added integer not null, meta blob, targets text, primary key (mill, checksum));
+    create table blocks (id text primary key not null, threadId text not null, authorId text not null, type integer not null, date integer not null, parents text not null, target text not null, body text not null);
+    insert into blocks(id, threadId, authorId, type, date, parents, target, body) values('block', 'thread', 'author', 6, 0, '', '', 'hello world');
+    insert into blocks(id, threadId, authorId, type, date, parents, target, body) values('join', 'thread', 'author', 3, 0, '', '', '');
+    insert into files(mill, checksum, source, opts, hash, key, media, name, size, added, meta, targets) values('/image/resize', 'checksum', 'source', 'opts', 'file', 'key', 'image/jpeg', 'beach.jpg', 1024, 0, '{"width":320,"camera":"pinhole"}', 'target');
+	`
+	_, err := db.Exec(sqlStmt)
+	return err
+}
+
+func Test015(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt014(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor015
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new tables
+	var id string
+	if err := db.QueryRow("select id from search_index where search_index match 'hello';").Scan(&id); err != nil {
+		t.Error(err)
+		return
+	}
+	if id != "block" {
+		t.Errorf("expected block, got %s", id)
+	}
+	if err := db.QueryRow("select id from search_index where search_index match 'pinhole';").Scan(&id); err != nil {
+		t.Error(err)
+		return
+	}
+	if id != "file" {
+		t.Errorf("expected file, got %s", id)
+	}
+	var count int
+	if err := db.QueryRow("select count(*) from search_index;").Scan(&count); err != nil {
+		t.Error(err)
+		return
+	}
+	if count != 2 {
+		t.Errorf("expected 2 rows, got %d", count)
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "16" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor017 struct{}
+
+func (Minor017) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	query := `
+    create table changes (seq integer primary key not null, type integer not null, id text not null, threadId text not null, date integer not null);
+    `
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f18, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f18.Close()
+	if _, err = f18.Write([]byte("18")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor017) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor017) Major() bool {
+	return false
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor019 struct{}
+
+func (Minor019) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	query := `
+    create table cafe_client_pins (cid text not null, clientId text not null, size integer not null, date integer not null, primary key (cid, clientId));
+    create index cafe_client_pin_clientId on cafe_client_pins (clientId);
+    `
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f20, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f20.Close()
+	if _, err = f20.Write([]byte("20")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor019) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor019) Major() bool {
+	return false
+}
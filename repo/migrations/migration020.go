@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor020 struct{}
+
+func (Minor020) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	query := `
+    create table cafe_client_bans (id text primary key not null, address text not null, date integer not null);
+    create index cafe_client_ban_address on cafe_client_bans (address);
+    `
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f21, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f21.Close()
+	if _, err = f21.Write([]byte("21")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor020) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor020) Major() bool {
+	return false
+}
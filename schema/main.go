@@ -25,6 +25,9 @@ var ErrMissingJsonSchema = fmt.Errorf("json mill requires a json schema")
 // ErrBadJsonSchema indicates json schema is invalid
 var ErrBadJsonSchema = fmt.Errorf("json schema is not valid")
 
+// ErrOptionalLinkUse indicates a required link uses an optional link
+var ErrOptionalLinkUse = fmt.Errorf("required link uses an optional link")
+
 // FileTag indicates the link should "use" the input file as source
 const FileTag = ":file"
 
@@ -39,6 +42,8 @@ func ValidateMill(mill string) bool {
 		"/blob",
 		"/image/resize",
 		"/image/exif",
+		"/video/meta",
+		"/video/poster",
 		"/json":
 		return true
 	}
@@ -59,6 +64,12 @@ func LinkByName(links []*ipld.Link, names []string) *ipld.Link {
 
 // Steps returns link steps in the order they should be processed
 func Steps(links map[string]*pb.Link) ([]pb.Step, error) {
+	for _, link := range links {
+		if use, ok := links[link.Use]; ok && use.Optional && !link.Optional {
+			return nil, ErrOptionalLinkUse
+		}
+	}
+
 	var steps []pb.Step
 	run := links
 	i := 0
@@ -0,0 +1,33 @@
+package textile
+
+var Videos = `
+{
+  "name": "videos",
+  "pin": true,
+  "links": {
+    "raw": {
+      "use": ":file",
+      "mill": "/blob"
+    },
+    "meta": {
+      "use": "raw",
+      "mill": "/video/meta"
+    },
+    "poster": {
+      "use": "raw",
+      "optional": true,
+      "mill": "/video/poster"
+    },
+    "thumb": {
+      "use": "poster",
+      "optional": true,
+      "pin": true,
+      "mill": "/image/resize",
+      "opts": {
+        "width": "320",
+        "quality": "80"
+      }
+    }
+  }
+}
+`